@@ -0,0 +1,124 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppendArraySlice(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	valid := []bool{true, false, true, true, false, true, true, true, false, true, true, true}
+
+	t.Run("int64", func(t *testing.T) {
+		ib := array.NewInt64Builder(mem)
+		defer ib.Release()
+		ib.AppendValues([]int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, valid)
+		arr := ib.NewInt64Array()
+		defer arr.Release()
+		src := array.NewSlice(arr, 1, 12).(*array.Int64)
+		defer src.Release()
+
+		// start with an unaligned builder to exercise the bitmap copy.
+		ib.Append(-1)
+		ib.AppendArraySlice(src, 2, 7)
+		ib.AppendArraySlice(src, 0, 0)
+		got := ib.NewInt64Array()
+		defer got.Release()
+
+		assert.Equal(t, "[-1 3 (null) 5 6 7 (null) 9]", got.String())
+		assert.Equal(t, 2, got.NullN())
+	})
+
+	t.Run("boolean", func(t *testing.T) {
+		bb := array.NewBooleanBuilder(mem)
+		defer bb.Release()
+		bb.AppendValues([]bool{true, true, false, true, false, false, true, false, true, true, false, true}, valid)
+		arr := bb.NewBooleanArray()
+		defer arr.Release()
+		src := array.NewSlice(arr, 3, 12).(*array.Boolean)
+		defer src.Release()
+
+		bb.AppendNull()
+		bb.AppendArraySlice(src, 1, 6)
+		got := bb.NewBooleanArray()
+		defer got.Release()
+
+		assert.Equal(t, "[(null) (null) false true false (null) true]", got.String())
+		assert.Equal(t, 3, got.NullN())
+	})
+
+	t.Run("string", func(t *testing.T) {
+		sb := array.NewStringBuilder(mem)
+		defer sb.Release()
+		sb.AppendValues([]string{"a", "bb", "ccc", "d", "ee", "f", "gg", "h", "ii", "j", "kk", "l"}, valid)
+		arr := sb.NewStringArray()
+		defer arr.Release()
+		src := array.NewSlice(arr, 2, 10).(*array.String)
+		defer src.Release()
+
+		sb.Append("x")
+		sb.AppendArraySlice(src, 1, 5)
+		got := sb.NewStringArray()
+		defer got.Release()
+
+		assert.Equal(t, `["x" "d" (null) "f" "gg" "h"]`, got.String())
+		assert.Equal(t, 1, got.NullN())
+		assert.NoError(t, got.ValidateFull())
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		bb := array.NewBinaryBuilder(mem, arrow.BinaryTypes.Binary)
+		defer bb.Release()
+		bb.AppendStringValues([]string{"a", "bb", "ccc", "d", "ee", "f", "gg", "h", "ii", "j", "kk", "l"}, valid)
+		arr := bb.NewBinaryArray()
+		defer arr.Release()
+		src := array.NewSlice(arr, 4, 12).(*array.Binary)
+		defer src.Release()
+
+		bb.AppendArraySlice(src, 3, 5)
+		got := bb.NewBinaryArray()
+		defer got.Release()
+
+		assert.Equal(t, `["h" (null) "j" "kk" "l"]`, got.String())
+		assert.Equal(t, 1, got.NullN())
+		assert.NoError(t, got.ValidateFull())
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		ib := array.NewInt64Builder(mem)
+		defer ib.Release()
+		ib.AppendValues([]int64{1, 2, 3}, nil)
+		src := ib.NewInt64Array()
+		defer src.Release()
+
+		assert.Panics(t, func() { ib.AppendArraySlice(src, 2, 2) })
+		assert.Panics(t, func() { ib.AppendArraySlice(src, -1, 1) })
+
+		ib.SetCollectErrors(true)
+		ib.AppendArraySlice(src, 1, 3)
+		assert.EqualError(t, ib.Err(), "arrow/array: *array.Int64Builder.AppendArraySlice: slice out of range of src")
+		assert.Equal(t, 0, ib.Len())
+	})
+}
@@ -0,0 +1,131 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// ArithmeticOption configures the arithmetic kernels.
+type ArithmeticOption func(*arithmeticConfig)
+
+type arithmeticConfig struct {
+	checkOverflow bool
+}
+
+// WithCheckOverflow specifies whether the arithmetic kernels return an error
+// when an integer result overflows its data type. Otherwise, integer results
+// wrap around. The default is false.
+func WithCheckOverflow(v bool) ArithmeticOption {
+	return func(cfg *arithmeticConfig) {
+		cfg.checkOverflow = v
+	}
+}
+
+// Negate returns a new array holding the negation of each element of arr.
+// Negating an unsigned integer wraps around, and overflows for non-zero values.
+//
+// Negate supports integer and floating-point arrays, and the returned array
+// has the same data type as arr. Null elements map to null outputs.
+func Negate(arr Interface, mem memory.Allocator, opts ...ArithmeticOption) (Interface, error) {
+	return arithmetic(arr, nil, mem, opts, arithmeticOp{
+		name: "negate",
+		i: func(a, _ int64) (int64, bool) {
+			return -a, a != math.MinInt64
+		},
+		u: func(a, _ uint64) (uint64, bool) {
+			return -a, a == 0
+		},
+		f: func(a, _ float64) float64 { return -a },
+	})
+}
+
+// Abs returns a new array holding the absolute value of each element of arr.
+// See Negate for the supported data types.
+func Abs(arr Interface, mem memory.Allocator, opts ...ArithmeticOption) (Interface, error) {
+	return arithmetic(arr, nil, mem, opts, arithmeticOp{
+		name: "abs",
+		i: func(a, _ int64) (int64, bool) {
+			if a < 0 {
+				return -a, a != math.MinInt64
+			}
+			return a, true
+		},
+		u: func(a, _ uint64) (uint64, bool) { return a, true },
+		f: func(a, _ float64) float64 { return math.Abs(a) },
+	})
+}
+
+// AddScalar returns a new array holding the sum of each element of arr and v.
+// v must be a Go value of the element type of arr, e.g. an int32 for an
+// Int32 array. See Negate for the supported data types.
+func AddScalar(arr Interface, v interface{}, mem memory.Allocator, opts ...ArithmeticOption) (Interface, error) {
+	return arithmetic(arr, v, mem, opts, arithmeticOp{
+		name: "add",
+		i: func(a, b int64) (int64, bool) {
+			c := a + b
+			return c, (c > a) == (b > 0)
+		},
+		u: func(a, b uint64) (uint64, bool) {
+			c := a + b
+			return c, c >= a
+		},
+		f: func(a, b float64) float64 { return a + b },
+	})
+}
+
+// MultiplyScalar returns a new array holding the product of each element of
+// arr and v. See AddScalar for the type of v.
+func MultiplyScalar(arr Interface, v interface{}, mem memory.Allocator, opts ...ArithmeticOption) (Interface, error) {
+	return arithmetic(arr, v, mem, opts, arithmeticOp{
+		name: "multiply",
+		i: func(a, b int64) (int64, bool) {
+			c := a * b
+			ok := a == 0 || (c/a == b && !(a == -1 && b == math.MinInt64))
+			return c, ok
+		},
+		u: func(a, b uint64) (uint64, bool) {
+			c := a * b
+			return c, a == 0 || c/a == b
+		},
+		f: func(a, b float64) float64 { return a * b },
+	})
+}
+
+// arithmeticOp is an arithmetic operation on 64-bit operands.
+// The integer operations report whether their 64-bit result did not overflow.
+type arithmeticOp struct {
+	name string
+	i    func(a, b int64) (int64, bool)
+	u    func(a, b uint64) (uint64, bool)
+	f    func(a, b float64) float64
+}
+
+// overflow returns the error reported when the result of op at index i
+// overflows its data type.
+func (op arithmeticOp) overflow(i int) error {
+	return fmt.Errorf("arrow/array: %s: overflow at index %d", op.name, i)
+}
+
+// errArithmeticScalar returns the error reported when the scalar v does not
+// match the element type of arr.
+func errArithmeticScalar(arr Interface, v interface{}) error {
+	return fmt.Errorf("arrow/array: invalid scalar type %T for %v array", v, arr.DataType())
+}
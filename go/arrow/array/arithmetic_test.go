@@ -0,0 +1,118 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestArithmetic(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt8Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int8{-3, 0, 5, math.MinInt8, 100}, []bool{true, false, true, true, true})
+
+	ints := ib.NewInt8Array()
+	defer ints.Release()
+
+	ub := array.NewUint32Builder(mem)
+	defer ub.Release()
+	ub.AppendValues([]uint32{0, 7, 1, math.MaxUint32}, []bool{true, true, false, true})
+
+	uints := ub.NewUint32Array()
+	defer uints.Release()
+
+	fb := array.NewFloat64Builder(mem)
+	defer fb.Release()
+	fb.AppendValues([]float64{1.5, 0, -2, 4}, []bool{true, false, true, true})
+
+	floats := fb.NewFloat64Array()
+	defer floats.Release()
+
+	type unaryFunc func(array.Interface, memory.Allocator, ...array.ArithmeticOption) (array.Interface, error)
+	type scalarFunc func(array.Interface, interface{}, memory.Allocator, ...array.ArithmeticOption) (array.Interface, error)
+	scalar := func(fn scalarFunc, v interface{}) unaryFunc {
+		return func(arr array.Interface, mem memory.Allocator, opts ...array.ArithmeticOption) (array.Interface, error) {
+			return fn(arr, v, mem, opts...)
+		}
+	}
+	check := []array.ArithmeticOption{array.WithCheckOverflow(true)}
+
+	for _, tc := range []struct {
+		name string
+		fn   unaryFunc
+		arr  array.Interface
+		opts []array.ArithmeticOption
+		want string
+		err  string
+	}{
+		{name: "negate-i8", fn: array.Negate, arr: ints, want: "[3 (null) -5 -128 -100]"},
+		{name: "negate-i8-check", fn: array.Negate, arr: ints, opts: check, err: "negate: overflow at index 3"},
+		{name: "abs-i8", fn: array.Abs, arr: ints, want: "[3 (null) 5 -128 100]"},
+		{name: "abs-i8-check", fn: array.Abs, arr: ints, opts: check, err: "abs: overflow at index 3"},
+		{name: "add-i8", fn: scalar(array.AddScalar, int8(30)), arr: ints, want: "[27 (null) 35 -98 -126]"},
+		{name: "add-i8-check", fn: scalar(array.AddScalar, int8(30)), arr: ints, opts: check, err: "add: overflow at index 4"},
+		{name: "add-i8-negative", fn: scalar(array.AddScalar, int8(-1)), arr: ints, opts: check, err: "add: overflow at index 3"},
+		{name: "mul-i8", fn: scalar(array.MultiplyScalar, int8(2)), arr: ints, want: "[-6 (null) 10 0 -56]"},
+		{name: "mul-i8-check", fn: scalar(array.MultiplyScalar, int8(-1)), arr: ints, opts: check, err: "multiply: overflow at index 3"},
+		{name: "negate-u32", fn: array.Negate, arr: uints, want: "[0 4294967289 (null) 1]"},
+		{name: "negate-u32-check", fn: array.Negate, arr: uints, opts: check, err: "negate: overflow at index 1"},
+		{name: "abs-u32", fn: array.Abs, arr: uints, opts: check, want: "[0 7 (null) 4294967295]"},
+		{name: "add-u32", fn: scalar(array.AddScalar, uint32(1)), arr: uints, want: "[1 8 (null) 0]"},
+		{name: "add-u32-check", fn: scalar(array.AddScalar, uint32(1)), arr: uints, opts: check, err: "add: overflow at index 3"},
+		{name: "mul-u32-check", fn: scalar(array.MultiplyScalar, uint32(3)), arr: uints, opts: check, err: "multiply: overflow at index 3"},
+		{name: "negate-f64", fn: array.Negate, arr: floats, opts: check, want: "[-1.5 (null) 2 -4]"},
+		{name: "abs-f64", fn: array.Abs, arr: floats, want: "[1.5 (null) 2 4]"},
+		{name: "add-f64", fn: scalar(array.AddScalar, 0.5), arr: floats, want: "[2 (null) -1.5 4.5]"},
+		{name: "mul-f64", fn: scalar(array.MultiplyScalar, -2.0), arr: floats, opts: check, want: "[-3 (null) 4 -8]"},
+		{name: "invalid-scalar", fn: scalar(array.AddScalar, 1), arr: floats, err: "invalid scalar type int for float64 array"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := tc.fn(tc.arr, mem, tc.opts...)
+			if tc.err != "" {
+				if err == nil {
+					out.Release()
+					t.Fatalf("expected an error")
+				}
+				if !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("invalid error: got=%q, want=%q", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer out.Release()
+
+			if got, want := out.DataType(), tc.arr.DataType(); !arrow.TypeEquals(got, want) {
+				t.Fatalf("invalid data type: got=%v, want=%v", got, want)
+			}
+			if got, want := fmt.Sprintf("%v", out), tc.want; got != want {
+				t.Fatalf("invalid result:\ngot= %s\nwant=%s", got, want)
+			}
+		})
+	}
+}
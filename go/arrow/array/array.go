@@ -17,6 +17,7 @@
 package array // import "github.com/apache/arrow/go/arrow/array"
 
 import (
+	"fmt"
 	"sync/atomic"
 
 	"github.com/apache/arrow/go/arrow"
@@ -48,6 +49,14 @@ type Interface interface {
 	// Len returns the number of elements in the array.
 	Len() int
 
+	// Validate checks the structural invariants of the array which do not
+	// require scanning its values, and returns the first violation found.
+	Validate() error
+
+	// ValidateFull checks all the invariants of the array, scanning its
+	// values, and returns the first violation found.
+	ValidateFull() error
+
 	// Retain increases the reference count by 1.
 	// Retain may be called simultaneously from multiple goroutines.
 	Retain()
@@ -93,6 +102,11 @@ func (a *array) DataType() arrow.DataType { return a.data.dtype }
 // NullN returns the number of null values in the array.
 func (a *array) NullN() int {
 	if a.data.nulls < 0 {
+		if len(a.nullBitmapBytes) == 0 {
+			// no validity bitmap: all values are valid.
+			a.data.nulls = 0
+			return 0
+		}
 		a.data.nulls = a.data.length - bitutil.CountSetBits(a.nullBitmapBytes, a.data.offset, a.data.length)
 	}
 	return a.data.nulls
@@ -137,7 +151,7 @@ func (a *array) Offset() int {
 type arrayConstructorFn func(*Data) Interface
 
 var (
-	makeArrayFn [32]arrayConstructorFn
+	makeArrayFn [64]arrayConstructorFn
 )
 
 func unsupportedArrayType(data *Data) Interface {
@@ -150,7 +164,7 @@ func invalidDataType(data *Data) Interface {
 
 // MakeFromData constructs a strongly-typed array instance from generic Data.
 func MakeFromData(data *Data) Interface {
-	return makeArrayFn[byte(data.dtype.ID()&0x1f)](data)
+	return makeArrayFn[byte(data.dtype.ID()&0x3f)](data)
 }
 
 // NewSlice constructs a zero-copy slice of the array with the indicated
@@ -160,12 +174,60 @@ func MakeFromData(data *Data) Interface {
 // NewSlice panics if the slice is outside the valid range of the input array.
 // NewSlice panics if j < i.
 func NewSlice(arr Interface, i, j int64) Interface {
+	if err := checkSliceBounds(i, j, int64(arr.Len())); err != nil {
+		panic(err.Error())
+	}
 	data := NewSliceData(arr.Data(), i, j)
 	slice := MakeFromData(data)
 	data.Release()
 	return slice
 }
 
+// NewSliceChecked is like NewSlice but returns an error if the slice is
+// outside the valid range of the input array or if j < i.
+func NewSliceChecked(arr Interface, i, j int64) (Interface, error) {
+	if err := checkSliceBounds(i, j, int64(arr.Len())); err != nil {
+		return nil, err
+	}
+	return NewSlice(arr, i, j), nil
+}
+
+// checkSliceBounds checks that 0 <= i <= j <= n.
+func checkSliceBounds(i, j, n int64) error {
+	if i < 0 || i > j || j > n {
+		return fmt.Errorf("arrow/array: slice bounds [%d:%d] out of range for length %d", i, j, n)
+	}
+	return nil
+}
+
+// AllNull reports whether all the elements of arr are null.
+// AllNull only inspects the validity bitmap of arr, counting its set bits
+// if the null count of arr is not known yet.
+// An empty array is considered all null.
+func AllNull(arr Interface) bool {
+	return arr.NullN() == arr.Len()
+}
+
+// AllValid reports whether all the elements of arr are valid (not null).
+// AllValid only inspects the validity bitmap of arr, counting its set bits
+// if the null count of arr is not known yet.
+// An empty array is considered all valid.
+func AllValid(arr Interface) bool {
+	return arr.NullN() == 0
+}
+
+// ReleaseAll releases all the provided arrays, skipping nil entries.
+// It is meant to be deferred after creating several arrays:
+//
+//  defer array.ReleaseAll(a, b, c)
+func ReleaseAll(arrs ...Interface) {
+	for _, arr := range arrs {
+		if arr != nil {
+			arr.Release()
+		}
+	}
+}
+
 func init() {
 	makeArrayFn = [...]arrayConstructorFn{
 		arrow.NULL:              func(data *Data) Interface { return NewNullData(data) },
@@ -194,13 +256,24 @@ func init() {
 		arrow.LIST:              func(data *Data) Interface { return NewListData(data) },
 		arrow.STRUCT:            func(data *Data) Interface { return NewStructData(data) },
 		arrow.UNION:             unsupportedArrayType,
-		arrow.DICTIONARY:        unsupportedArrayType,
+		arrow.DICTIONARY:        func(data *Data) Interface { return NewDictionaryData(data) },
 		arrow.MAP:               unsupportedArrayType,
 		arrow.EXTENSION:         unsupportedArrayType,
 		arrow.FIXED_SIZE_LIST:   func(data *Data) Interface { return NewFixedSizeListData(data) },
 		arrow.DURATION:          func(data *Data) Interface { return NewDurationData(data) },
+		arrow.LARGE_STRING:      func(data *Data) Interface { return NewLargeStringData(data) },
+		arrow.LARGE_BINARY:      func(data *Data) Interface { return NewLargeBinaryData(data) },
+		arrow.LARGE_LIST:        func(data *Data) Interface { return NewLargeListData(data) },
+		arrow.DECIMAL256:        func(data *Data) Interface { return NewDecimal256Data(data) },
+		arrow.RUN_END_ENCODED:   func(data *Data) Interface { return NewRunEndEncodedData(data) },
+
+		// invalid data types to fill out array size 2⁶-1
+		63: invalidDataType,
+	}
 
-		// invalid data types to fill out array size 2⁵-1
-		31: invalidDataType,
+	for i, fn := range makeArrayFn {
+		if fn == nil {
+			makeArrayFn[i] = invalidDataType
+		}
 	}
 }
@@ -17,6 +17,7 @@
 package array_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow"
@@ -34,6 +35,10 @@ func (d *testDataType) ID() arrow.Type { return d.id }
 func (d *testDataType) Name() string   { panic("implement me") }
 func (d *testDataType) BitWidth() int  { return 8 }
 
+func (d *testDataType) Layout() arrow.DataTypeLayout {
+	return arrow.DataTypeLayout{Buffers: []arrow.BufferSpec{arrow.SpecBitmap(), arrow.SpecFixedWidth(1)}}
+}
+
 func TestMakeFromData(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -67,6 +72,7 @@ func TestMakeFromData(t *testing.T) {
 		{name: "month_interval", d: arrow.FixedWidthTypes.MonthInterval},
 		{name: "day_time_interval", d: arrow.FixedWidthTypes.DayTimeInterval},
 		{name: "decimal", d: &testDataType{arrow.DECIMAL}},
+		{name: "decimal256", d: &arrow.Decimal256Type{Precision: 40, Scale: 2}},
 
 		{name: "list", d: &testDataType{arrow.LIST}, child: []*array.Data{
 			array.NewData(&testDataType{arrow.INT64}, 0, make([]*memory.Buffer, 4), nil, 0, 0),
@@ -84,16 +90,25 @@ func TestMakeFromData(t *testing.T) {
 			array.NewData(&testDataType{arrow.INT64}, 0, make([]*memory.Buffer, 4), nil, 0, 0),
 		}},
 		{name: "duration", d: &testDataType{arrow.DURATION}},
+		{name: "large_string", d: &testDataType{arrow.LARGE_STRING}, size: 3},
+		{name: "large_binary", d: &testDataType{arrow.LARGE_BINARY}, size: 3},
+		{name: "large_list", d: &testDataType{arrow.LARGE_LIST}, child: []*array.Data{
+			array.NewData(&testDataType{arrow.INT64}, 0, make([]*memory.Buffer, 4), nil, 0, 0),
+			array.NewData(&testDataType{arrow.INT64}, 0, make([]*memory.Buffer, 4), nil, 0, 0),
+		}},
+		{name: "dictionary", d: &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String}, child: []*array.Data{
+			array.NewData(arrow.BinaryTypes.String, 0, make([]*memory.Buffer, 3), nil, 0, 0),
+		}},
 
 		// unsupported types
 		{name: "union", d: &testDataType{arrow.UNION}, expPanic: true, expError: "unsupported data type: UNION"},
-		{name: "dictionary", d: &testDataType{arrow.DICTIONARY}, expPanic: true, expError: "unsupported data type: DICTIONARY"},
 		{name: "map", d: &testDataType{arrow.Type(27)}, expPanic: true, expError: "unsupported data type: MAP"},
 		{name: "extension", d: &testDataType{arrow.Type(28)}, expPanic: true, expError: "unsupported data type: EXTENSION"},
 
 		// invalid types
 		{name: "invalid(-1)", d: &testDataType{arrow.Type(-1)}, expPanic: true, expError: "invalid data type: Type(-1)"},
-		{name: "invalid(31)", d: &testDataType{arrow.Type(31)}, expPanic: true, expError: "invalid data type: Type(31)"},
+		{name: "invalid(36)", d: &testDataType{arrow.Type(36)}, expPanic: true, expError: "invalid data type: Type(36)"},
+		{name: "invalid(63)", d: &testDataType{arrow.Type(63)}, expPanic: true, expError: "invalid data type: Type(63)"},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -125,17 +140,20 @@ func TestArray_NullN(t *testing.T) {
 		l    int
 		bm   []byte
 		n    int
+		off  int
 		exp  int
 	}{
 		{name: "unknown,l16", l: 16, bm: bbits(0x11001010, 0x00110011), n: array.UnknownNullCount, exp: 8},
 		{name: "unknown,l12,ignores last nibble", l: 12, bm: bbits(0x11001010, 0x00111111), n: array.UnknownNullCount, exp: 6},
 		{name: "unknown,l12,12 nulls", l: 12, bm: bbits(0x00000000, 0x00000000), n: array.UnknownNullCount, exp: 12},
 		{name: "unknown,l12,00 nulls", l: 12, bm: bbits(0x11111111, 0x11111111), n: array.UnknownNullCount, exp: 0},
+		{name: "unknown,l0", l: 0, bm: bbits(0x00000000), n: array.UnknownNullCount, exp: 0},
+		{name: "unknown,l8,offset 3", l: 8, bm: bbits(0x11100000, 0x11111111), n: array.UnknownNullCount, off: 3, exp: 5},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			buf := memory.NewBufferBytes(test.bm)
-			data := array.NewData(arrow.FixedWidthTypes.Boolean, test.l, []*memory.Buffer{buf, nil}, nil, test.n, 0)
+			data := array.NewData(arrow.FixedWidthTypes.Boolean, test.l, []*memory.Buffer{buf, nil}, nil, test.n, test.off)
 			buf.Release()
 			ar := array.MakeFromData(data)
 			data.Release()
@@ -200,6 +218,57 @@ func TestArraySlice(t *testing.T) {
 	}
 }
 
+func TestArraySliceBounds(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	b := array.NewInt32Builder(pool)
+	defer b.Release()
+	b.AppendValues([]int32{1, 2, 3, 4}, nil)
+
+	arr := b.NewInt32Array()
+	defer arr.Release()
+
+	for _, tc := range []struct {
+		i, j int64
+		err  string
+	}{
+		{i: 0, j: 0},
+		{i: 2, j: 2},
+		{i: 4, j: 4},
+		{i: 0, j: 4},
+		{i: -1, j: 2, err: "arrow/array: slice bounds [-1:2] out of range for length 4"},
+		{i: 3, j: 2, err: "arrow/array: slice bounds [3:2] out of range for length 4"},
+		{i: 1, j: 5, err: "arrow/array: slice bounds [1:5] out of range for length 4"},
+		{i: 5, j: 5, err: "arrow/array: slice bounds [5:5] out of range for length 4"},
+	} {
+		t.Run(fmt.Sprintf("%d:%d", tc.i, tc.j), func(t *testing.T) {
+			slice, err := array.NewSliceChecked(arr, tc.i, tc.j)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+				}
+
+				defer func() {
+					if got := recover(); got != tc.err {
+						t.Fatalf("invalid panic: got=%v, want=%q", got, tc.err)
+					}
+				}()
+				array.NewSlice(arr, tc.i, tc.j)
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer slice.Release()
+
+			if got, want := slice.Len(), int(tc.j-tc.i); got != want {
+				t.Fatalf("invalid slice length: got=%d, want=%d", got, want)
+			}
+		})
+	}
+}
+
 func TestArraySliceTypes(t *testing.T) {
 	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer pool.AssertSize(t, 0)
@@ -299,3 +368,133 @@ func TestArraySliceTypes(t *testing.T) {
 		})
 	}
 }
+
+func TestReleaseAll(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int64{1, 2, 3}, nil)
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues([]string{"a", "b", "c"}, []bool{true, false, true})
+
+	lb := array.NewListBuilder(mem, arrow.PrimitiveTypes.Float64)
+	defer lb.Release()
+	lb.Append(true)
+	lb.ValueBuilder().(*array.Float64Builder).AppendValues([]float64{1, 2}, nil)
+
+	i64 := ib.NewArray()
+	str := sb.NewArray()
+	lst := lb.NewArray()
+	sli := array.NewSlice(i64, 1, 2)
+
+	if mem.CurrentAlloc() == 0 {
+		t.Fatalf("arrays should hold memory")
+	}
+
+	array.ReleaseAll(i64, nil, str, lst, sli)
+	array.ReleaseAll()
+
+	mem.AssertSize(t, 0)
+}
+
+func TestReleaseCascade(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "f1", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "f2", Type: arrow.BinaryTypes.String},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"a", "b", "c"}, nil)
+	rec1 := b.NewRecord()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{4, 5}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"d", "e"}, nil)
+	rec2 := b.NewRecord()
+
+	tbl := array.NewTableFromRecords(schema, []array.Record{rec1, rec2})
+
+	// the table holds its own references to the columns of the records.
+	rec1.Release()
+	rec2.Release()
+
+	if mem.CurrentAlloc() == 0 {
+		t.Fatalf("table should hold memory")
+	}
+
+	tbl.Release()
+	mem.AssertSize(t, 0)
+}
+
+func TestAllNullAllValid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewInt32Builder(mem)
+	defer b.Release()
+
+	newArray := func(valid []bool) array.Interface {
+		b.AppendValues(make([]int32, len(valid)), valid)
+		return b.NewArray()
+	}
+
+	var (
+		allNull  = newArray([]bool{false, false, false})
+		allValid = newArray([]bool{true, true, true})
+		mixed    = newArray([]bool{true, true, false, false, false, true})
+		empty    = newArray(nil)
+		nulls    = array.NewNull(4)
+
+		nullWindow  = array.NewSlice(mixed, 2, 5)
+		validWindow = array.NewSlice(mixed, 0, 2)
+	)
+	defer array.ReleaseAll(allNull, allValid, mixed, empty, nulls, nullWindow, validWindow)
+
+	for _, tc := range []struct {
+		name     string
+		arr      array.Interface
+		allNull  bool
+		allValid bool
+	}{
+		{name: "all-null", arr: allNull, allNull: true},
+		{name: "all-valid", arr: allValid, allValid: true},
+		{name: "mixed", arr: mixed},
+		{name: "empty", arr: empty, allNull: true, allValid: true},
+		{name: "null-array", arr: nulls, allNull: true},
+		{name: "null-window", arr: nullWindow, allNull: true},
+		{name: "valid-window", arr: validWindow, allValid: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := array.AllNull(tc.arr), tc.allNull; got != want {
+				t.Fatalf("invalid all-null: got=%v, want=%v", got, want)
+			}
+			if got, want := array.AllValid(tc.arr), tc.allValid; got != want {
+				t.Fatalf("invalid all-valid: got=%v, want=%v", got, want)
+			}
+		})
+	}
+
+	t.Run("no-validity-bitmap", func(t *testing.T) {
+		data := array.NewData(arrow.PrimitiveTypes.Int32, 3, []*memory.Buffer{nil, allValid.Data().Buffers()[1]}, nil, 0, 0)
+		defer data.Release()
+
+		slice := array.NewSliceData(data, 1, 3)
+		defer slice.Release()
+
+		arr := array.MakeFromData(slice)
+		defer arr.Release()
+
+		if !array.AllValid(arr) || array.AllNull(arr) {
+			t.Fatalf("array without validity bitmap should be all valid")
+		}
+	})
+}
@@ -0,0 +1,125 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// BatchBuilder builds a stream of records from a known Schema, emitting a
+// new record every maxRows rows.
+//
+// Values of a row are appended via the field builders, after which Append
+// must be called to complete the row:
+//
+//  for _, row := range rows {
+//      bldr.Field(0).(*array.Int64Builder).Append(row.ID)
+//      bldr.Field(1).(*array.StringBuilder).Append(row.Name)
+//      if rec := bldr.Append(); rec != nil {
+//          send(rec)
+//      }
+//  }
+//  if rec := bldr.Flush(); rec != nil {
+//      send(rec)
+//  }
+type BatchBuilder struct {
+	refCount int64
+	bldr     *RecordBuilder
+	maxRows  int
+	rows     int
+}
+
+// NewBatchBuilder returns a builder emitting records of at most maxRows rows,
+// using the provided memory allocator and schema.
+//
+// NewBatchBuilder panics if maxRows is not positive.
+func NewBatchBuilder(mem memory.Allocator, schema *arrow.Schema, maxRows int) *BatchBuilder {
+	if maxRows <= 0 {
+		panic("arrow/array: invalid batch size")
+	}
+
+	b := &BatchBuilder{
+		refCount: 1,
+		bldr:     NewRecordBuilder(mem, schema),
+		maxRows:  maxRows,
+	}
+	b.bldr.Reserve(maxRows)
+	return b
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (b *BatchBuilder) Retain() {
+	atomic.AddInt64(&b.refCount, 1)
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory of the pending rows is freed.
+func (b *BatchBuilder) Release() {
+	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&b.refCount, -1) == 0 {
+		b.bldr.Release()
+		b.bldr = nil
+	}
+}
+
+func (b *BatchBuilder) Schema() *arrow.Schema { return b.bldr.Schema() }
+func (b *BatchBuilder) Fields() []Builder     { return b.bldr.Fields() }
+func (b *BatchBuilder) Field(i int) Builder   { return b.bldr.Field(i) }
+
+// MaxRows returns the number of rows of the emitted records.
+func (b *BatchBuilder) MaxRows() int { return b.maxRows }
+
+// Len returns the number of completed rows not yet emitted.
+func (b *BatchBuilder) Len() int { return b.rows }
+
+// Append completes the row whose values were appended via the field builders.
+//
+// Append returns a new record and resets the builder once maxRows rows have
+// been completed, and nil otherwise.
+// The returned Record must be Release()'d after use.
+func (b *BatchBuilder) Append() Record {
+	b.rows++
+	if b.rows < b.maxRows {
+		return nil
+	}
+	return b.newRecord()
+}
+
+// Flush returns a new record holding the completed rows not yet emitted, and
+// resets the builder.
+// Flush returns nil if there are no such rows.
+//
+// The returned Record must be Release()'d after use.
+func (b *BatchBuilder) Flush() Record {
+	if b.rows == 0 {
+		return nil
+	}
+	return b.newRecord()
+}
+
+func (b *BatchBuilder) newRecord() Record {
+	rec := b.bldr.NewRecord()
+	b.rows = 0
+	b.bldr.Reserve(b.maxRows)
+	return rec
+}
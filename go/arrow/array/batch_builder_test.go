@@ -0,0 +1,118 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestBatchBuilder(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+		},
+		nil,
+	)
+
+	b := array.NewBatchBuilder(mem, schema, 3)
+	defer b.Release()
+
+	b.Retain()
+	b.Release()
+
+	var recs []array.Record
+	defer func() {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}()
+
+	for i := 0; i < 8; i++ {
+		b.Field(0).(*array.Int64Builder).Append(int64(i))
+		if i%4 == 0 {
+			b.Field(1).AppendNull()
+		} else {
+			b.Field(1).(*array.StringBuilder).Append(string(rune('a' + i)))
+		}
+		rec := b.Append()
+		if got, want := rec != nil, (i+1)%3 == 0; got != want {
+			t.Fatalf("row %d: invalid record emission: got=%v, want=%v", i, got, want)
+		}
+		if rec != nil {
+			recs = append(recs, rec)
+		}
+	}
+
+	if got, want := b.Len(), 2; got != want {
+		t.Fatalf("invalid number of pending rows: got=%d, want=%d", got, want)
+	}
+
+	rec := b.Flush()
+	if rec == nil {
+		t.Fatalf("expected a partial record")
+	}
+	recs = append(recs, rec)
+
+	if rec := b.Flush(); rec != nil {
+		rec.Release()
+		t.Fatalf("expected no record after flush")
+	}
+
+	want := [][]int64{{0, 1, 2}, {3, 4, 5}, {6, 7}}
+	if got, want := len(recs), len(want); got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+	for i, rec := range recs {
+		if !rec.Schema().Equal(schema) {
+			t.Fatalf("rec[%d]: invalid schema: got=%v, want=%v", i, rec.Schema(), schema)
+		}
+		if got := rec.Column(0).(*array.Int64).Int64Values(); !reflect.DeepEqual(got, want[i]) {
+			t.Fatalf("rec[%d]: invalid ids: got=%v, want=%v", i, got, want[i])
+		}
+		names := rec.Column(1).(*array.String)
+		for j, id := range want[i] {
+			if got, want := names.IsNull(j), id%4 == 0; got != want {
+				t.Fatalf("rec[%d][%d]: invalid null: got=%v, want=%v", i, j, got, want)
+			}
+			if names.IsNull(j) {
+				continue
+			}
+			if got, want := names.Value(j), string(rune('a'+id)); got != want {
+				t.Fatalf("rec[%d][%d]: invalid name: got=%q, want=%q", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestBatchBuilderInvalidSize(t *testing.T) {
+	defer func() {
+		if e := recover(); e == nil {
+			t.Fatalf("expected a panic")
+		}
+	}()
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	array.NewBatchBuilder(memory.NewGoAllocator(), schema, 0)
+}
@@ -0,0 +1,133 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Between returns a mask holding, for each non-null element of arr, whether
+// it lies within [lo, hi], or within (lo, hi) when inclusive is false.
+// Null elements yield null mask elements, and NaN elements are never
+// within bounds.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+//
+// Between supports the numeric primitive arrays. lo and hi must be Go
+// values of the element type of arr, e.g. int32 values for an Int32 array.
+func Between(arr Interface, lo, hi interface{}, inclusive bool) (*Boolean, error) {
+	in, err := betweenFunc(arr, lo, hi, inclusive)
+	if err != nil {
+		return nil, err
+	}
+
+	bldr := NewBooleanBuilder(memory.DefaultAllocator)
+	defer bldr.Release()
+
+	bldr.Reserve(arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			bldr.UnsafeAppendBoolToBitmap(false)
+			continue
+		}
+		bldr.UnsafeAppend(in(i))
+	}
+	return bldr.NewBooleanArray(), nil
+}
+
+// betweenFunc returns a function reporting whether the i-th element of arr
+// lies within the bounds.
+func betweenFunc(arr Interface, lo, hi interface{}, inclusive bool) (func(i int) bool, error) {
+	var want interface{}
+	switch arr.(type) {
+	case *Int8:
+		want = int8(0)
+	case *Int16:
+		want = int16(0)
+	case *Int32:
+		want = int32(0)
+	case *Int64:
+		want = int64(0)
+	case *Uint8:
+		want = uint8(0)
+	case *Uint16:
+		want = uint16(0)
+	case *Uint32:
+		want = uint32(0)
+	case *Uint64:
+		want = uint64(0)
+	case *Float32:
+		want = float32(0)
+	case *Float64:
+		want = float64(0)
+	default:
+		return nil, fmt.Errorf("arrow/array: between not supported for %v arrays", arr.DataType())
+	}
+	for _, bound := range []interface{}{lo, hi} {
+		if reflect.TypeOf(bound) != reflect.TypeOf(want) {
+			return nil, fmt.Errorf("arrow/array: invalid bound %v (type %T) for %v array", bound, bound, arr.DataType())
+		}
+	}
+
+	switch arr := arr.(type) {
+	case *Int8:
+		return intBetween(func(i int) int64 { return int64(arr.Value(i)) }, int64(lo.(int8)), int64(hi.(int8)), inclusive), nil
+	case *Int16:
+		return intBetween(func(i int) int64 { return int64(arr.Value(i)) }, int64(lo.(int16)), int64(hi.(int16)), inclusive), nil
+	case *Int32:
+		return intBetween(func(i int) int64 { return int64(arr.Value(i)) }, int64(lo.(int32)), int64(hi.(int32)), inclusive), nil
+	case *Int64:
+		return intBetween(arr.Value, lo.(int64), hi.(int64), inclusive), nil
+	case *Uint8:
+		return uintBetween(func(i int) uint64 { return uint64(arr.Value(i)) }, uint64(lo.(uint8)), uint64(hi.(uint8)), inclusive), nil
+	case *Uint16:
+		return uintBetween(func(i int) uint64 { return uint64(arr.Value(i)) }, uint64(lo.(uint16)), uint64(hi.(uint16)), inclusive), nil
+	case *Uint32:
+		return uintBetween(func(i int) uint64 { return uint64(arr.Value(i)) }, uint64(lo.(uint32)), uint64(hi.(uint32)), inclusive), nil
+	case *Uint64:
+		return uintBetween(arr.Value, lo.(uint64), hi.(uint64), inclusive), nil
+	case *Float32:
+		return floatBetween(func(i int) float64 { return float64(arr.Value(i)) }, float64(lo.(float32)), float64(hi.(float32)), inclusive), nil
+	default:
+		arr64 := arr.(*Float64)
+		return floatBetween(arr64.Value, lo.(float64), hi.(float64), inclusive), nil
+	}
+}
+
+func intBetween(v func(i int) int64, lo, hi int64, inclusive bool) func(i int) bool {
+	if inclusive {
+		return func(i int) bool { x := v(i); return lo <= x && x <= hi }
+	}
+	return func(i int) bool { x := v(i); return lo < x && x < hi }
+}
+
+func uintBetween(v func(i int) uint64, lo, hi uint64, inclusive bool) func(i int) bool {
+	if inclusive {
+		return func(i int) bool { x := v(i); return lo <= x && x <= hi }
+	}
+	return func(i int) bool { x := v(i); return lo < x && x < hi }
+}
+
+func floatBetween(v func(i int) float64, lo, hi float64, inclusive bool) func(i int) bool {
+	if inclusive {
+		return func(i int) bool { x := v(i); return lo <= x && x <= hi }
+	}
+	return func(i int) bool { x := v(i); return lo < x && x < hi }
+}
@@ -0,0 +1,121 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestBetween(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int32{-5, 0, 1, 5, 9, 10, 11, 7}, []bool{true, true, true, true, true, true, true, false})
+	i32 := ib.NewArray()
+	defer i32.Release()
+
+	ub := array.NewUint64Builder(mem)
+	defer ub.Release()
+	ub.AppendValues([]uint64{0, 1, math.MaxUint64, 2}, nil)
+	u64 := ub.NewArray()
+	defer u64.Release()
+
+	fb := array.NewFloat64Builder(mem)
+	defer fb.Release()
+	fb.AppendValues([]float64{0.5, 1, math.NaN(), 1.5, math.Inf(1), 0}, []bool{true, true, true, true, true, false})
+	f64 := fb.NewArray()
+	defer f64.Release()
+
+	for _, tc := range []struct {
+		name      string
+		arr       array.Interface
+		lo, hi    interface{}
+		inclusive bool
+		want      string
+	}{
+		{"int32-inclusive", i32, int32(0), int32(10), true, "[false true true true true true false (null)]"},
+		{"int32-exclusive", i32, int32(0), int32(10), false, "[false false true true true false false (null)]"},
+		{"int32-empty-range", i32, int32(5), int32(5), false, "[false false false false false false false (null)]"},
+		{"uint64-inclusive", u64, uint64(1), uint64(math.MaxUint64), true, "[false true true true]"},
+		{"float64-inclusive", f64, 1.0, 1.5, true, "[false true false true false (null)]"},
+		{"float64-exclusive", f64, 0.0, math.Inf(1), false, "[true true false true false (null)]"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mask, err := array.Between(tc.arr, tc.lo, tc.hi, tc.inclusive)
+			if err != nil {
+				t.Fatalf("could not compute mask: %+v", err)
+			}
+			defer mask.Release()
+
+			if got := array.NewStringer(mask).String(); got != tc.want {
+				t.Fatalf("invalid mask: got=%s, want=%s", got, tc.want)
+			}
+		})
+	}
+
+	sliced := array.NewSlice(i32, 3, 8)
+	defer sliced.Release()
+	mask, err := array.Between(sliced, int32(5), int32(9), true)
+	if err != nil {
+		t.Fatalf("could not compute mask of sliced array: %+v", err)
+	}
+	defer mask.Release()
+	if got, want := array.NewStringer(mask).String(), "[true true false false (null)]"; got != want {
+		t.Fatalf("invalid mask: got=%s, want=%s", got, want)
+	}
+}
+
+func TestBetweenInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.Append(1)
+	i32 := ib.NewArray()
+	defer i32.Release()
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.Append("a")
+	str := sb.NewArray()
+	defer str.Release()
+
+	for _, tc := range []struct {
+		name   string
+		arr    array.Interface
+		lo, hi interface{}
+	}{
+		{"untyped-int", i32, 0, 10},
+		{"int64-bounds", i32, int64(0), int64(10)},
+		{"mixed-bounds", i32, int32(0), int64(10)},
+		{"nil-bound", i32, nil, int32(10)},
+		{"string-array", str, "a", "b"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := array.Between(tc.arr, tc.lo, tc.hi, true); err == nil {
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
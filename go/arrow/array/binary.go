@@ -129,6 +129,112 @@ func arrayEqualBinary(left, right *Binary) bool {
 	return true
 }
 
+// A type which represents an immutable sequence of variable-length binary strings,
+// using 64-bit offsets.
+type LargeBinary struct {
+	array
+	valueOffsets []int64
+	valueBytes   []byte
+}
+
+// NewLargeBinaryData constructs a new LargeBinary array from data.
+func NewLargeBinaryData(data *Data) *LargeBinary {
+	a := &LargeBinary{}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+// Value returns the slice at index i. This value should not be mutated.
+func (a *LargeBinary) Value(i int) []byte {
+	if i < 0 || i >= a.array.data.length {
+		panic("arrow/array: index out of range")
+	}
+	idx := a.array.data.offset + i
+	return a.valueBytes[a.valueOffsets[idx]:a.valueOffsets[idx+1]]
+}
+
+// ValueString returns the string at index i without performing additional allocations.
+// The string is only valid for the lifetime of the LargeBinary array.
+func (a *LargeBinary) ValueString(i int) string {
+	b := a.Value(i)
+	return *(*string)(unsafe.Pointer(&b))
+}
+
+func (a *LargeBinary) ValueOffset(i int) int64 {
+	if i < 0 || i >= a.array.data.length {
+		panic("arrow/array: index out of range")
+	}
+	return a.valueOffsets[a.array.data.offset+i]
+}
+
+func (a *LargeBinary) ValueLen(i int) int {
+	if i < 0 || i >= a.array.data.length {
+		panic("arrow/array: index out of range")
+	}
+	beg := a.array.data.offset + i
+	return int(a.valueOffsets[beg+1] - a.valueOffsets[beg])
+}
+
+func (a *LargeBinary) ValueOffsets() []int64 {
+	beg := a.array.data.offset
+	end := beg + a.array.data.length + 1
+	return a.valueOffsets[beg:end]
+}
+
+func (a *LargeBinary) ValueBytes() []byte {
+	beg := a.array.data.offset
+	end := beg + a.array.data.length
+	return a.valueBytes[a.valueOffsets[beg]:a.valueOffsets[end]]
+}
+
+func (a *LargeBinary) String() string {
+	o := new(strings.Builder)
+	o.WriteString("[")
+	for i := 0; i < a.Len(); i++ {
+		if i > 0 {
+			o.WriteString(" ")
+		}
+		switch {
+		case a.IsNull(i):
+			o.WriteString("(null)")
+		default:
+			fmt.Fprintf(o, "%q", a.ValueString(i))
+		}
+	}
+	o.WriteString("]")
+	return o.String()
+}
+
+func (a *LargeBinary) setData(data *Data) {
+	if len(data.buffers) != 3 {
+		panic("len(data.buffers) != 3")
+	}
+
+	a.array.setData(data)
+
+	if valueData := data.buffers[2]; valueData != nil {
+		a.valueBytes = valueData.Bytes()
+	}
+
+	if valueOffsets := data.buffers[1]; valueOffsets != nil {
+		a.valueOffsets = arrow.Int64Traits.CastFromBytes(valueOffsets.Bytes())
+	}
+}
+
+func arrayEqualLargeBinary(left, right *LargeBinary) bool {
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) {
+			continue
+		}
+		if bytes.Compare(left.Value(i), right.Value(i)) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 var (
 	_ Interface = (*Binary)(nil)
+	_ Interface = (*LargeBinary)(nil)
 )
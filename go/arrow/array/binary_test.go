@@ -428,3 +428,44 @@ func TestBinaryStringer(t *testing.T) {
 		t.Fatalf("invalid stringer:\ngot= %s\nwant=%s\n", got, want)
 	}
 }
+
+func TestLargeBinary(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := NewBinaryBuilder(mem, arrow.BinaryTypes.LargeBinary)
+	defer b.Release()
+
+	values := [][]byte{[]byte("AAA"), nil, []byte("BBBB"), []byte("")}
+	valid := []bool{true, false, true, true}
+	b.AppendValues(values, valid)
+
+	arr := b.NewArray().(*LargeBinary)
+	defer arr.Release()
+
+	assert.Equal(t, arrow.BinaryTypes.LargeBinary, arr.DataType())
+	assert.Equal(t, 4, arr.Len())
+	assert.Equal(t, 1, arr.NullN())
+	assert.Equal(t, []byte("AAA"), arr.Value(0))
+	assert.Equal(t, []byte{}, arr.Value(1))
+	assert.Equal(t, "BBBB", arr.ValueString(2))
+	assert.Equal(t, []int64{0, 3, 3, 7, 7}, arr.ValueOffsets())
+	assert.Equal(t, []byte("AAABBBB"), arr.ValueBytes())
+	assert.Equal(t, 4, arr.ValueLen(2))
+
+	slice := NewSliceData(arr.Data(), 1, 3)
+	defer slice.Release()
+
+	sub := NewLargeBinaryData(slice)
+	defer sub.Release()
+
+	assert.Equal(t, 2, sub.Len())
+	assert.Equal(t, 1, sub.NullN())
+	assert.Equal(t, []byte("BBBB"), sub.Value(1))
+	assert.Equal(t, int64(3), sub.ValueOffset(1))
+	assert.Equal(t, []int64{3, 3, 7}, sub.ValueOffsets())
+	assert.Equal(t, []byte("BBBB"), sub.ValueBytes())
+	assert.Equal(t, `[(null) "BBBB"]`, sub.String())
+
+	assert.Panics(t, func() { b.NewBinaryArray() }, "large binary builder should not build binary arrays")
+}
@@ -29,20 +29,51 @@ const (
 	binaryArrayMaximumCapacity = math.MaxInt32
 )
 
+// offsetsBuilder accumulates the value offsets of variable-length binary arrays,
+// either as 32-bit or as 64-bit integers.
+type offsetsBuilder interface {
+	Release()
+	Finish() *memory.Buffer
+	resize(nbytes int)
+	setGrowth(g growthPolicy)
+
+	appendOffset(v int)
+	offset(i int) int
+	byteWidth() int
+}
+
+func (b *int32BufferBuilder) appendOffset(v int) { b.AppendValue(int32(v)) }
+func (b *int32BufferBuilder) offset(i int) int   { return int(b.Value(i)) }
+func (b *int32BufferBuilder) byteWidth() int     { return arrow.Int32SizeBytes }
+
+func (b *int64BufferBuilder) appendOffset(v int) { b.AppendValue(int64(v)) }
+func (b *int64BufferBuilder) offset(i int) int   { return int(b.Value(i)) }
+func (b *int64BufferBuilder) byteWidth() int     { return arrow.Int64SizeBytes }
+
 // A BinaryBuilder is used to build a Binary array using the Append methods.
+//
+// A BinaryBuilder created for the LargeBinary or LargeString data types
+// builds arrays with 64-bit offsets.
 type BinaryBuilder struct {
 	builder
 
 	dtype   arrow.BinaryDataType
-	offsets *int32BufferBuilder
+	offsets offsetsBuilder
 	values  *byteBufferBuilder
 }
 
 func NewBinaryBuilder(mem memory.Allocator, dtype arrow.BinaryDataType) *BinaryBuilder {
+	var offsets offsetsBuilder
+	switch dtype.ID() {
+	case arrow.LARGE_BINARY, arrow.LARGE_STRING:
+		offsets = newInt64BufferBuilder(mem)
+	default:
+		offsets = newInt32BufferBuilder(mem)
+	}
 	b := &BinaryBuilder{
 		builder: builder{refCount: 1, mem: mem},
 		dtype:   dtype,
-		offsets: newInt32BufferBuilder(mem),
+		offsets: offsets,
 		values:  newByteBufferBuilder(mem),
 	}
 	return b
@@ -70,6 +101,12 @@ func (b *BinaryBuilder) Release() {
 	}
 }
 
+func (b *BinaryBuilder) setGrowth(g growthPolicy) {
+	b.builder.setGrowth(g)
+	b.offsets.setGrowth(g)
+	b.values.setGrowth(g)
+}
+
 func (b *BinaryBuilder) Append(v []byte) {
 	b.Reserve(1)
 	b.appendNextOffset()
@@ -87,22 +124,42 @@ func (b *BinaryBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *BinaryBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	for i := 0; i < n; i++ {
+		b.appendNextOffset()
+	}
+	b.unsafeAppendNulls(n)
+}
+
+// AppendEmptyValue appends an empty binary value.
+func (b *BinaryBuilder) AppendEmptyValue() {
+	b.Append(nil)
+}
+
 // AppendValues will append the values in the v slice. The valid slice determines which values
 // in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
 // all values in v are appended and considered valid.
 func (b *BinaryBuilder) AppendValues(v [][]byte, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
 		return
 	}
 
+	n := 0
+	for _, vv := range v {
+		n += len(vv)
+	}
+
 	b.Reserve(len(v))
+	b.ReserveData(n)
 	for _, vv := range v {
 		b.appendNextOffset()
-		b.values.Append(vv)
+		b.values.unsafeAppend(vv)
 	}
 
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
@@ -113,37 +170,73 @@ func (b *BinaryBuilder) AppendValues(v [][]byte, valid []bool) {
 // all values in v are appended and considered valid.
 func (b *BinaryBuilder) AppendStringValues(v []string, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendStringValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
 		return
 	}
 
+	n := 0
+	for _, vv := range v {
+		n += len(vv)
+	}
+
 	b.Reserve(len(v))
+	b.ReserveData(n)
 	for _, vv := range v {
 		b.appendNextOffset()
-		b.values.Append([]byte(vv))
+		b.values.unsafeAppendString(vv)
 	}
 
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The value data and the validity bitmap are
+// copied in bulk, and the value offsets are rebased.
+func (b *BinaryBuilder) AppendArraySlice(src *Binary, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	offsets := src.ValueOffsets()[start : start+length+1]
+	b.Reserve(length)
+	b.ReserveData(int(offsets[length] - offsets[0]))
+	b.unsafeAppendOffsets(offsets)
+	b.values.unsafeAppend(src.valueBytes[offsets[0]:offsets[length]])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
+// unsafeAppendOffsets appends the offsets of the len(offsets)-1 values they
+// delimit, rebased on the current end of the data buffer.
+func (b *BinaryBuilder) unsafeAppendOffsets(offsets []int32) {
+	base := b.values.Len() - int(offsets[0])
+	for _, o := range offsets[:len(offsets)-1] {
+		b.offsets.appendOffset(base + int(o))
+	}
+}
+
 func (b *BinaryBuilder) Value(i int) []byte {
-	offsets := b.offsets.Values()
-	start := int(offsets[i])
+	start := b.offsets.offset(i)
 	var end int
 	if i == (b.length - 1) {
 		end = b.values.Len()
 	} else {
-		end = int(offsets[i+1])
+		end = b.offsets.offset(i + 1)
 	}
 	return b.values.Bytes()[start:end]
 }
 
 func (b *BinaryBuilder) init(capacity int) {
 	b.builder.init(capacity)
-	b.offsets.resize((capacity + 1) * arrow.Int32SizeBytes)
+	b.offsets.resize((capacity + 1) * b.offsets.byteWidth())
 }
 
 // DataLen returns the number of bytes in the data array.
@@ -155,40 +248,67 @@ func (b *BinaryBuilder) DataCap() int { return b.values.capacity }
 
 // Reserve ensures there is enough space for appending n elements
 // by checking the capacity and calling Resize if necessary.
+// Reserve only sizes the offsets and the validity bitmap: use ReserveData
+// to size the data buffer holding the bytes of the values.
 func (b *BinaryBuilder) Reserve(n int) {
 	b.builder.reserve(n, b.Resize)
 }
 
 // ReserveData ensures there is enough space for appending n bytes
-// by checking the capacity and resizing the data buffer if necessary.
+// by checking the capacity and growing the data buffer if necessary.
+// Calling ReserveData with the total size of the values to append, before
+// appending them, sizes the data buffer once instead of growing it
+// repeatedly. ReserveData is independent of Reserve.
 func (b *BinaryBuilder) ReserveData(n int) {
 	if b.values.capacity < b.values.length+n {
-		b.values.resize(b.values.Len() + n)
+		b.values.grow(b.values.Len() + n)
 	}
 }
 
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may be reduced.
 func (b *BinaryBuilder) Resize(n int) {
-	b.offsets.resize((n + 1) * arrow.Int32SizeBytes)
+	b.offsets.resize((n + 1) * b.offsets.byteWidth())
 	b.builder.resize(n, b.init)
 }
 
-// NewArray creates a Binary array from the memory buffers used by the builder and resets the BinaryBuilder
-// so it can be used to build a new array.
+// NewArray creates a Binary (or LargeBinary, for 64-bit offsets) array from the memory buffers
+// used by the builder and resets the BinaryBuilder so it can be used to build a new array.
 func (b *BinaryBuilder) NewArray() Interface {
+	if b.offsets.byteWidth() == arrow.Int64SizeBytes {
+		return b.NewLargeBinaryArray()
+	}
 	return b.NewBinaryArray()
 }
 
 // NewBinaryArray creates a Binary array from the memory buffers used by the builder and resets the BinaryBuilder
 // so it can be used to build a new array.
+//
+// NewBinaryArray panics if the builder uses 64-bit offsets.
 func (b *BinaryBuilder) NewBinaryArray() (a *Binary) {
+	if b.offsets.byteWidth() != arrow.Int32SizeBytes {
+		panic("arrow/array: invalid call to NewBinaryArray on a builder with 64-bit offsets")
+	}
 	data := b.newData()
 	a = NewBinaryData(data)
 	data.Release()
 	return
 }
 
+// NewLargeBinaryArray creates a LargeBinary array from the memory buffers used by the builder and resets the BinaryBuilder
+// so it can be used to build a new array.
+//
+// NewLargeBinaryArray panics if the builder uses 32-bit offsets.
+func (b *BinaryBuilder) NewLargeBinaryArray() (a *LargeBinary) {
+	if b.offsets.byteWidth() != arrow.Int64SizeBytes {
+		panic("arrow/array: invalid call to NewLargeBinaryArray on a builder with 32-bit offsets")
+	}
+	data := b.newData()
+	a = NewLargeBinaryData(data)
+	data.Release()
+	return
+}
+
 func (b *BinaryBuilder) newData() (data *Data) {
 	b.appendNextOffset()
 	offsets, values := b.offsets.Finish(), b.values.Finish()
@@ -209,7 +329,7 @@ func (b *BinaryBuilder) newData() (data *Data) {
 func (b *BinaryBuilder) appendNextOffset() {
 	numBytes := b.values.Len()
 	// TODO(sgc): check binaryArrayMaximumCapacity?
-	b.offsets.AppendValue(int32(numBytes))
+	b.offsets.appendOffset(numBytes)
 }
 
 var (
@@ -38,6 +38,27 @@ func NewBoolean(length int, data *memory.Buffer, nullBitmap *memory.Buffer, null
 	return NewBooleanData(NewData(arrow.FixedWidthTypes.Boolean, length, []*memory.Buffer{nullBitmap, data}, nil, nulls, 0))
 }
 
+// NewBooleanFromBitmap creates a boolean array of length elements adopting the
+// LSB bit-packed data buffer as its values, without copying or repacking it.
+// The validity buffer can be nil if there are no null values, in which case nulls is ignored.
+// If nulls is not known, use UnknownNullCount to calculate the value of NullN at runtime from
+// the validity buffer.
+//
+// NewBooleanFromBitmap panics if data or validity are too small to hold length bits.
+func NewBooleanFromBitmap(data, validity *memory.Buffer, length, nulls int) *Boolean {
+	nbytes := int(bitutil.BytesForBits(int64(length)))
+	if data.Len() < nbytes {
+		panic(fmt.Errorf("arrow/array: boolean data buffer too small (got=%d bytes, want=%d)", data.Len(), nbytes))
+	}
+	switch {
+	case validity == nil:
+		nulls = 0
+	case validity.Len() < nbytes:
+		panic(fmt.Errorf("arrow/array: boolean validity buffer too small (got=%d bytes, want=%d)", validity.Len(), nbytes))
+	}
+	return NewBoolean(length, data, validity, nulls)
+}
+
 func NewBooleanData(data *Data) *Boolean {
 	a := &Boolean{}
 	a.refCount = 1
@@ -70,6 +91,21 @@ func (a *Boolean) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Boolean) Range(fn func(i int, v bool, valid bool) bool) {
+	var (
+		nulls = a.NullN() > 0
+		off   = a.array.data.offset
+	)
+	for i := 0; i < a.array.data.length; i++ {
+		if !fn(i, bitutil.BitIsSet(a.values, off+i), !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Boolean) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
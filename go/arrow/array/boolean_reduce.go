@@ -0,0 +1,67 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+// All reports whether all the elements of b are true, following Kleene
+// logic: the result is false if any element is false, and otherwise null if
+// any element is null. valid is false when the result is null.
+// All is true for an empty array.
+func All(b *Boolean) (result bool, valid bool) {
+	valid = true
+	for i := 0; i < b.Len(); i++ {
+		switch {
+		case b.IsNull(i):
+			valid = false
+		case !b.Value(i):
+			return false, true
+		}
+	}
+	return valid, valid
+}
+
+// Any reports whether any element of b is true, following Kleene logic:
+// the result is true if any element is true, and otherwise null if any
+// element is null. valid is false when the result is null.
+// Any is false for an empty array.
+func Any(b *Boolean) (result bool, valid bool) {
+	valid = true
+	for i := 0; i < b.Len(); i++ {
+		switch {
+		case b.IsNull(i):
+			valid = false
+		case b.Value(i):
+			return true, true
+		}
+	}
+	return false, valid
+}
+
+// TrueCount returns the number of true elements of b, ignoring nulls.
+func TrueCount(b *Boolean) int {
+	n := 0
+	for i := 0; i < b.Len(); i++ {
+		if b.IsValid(i) && b.Value(i) {
+			n++
+		}
+	}
+	return n
+}
+
+// FalseCount returns the number of false elements of b, ignoring nulls.
+func FalseCount(b *Boolean) int {
+	return b.Len() - b.NullN() - TrueCount(b)
+}
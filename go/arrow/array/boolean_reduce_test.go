@@ -0,0 +1,89 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestBooleanReductions(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	type result struct {
+		v, valid bool
+	}
+
+	for _, tc := range []struct {
+		name          string
+		vs            []bool
+		valids        []bool
+		all, any      result
+		trues, falses int
+	}{
+		{name: "empty", all: result{true, true}, any: result{false, true}},
+		{
+			name: "all-true", vs: []bool{true, true},
+			all: result{true, true}, any: result{true, true}, trues: 2,
+		},
+		{
+			name: "all-false", vs: []bool{false, false, false},
+			all: result{false, true}, any: result{false, true}, falses: 3,
+		},
+		{
+			name: "all-null", vs: []bool{true, false}, valids: []bool{false, false},
+			all: result{false, false}, any: result{false, false},
+		},
+		{
+			name: "true-null", vs: []bool{true, false}, valids: []bool{true, false},
+			all: result{false, false}, any: result{true, true}, trues: 1,
+		},
+		{
+			name: "false-null", vs: []bool{false, true}, valids: []bool{true, false},
+			all: result{false, true}, any: result{false, false}, falses: 1,
+		},
+		{
+			name: "mixed", vs: []bool{true, false, true, true}, valids: []bool{true, true, false, true},
+			all: result{false, true}, any: result{true, true}, trues: 2, falses: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bldr := array.NewBooleanBuilder(mem)
+			defer bldr.Release()
+			bldr.AppendValues(tc.vs, tc.valids)
+
+			arr := bldr.NewBooleanArray()
+			defer arr.Release()
+
+			if v, valid := array.All(arr); (result{v, valid}) != tc.all {
+				t.Fatalf("invalid All: got=%v, want=%v", result{v, valid}, tc.all)
+			}
+			if v, valid := array.Any(arr); (result{v, valid}) != tc.any {
+				t.Fatalf("invalid Any: got=%v, want=%v", result{v, valid}, tc.any)
+			}
+			if got, want := array.TrueCount(arr), tc.trues; got != want {
+				t.Fatalf("invalid TrueCount: got=%d, want=%d", got, want)
+			}
+			if got, want := array.FalseCount(arr), tc.falses; got != want {
+				t.Fatalf("invalid FalseCount: got=%d, want=%d", got, want)
+			}
+		})
+	}
+}
@@ -286,3 +286,99 @@ func TestBooleanStringer(t *testing.T) {
 		t.Fatalf("invalid stringer:\ngot= %q\nwant=%q", got, want)
 	}
 }
+
+func TestNewBooleanFromBitmap(t *testing.T) {
+	// LSB packed bits with the following pattern:
+	// 01010011 11000101
+	data := memory.NewBufferBytes([]byte{0xca, 0xa3})
+
+	// LSB packed validity bitmap, where every 4th element is null:
+	// 11101110 11101110
+	valid := memory.NewBufferBytes([]byte{0x77, 0x77})
+
+	arr := array.NewBooleanFromBitmap(data, valid, 16, array.UnknownNullCount)
+	defer arr.Release()
+
+	if got, want := arr.Len(), 16; got != want {
+		t.Fatalf("invalid length: got=%d, want=%d", got, want)
+	}
+	if got, want := arr.NullN(), 4; got != want {
+		t.Fatalf("invalid null count: got=%d, want=%d", got, want)
+	}
+
+	const want = "[false true false (null) false false true (null) true true false (null) false true false (null)]"
+	if got := arr.String(); got != want {
+		t.Fatalf("invalid values:\ngot= %q\nwant=%q", got, want)
+	}
+
+	if got, want := arr.Data().Buffers()[1], data; got != want {
+		t.Fatalf("data buffer was not adopted")
+	}
+
+	t.Run("offset", func(t *testing.T) {
+		slice := array.NewSlice(arr, 5, 14).(*array.Boolean)
+		defer slice.Release()
+
+		if got, want := slice.NullN(), 2; got != want {
+			t.Fatalf("invalid null count: got=%d, want=%d", got, want)
+		}
+
+		const want = "[false true (null) true true false (null) false true]"
+		if got := slice.String(); got != want {
+			t.Fatalf("invalid values:\ngot= %q\nwant=%q", got, want)
+		}
+	})
+
+	t.Run("no-validity", func(t *testing.T) {
+		arr := array.NewBooleanFromBitmap(data, nil, 10, array.UnknownNullCount)
+		defer arr.Release()
+
+		if got, want := arr.NullN(), 0; got != want {
+			t.Fatalf("invalid null count: got=%d, want=%d", got, want)
+		}
+
+		const want = "[false true false true false false true true true true]"
+		if got := arr.String(); got != want {
+			t.Fatalf("invalid values:\ngot= %q\nwant=%q", got, want)
+		}
+	})
+
+	t.Run("short-buffer", func(t *testing.T) {
+		defer func() {
+			if e := recover(); e == nil {
+				t.Fatalf("expected a panic")
+			}
+		}()
+		array.NewBooleanFromBitmap(data, valid, 17, array.UnknownNullCount)
+	})
+}
+
+func TestBooleanRange(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewBooleanBuilder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]bool{true, false, true, true, false}, []bool{true, true, false, true, true})
+
+	arr := bldr.NewBooleanArray()
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 1, 5).(*array.Boolean)
+	defer slice.Release()
+
+	var got []string
+	slice.Range(func(i int, v bool, valid bool) bool {
+		switch {
+		case valid:
+			got = append(got, fmt.Sprint(v))
+		default:
+			got = append(got, "(null)")
+		}
+		return true
+	})
+
+	if got, want := strings.Join(got, " "), "false (null) true false"; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+}
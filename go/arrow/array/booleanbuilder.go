@@ -70,6 +70,18 @@ func (b *BooleanBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *BooleanBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *BooleanBuilder) AppendEmptyValue() {
+	b.Append(false)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *BooleanBuilder) UnsafeAppend(v bool) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	if v {
@@ -82,7 +94,8 @@ func (b *BooleanBuilder) UnsafeAppend(v bool) {
 
 func (b *BooleanBuilder) AppendValues(v []bool, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -90,12 +103,57 @@ func (b *BooleanBuilder) AppendValues(v []bool, valid []bool) {
 	}
 
 	b.Reserve(len(v))
-	for i, vv := range v {
-		bitutil.SetBitTo(b.rawData, b.length+i, vv)
-	}
+	packBools(b.rawData, b.length, v)
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The value and validity bitmaps are copied in bulk.
+func (b *BooleanBuilder) AppendArraySlice(src *Boolean, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	bitutil.CopyBitmap(src.values, src.Offset()+start, length, b.rawData, b.length)
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
+// packBools writes vs as LSB-ordered bits into buf, starting at bit index offset.
+// Whole bytes are assembled in a register and stored at once.
+func packBools(buf []byte, offset int, vs []bool) {
+	// leading bits, up to the next byte boundary.
+	for len(vs) > 0 && offset%8 != 0 {
+		bitutil.SetBitTo(buf, offset, vs[0])
+		offset++
+		vs = vs[1:]
+	}
+
+	out := buf[offset/8:]
+	n := len(vs) / 8
+	for i := 0; i < n; i++ {
+		var v byte
+		for j, vv := range vs[i*8 : i*8+8] {
+			if vv {
+				v |= bitutil.BitMask[j]
+			}
+		}
+		out[i] = v
+	}
+
+	// trailing bits.
+	offset += n * 8
+	for _, vv := range vs[n*8:] {
+		bitutil.SetBitTo(buf, offset, vv)
+		offset++
+	}
+}
+
 func (b *BooleanBuilder) init(capacity int) {
 	b.builder.init(capacity)
 
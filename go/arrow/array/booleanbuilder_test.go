@@ -88,3 +88,73 @@ func TestBooleanBuilder_Empty(t *testing.T) {
 	assert.Equal(t, want, boolValues(a))
 	a.Release()
 }
+
+func TestBooleanBuilder_AppendValuesPacking(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewBooleanBuilder(mem)
+	defer b.Release()
+
+	// same LSB packed pattern as Example_fromMemory:
+	// 01010011 11000101
+	vals := tools.Bools(0, 1, 0, 1, 0, 0, 1, 1, 1, 1, 0, 0, 0, 1, 0, 1)
+
+	b.AppendValues(vals, nil)
+	a := b.NewBooleanArray()
+	assert.Equal(t, []byte{0xca, 0xa3}, a.Data().Buffers()[1].Bytes())
+	a.Release()
+
+	// misaligned appends, spanning several bytes.
+	b.Append(true)
+	b.AppendNull()
+	b.AppendValues(vals, tools.Bools(1, 1, 1, 0, 1, 1, 1, 0, 1, 1, 1, 0, 1, 1, 1, 0))
+	b.AppendValues(vals[:5], nil)
+	a = b.NewBooleanArray()
+	defer a.Release()
+
+	want := append(append([]bool{true, false}, vals...), vals[:5]...)
+	assert.Equal(t, len(want), a.Len())
+	assert.Equal(t, 5, a.NullN())
+	for i, v := range want {
+		if a.IsValid(i) {
+			assert.Equal(t, v, a.Value(i), "value at index %d", i)
+		}
+	}
+}
+
+func BenchmarkBooleanBuilder(b *testing.B) {
+	const n = 1000000
+
+	vals := make([]bool, n)
+	for i := range vals {
+		vals[i] = i%3 == 0
+	}
+
+	mem := memory.NewGoAllocator()
+	b.Run("append", func(b *testing.B) {
+		bld := array.NewBooleanBuilder(mem)
+		defer bld.Release()
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, v := range vals {
+				bld.Append(v)
+			}
+			bld.NewBooleanArray().Release()
+		}
+	})
+
+	b.Run("append-values", func(b *testing.B) {
+		bld := array.NewBooleanBuilder(mem)
+		defer bld.Release()
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			bld.AppendValues(vals, nil)
+			bld.NewBooleanArray().Release()
+		}
+	})
+}
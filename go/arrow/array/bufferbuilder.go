@@ -19,7 +19,6 @@ package array
 import (
 	"sync/atomic"
 
-	"github.com/apache/arrow/go/arrow/bitutil"
 	"github.com/apache/arrow/go/arrow/internal/debug"
 	"github.com/apache/arrow/go/arrow/memory"
 )
@@ -32,6 +31,7 @@ type bufferBuilder struct {
 	buffer   *memory.Buffer
 	length   int
 	capacity int
+	factor   float64 // growth factor, or zero to grow to the next power of two.
 
 	bytes []byte
 }
@@ -56,6 +56,15 @@ func (b *bufferBuilder) Release() {
 	}
 }
 
+// setGrowth configures the growth of the buffer. Only the growth factor of g
+// applies, the minimum increment being a number of array elements.
+func (b *bufferBuilder) setGrowth(g growthPolicy) { b.factor = g.factor }
+
+// grow resizes the buffer to hold at least n bytes.
+func (b *bufferBuilder) grow(n int) {
+	b.resize(growthPolicy{factor: b.factor}.grow(b.capacity, n))
+}
+
 // Len returns the length of the memory buffer in bytes.
 func (b *bufferBuilder) Len() int { return b.length }
 
@@ -73,21 +82,16 @@ func (b *bufferBuilder) resize(elements int) {
 		b.buffer = memory.NewResizableBuffer(b.mem)
 	}
 
+	// the buffer zero-fills the bytes exposed by growing it.
 	b.buffer.Resize(elements)
-	oldCapacity := b.capacity
-	b.capacity = b.buffer.Cap()
-	b.bytes = b.buffer.Buf()
-
-	if b.capacity > oldCapacity {
-		memory.Set(b.bytes[oldCapacity:], 0)
-	}
+	b.capacity = b.buffer.Len()
+	b.bytes = b.buffer.Bytes()
 }
 
 // Advance increases the buffer by length and initializes the skipped bytes to zero.
 func (b *bufferBuilder) Advance(length int) {
 	if b.capacity < b.length+length {
-		newCapacity := bitutil.NextPowerOf2(b.length + length)
-		b.resize(newCapacity)
+		b.grow(b.length + length)
 	}
 	b.length += length
 }
@@ -95,8 +99,7 @@ func (b *bufferBuilder) Advance(length int) {
 // Append appends the contents of v to the buffer, resizing it if necessary.
 func (b *bufferBuilder) Append(v []byte) {
 	if b.capacity < b.length+len(v) {
-		newCapacity := bitutil.NextPowerOf2(b.length + len(v))
-		b.resize(newCapacity)
+		b.grow(b.length + len(v))
 	}
 	b.unsafeAppend(v)
 }
@@ -125,3 +128,8 @@ func (b *bufferBuilder) unsafeAppend(data []byte) {
 	copy(b.bytes[b.length:], data)
 	b.length += len(data)
 }
+
+func (b *bufferBuilder) unsafeAppendString(data string) {
+	copy(b.bytes[b.length:], data)
+	b.length += len(data)
+}
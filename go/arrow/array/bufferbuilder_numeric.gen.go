@@ -20,10 +20,41 @@ package array
 
 import (
 	"github.com/apache/arrow/go/arrow"
-	"github.com/apache/arrow/go/arrow/bitutil"
 	"github.com/apache/arrow/go/arrow/memory"
 )
 
+type int64BufferBuilder struct {
+	bufferBuilder
+}
+
+func newInt64BufferBuilder(mem memory.Allocator) *int64BufferBuilder {
+	return &int64BufferBuilder{bufferBuilder: bufferBuilder{refCount: 1, mem: mem}}
+}
+
+// AppendValues appends the contents of v to the buffer, growing the buffer as needed.
+func (b *int64BufferBuilder) AppendValues(v []int64) { b.Append(arrow.Int64Traits.CastToBytes(v)) }
+
+// Values returns a slice of length b.Len().
+// The slice is only valid for use until the next buffer modification. That is, until the next call
+// to Advance, Reset, Finish or any Append function. The slice aliases the buffer content at least until the next
+// buffer modification.
+func (b *int64BufferBuilder) Values() []int64 { return arrow.Int64Traits.CastFromBytes(b.Bytes()) }
+
+// Value returns the int64 element at the index i. Value will panic if i is negative or ≥ Len.
+func (b *int64BufferBuilder) Value(i int) int64 { return b.Values()[i] }
+
+// Len returns the number of int64 elements in the buffer.
+func (b *int64BufferBuilder) Len() int { return b.length / arrow.Int64SizeBytes }
+
+// AppendValue appends v to the buffer, growing the buffer as needed.
+func (b *int64BufferBuilder) AppendValue(v int64) {
+	if b.capacity < b.length+arrow.Int64SizeBytes {
+		b.grow(b.length + arrow.Int64SizeBytes)
+	}
+	arrow.Int64Traits.PutValue(b.bytes[b.length:], v)
+	b.length += arrow.Int64SizeBytes
+}
+
 type int32BufferBuilder struct {
 	bufferBuilder
 }
@@ -50,8 +81,7 @@ func (b *int32BufferBuilder) Len() int { return b.length / arrow.Int32SizeBytes
 // AppendValue appends v to the buffer, growing the buffer as needed.
 func (b *int32BufferBuilder) AppendValue(v int32) {
 	if b.capacity < b.length+arrow.Int32SizeBytes {
-		newCapacity := bitutil.NextPowerOf2(b.length + arrow.Int32SizeBytes)
-		b.resize(newCapacity)
+		b.grow(b.length + arrow.Int32SizeBytes)
 	}
 	arrow.Int32Traits.PutValue(b.bytes[b.length:], v)
 	b.length += arrow.Int32SizeBytes
@@ -51,6 +51,14 @@ type Builder interface {
 	// AppendNull adds a new null value to the array being built.
 	AppendNull()
 
+	// AppendNulls adds n null values to the array being built.
+	AppendNulls(n int)
+
+	// AppendEmptyValue adds a new valid, empty value to the array being
+	// built: the zero value of fixed-width types, an empty string, binary
+	// or list value, or a struct value holding empty field values.
+	AppendEmptyValue()
+
 	// Reserve ensures there is enough space for appending n elements
 	// by checking the capacity and calling Resize if necessary.
 	Reserve(n int)
@@ -64,8 +72,20 @@ type Builder interface {
 	// a new array.
 	NewArray() Interface
 
+	// SetCollectErrors configures how the builder handles invalid calls,
+	// such as appending values and validities of different lengths.
+	// By default, the builder panics. When v is true, the builder ignores
+	// the invalid call instead, and records its error, retrievable with Err.
+	SetCollectErrors(v bool)
+
+	// Err returns the first error recorded by the builder since it was last
+	// reset, when collecting errors. See SetCollectErrors.
+	Err() error
+
 	init(capacity int)
 	resize(newBits int, init func(int))
+	collectErrors() bool
+	setGrowth(g growthPolicy)
 }
 
 // builder provides common functionality for managing the validity bitmap (nulls) when building arrays.
@@ -76,6 +96,63 @@ type builder struct {
 	nulls      int
 	length     int
 	capacity   int
+	prevCap    int // capacity of the previously built array, used as an allocation hint if growth.reuseCap.
+
+	collectErrs bool  // whether invalid calls are recorded in err instead of panicking.
+	err         error // first error recorded since the last reset.
+
+	growth growthPolicy
+}
+
+// growthPolicy describes how the capacity of a builder grows when appending
+// past it.
+type growthPolicy struct {
+	factor   float64 // capacity multiplier, or zero to grow to the next power of two.
+	minInc   int     // minimum capacity increment.
+	reuseCap bool    // whether a reset builder first allocates its previous capacity.
+}
+
+// grow returns the new capacity of a builder of capacity c, which must hold
+// at least n elements.
+func (g growthPolicy) grow(c, n int) int {
+	newCap := n
+	switch g.factor {
+	case 0:
+		newCap = bitutil.NextPowerOf2(n)
+	default:
+		if v := int(float64(c) * g.factor); v > newCap {
+			newCap = v
+		}
+	}
+	if v := c + g.minInc; v > newCap {
+		newCap = v
+	}
+	return newCap
+}
+
+func (b *builder) setGrowth(g growthPolicy) { b.growth = g }
+
+// SetCollectErrors configures how the builder handles invalid calls.
+// By default, the builder panics. When v is true, the builder ignores
+// the invalid call instead, and records its error, retrievable with Err.
+func (b *builder) SetCollectErrors(v bool) { b.collectErrs = v }
+
+// Err returns the first error recorded by the builder since it was last
+// reset, when collecting errors.
+func (b *builder) Err() error { return b.err }
+
+func (b *builder) collectErrors() bool { return b.collectErrs }
+
+// invalid handles an invalid call to the op method of bldr.
+// invalid panics with v, unless the builder collects errors, in which case
+// the first such error is recorded.
+func (b *builder) invalid(bldr Builder, op string, v interface{}) {
+	if !b.collectErrs {
+		panic(v)
+	}
+	if b.err == nil {
+		b.err = fmt.Errorf("arrow/array: %T.%s: %v", bldr, op, v)
+	}
 }
 
 // Retain increases the reference count by 1.
@@ -109,6 +186,10 @@ func (b *builder) reset() {
 
 	b.nulls = 0
 	b.length = 0
+	b.err = nil
+	if b.growth.reuseCap && b.capacity > 0 {
+		b.prevCap = b.capacity
+	}
 	b.capacity = 0
 }
 
@@ -119,13 +200,8 @@ func (b *builder) resize(newBits int, init func(int)) {
 	}
 
 	newBytesN := bitutil.CeilByte(newBits) / 8
-	oldBytesN := b.nullBitmap.Len()
 	b.nullBitmap.Resize(newBytesN)
 	b.capacity = newBits
-	if oldBytesN < newBytesN {
-		// TODO(sgc): necessary?
-		memory.Set(b.nullBitmap.Buf()[oldBytesN:], 0)
-	}
 	if newBits < b.length {
 		b.length = newBits
 		b.nulls = newBits - bitutil.CountSetBits(b.nullBitmap.Buf(), 0, newBits)
@@ -134,7 +210,10 @@ func (b *builder) resize(newBits int, init func(int)) {
 
 func (b *builder) reserve(elements int, resize func(int)) {
 	if b.length+elements > b.capacity {
-		newCap := bitutil.NextPowerOf2(b.length + elements)
+		newCap := b.growth.grow(b.capacity, b.length+elements)
+		if b.growth.reuseCap && b.capacity == 0 && newCap < b.prevCap {
+			newCap = b.prevCap
+		}
 		resize(newCap)
 	}
 }
@@ -175,30 +254,46 @@ func (b *builder) unsafeAppendBoolsToBitmap(valid []bool, length int) {
 	b.length += len(valid)
 }
 
-// unsafeSetValid sets the next length bits to valid in the validity bitmap.
-func (b *builder) unsafeSetValid(length int) {
-	padToByte := min(8-(b.length%8), length)
-	if padToByte == 8 {
-		padToByte = 0
-	}
-	bits := b.nullBitmap.Bytes()
-	for i := b.length; i < b.length+padToByte; i++ {
-		bitutil.SetBit(bits, i)
+// validArraySlice reports whether [start:start+length] is within the bounds
+// of arr, as required by the AppendArraySlice methods.
+func validArraySlice(arr Interface, start, length int) bool {
+	return start >= 0 && length >= 0 && start+length <= arr.Len()
+}
+
+// unsafeAppendBitmap appends the length validity bits of bitmap starting at
+// bit index offset to the validity bitmap.
+// If bitmap is empty, the next length bits are set to valid (not null).
+func (b *builder) unsafeAppendBitmap(bitmap []byte, offset, length int) {
+	if len(bitmap) == 0 {
+		b.unsafeSetValid(length)
+		return
 	}
 
-	start := (b.length + padToByte) / 8
-	fastLength := (length - padToByte) / 8
-	memory.Set(bits[start:start+fastLength], 0xff)
+	bitutil.CopyBitmap(bitmap, offset, length, b.nullBitmap.Bytes(), b.length)
+	b.nulls += length - bitutil.CountSetBits(bitmap, offset, length)
+	b.length += length
+}
 
-	newLength := b.length + length
-	// trailing bytes
-	for i := b.length + padToByte + (fastLength * 8); i < newLength; i++ {
-		bitutil.SetBit(bits, i)
+// unsafeAppendNulls appends n null slots to the validity bitmap.
+func (b *builder) unsafeAppendNulls(n int) {
+	if n <= 0 {
+		return
 	}
+	bitutil.SetBitsTo(b.nullBitmap.Bytes(), int64(b.length), int64(n), false)
+	b.length += n
+	b.nulls += n
+}
 
-	b.length = newLength
+// unsafeSetValid sets the next length bits to valid in the validity bitmap.
+func (b *builder) unsafeSetValid(length int) {
+	bitutil.SetBitsTo(b.nullBitmap.Bytes(), int64(b.length), int64(length), true)
+	b.length += length
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -208,10 +303,111 @@ func (b *builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	b.length++
 }
 
+// BuilderOption configures the builders created by NewBuilder and
+// NewRecordBuilder.
+type BuilderOption func(*builderConfig)
+
+type builderConfig struct {
+	growth growthPolicy
+}
+
+// WithGrowthFactor configures the builders to multiply their capacity by f
+// when appending past it, instead of growing it to the next power of two.
+// Lower factors, such as 1.5, lower the peak memory usage of large builds,
+// at the cost of more reallocations.
+//
+// WithGrowthFactor panics if f is not greater than 1.
+func WithGrowthFactor(f float64) BuilderOption {
+	if !(f > 1) {
+		panic(fmt.Errorf("arrow/array: invalid growth factor %v (must be > 1)", f))
+	}
+	return func(cfg *builderConfig) {
+		cfg.growth.factor = f
+	}
+}
+
+// WithMinGrowth configures the builders to grow their capacity by at least
+// n elements when appending past it. The minimum increment does not apply
+// to the value bytes of binary and string builders.
+//
+// WithMinGrowth panics if n is negative.
+func WithMinGrowth(n int) BuilderOption {
+	if n < 0 {
+		panic(fmt.Errorf("arrow/array: invalid minimum growth %d", n))
+	}
+	return func(cfg *builderConfig) {
+		cfg.growth.minInc = n
+	}
+}
+
+// WithReuseCapacity configures the builders to remember their capacity when
+// building an array: the first append after NewArray allocates that capacity
+// at once, instead of growing the buffers from a small size. Builders reused
+// across batches of similar sizes thus perform a single allocation per buffer
+// and per batch, but keep allocating the capacity of the largest recent batch.
+func WithReuseCapacity(v bool) BuilderOption {
+	return func(cfg *builderConfig) {
+		cfg.growth.reuseCap = v
+	}
+}
+
+// NewBuilder returns a new builder for arrays of the provided data type,
+// configured with opts. Nested builders apply opts to their child builders.
+//
+// NewBuilder panics if there is no builder for that data type.
+func NewBuilder(mem memory.Allocator, dtype arrow.DataType, opts ...BuilderOption) Builder {
+	b := newBuilder(mem, dtype)
+	if len(opts) > 0 {
+		var cfg builderConfig
+		for _, opt := range opts {
+			opt(&cfg)
+		}
+		b.setGrowth(cfg.growth)
+	}
+	return b
+}
+
+// NewArrayChecked creates a new array from the memory buffers used by b and
+// resets b, like b.NewArray.
+// If b recorded an error, or if its appended values do not make a valid
+// array, NewArrayChecked returns that error and no array: b is reset and the
+// values appended since the last reset are discarded.
+// See Builder.SetCollectErrors.
+func NewArrayChecked(b Builder) (Interface, error) {
+	err := b.Err()
+	if c, ok := b.(newArrayChecker); ok && err == nil {
+		err = c.checkNewArray()
+	}
+	if err != nil {
+		// err is returned: discarding the values must not report it again.
+		collect := b.collectErrors()
+		b.SetCollectErrors(true)
+		b.NewArray().Release()
+		b.SetCollectErrors(collect)
+		return nil, err
+	}
+	return b.NewArray(), nil
+}
+
+// newArrayChecker is implemented by the builders which can only validate
+// their appended values when the array is built, such as list builders
+// given offsets ahead of the values they refer to.
+type newArrayChecker interface {
+	// checkNewArray returns the error NewArray would report, if any.
+	checkNewArray() error
+}
+
+// NewDefaultBuilder returns a builder for the data type dtype, as with
+// NewBuilder, using memory.DefaultAllocator at the time of the call.
+func NewDefaultBuilder(dtype arrow.DataType) Builder {
+	return NewBuilder(memory.DefaultAllocator, dtype)
+}
+
 func newBuilder(mem memory.Allocator, dtype arrow.DataType) Builder {
 	// FIXME(sbinet): use a type switch on dtype instead?
 	switch dtype.ID() {
 	case arrow.NULL:
+		return NewNullBuilder(mem)
 	case arrow.BOOL:
 		return NewBooleanBuilder(mem)
 	case arrow.UINT8:
@@ -244,8 +440,12 @@ func newBuilder(mem memory.Allocator, dtype arrow.DataType) Builder {
 		typ := dtype.(*arrow.FixedSizeBinaryType)
 		return NewFixedSizeBinaryBuilder(mem, typ)
 	case arrow.DATE32:
+		return NewDate32Builder(mem)
 	case arrow.DATE64:
+		return NewDate64Builder(mem)
 	case arrow.TIMESTAMP:
+		typ := dtype.(*arrow.TimestampType)
+		return NewTimestampBuilder(mem, typ)
 	case arrow.TIME32:
 		typ := dtype.(*arrow.Time32Type)
 		return NewTime32Builder(mem, typ)
@@ -253,7 +453,18 @@ func newBuilder(mem memory.Allocator, dtype arrow.DataType) Builder {
 		typ := dtype.(*arrow.Time64Type)
 		return NewTime64Builder(mem, typ)
 	case arrow.INTERVAL:
+		switch dtype.(type) {
+		case *arrow.MonthIntervalType:
+			return NewMonthIntervalBuilder(mem)
+		case *arrow.DayTimeIntervalType:
+			return NewDayTimeIntervalBuilder(mem)
+		}
 	case arrow.DECIMAL:
+		typ := dtype.(*arrow.Decimal128Type)
+		return NewDecimal128Builder(mem, typ)
+	case arrow.DECIMAL256:
+		typ := dtype.(*arrow.Decimal256Type)
+		return NewDecimal256Builder(mem, typ)
 	case arrow.LIST:
 		typ := dtype.(*arrow.ListType)
 		return NewListBuilder(mem, typ.Elem())
@@ -262,12 +473,39 @@ func newBuilder(mem memory.Allocator, dtype arrow.DataType) Builder {
 		return NewStructBuilder(mem, typ)
 	case arrow.UNION:
 	case arrow.DICTIONARY:
+		typ := dtype.(*arrow.DictionaryType)
+		return NewDictionaryBuilder(mem, typ)
 	case arrow.MAP:
 	case arrow.EXTENSION:
 	case arrow.FIXED_SIZE_LIST:
 		typ := dtype.(*arrow.FixedSizeListType)
 		return NewFixedSizeListBuilder(mem, typ.Len(), typ.Elem())
 	case arrow.DURATION:
+		typ := dtype.(*arrow.DurationType)
+		return NewDurationBuilder(mem, typ)
+	case arrow.LARGE_STRING:
+		return NewLargeStringBuilder(mem)
+	case arrow.LARGE_BINARY:
+		return NewBinaryBuilder(mem, arrow.BinaryTypes.LargeBinary)
+	case arrow.LARGE_LIST:
+		typ := dtype.(*arrow.LargeListType)
+		return NewLargeListBuilder(mem, typ.Elem())
+	case arrow.RUN_END_ENCODED:
+		typ := dtype.(*arrow.RunEndEncodedType)
+		return NewRunEndEncodedBuilder(mem, typ.RunEnds, typ.Values)
 	}
 	panic(fmt.Errorf("arrow/array: unsupported builder for %T", dtype))
 }
+
+// checkTimeUnit returns an error if the unit of the Time32 or Time64 type
+// dtype is not valid for its bit width: seconds or milliseconds for Time32,
+// microseconds or nanoseconds for Time64.
+func checkTimeUnit(dtype arrow.DataType) (err error) {
+	switch dt := dtype.(type) {
+	case *arrow.Time32Type:
+		_, err = arrow.NewTime32Type(dt.Unit)
+	case *arrow.Time64Type:
+		_, err = arrow.NewTime64Type(dt.Unit)
+	}
+	return err
+}
@@ -17,8 +17,10 @@
 package array
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/internal/testing/tools"
 	"github.com/apache/arrow/go/arrow/memory"
 	"github.com/stretchr/testify/assert"
@@ -81,3 +83,456 @@ func TestBuilder_resize(t *testing.T) {
 	assert.Equal(t, n, b.Len())
 	assert.Equal(t, n-1, b.NullN())
 }
+
+func TestNewBuilder(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, dtype := range []arrow.DataType{
+		arrow.FixedWidthTypes.Date32,
+		arrow.FixedWidthTypes.Date64,
+		arrow.FixedWidthTypes.Timestamp_ms,
+		arrow.FixedWidthTypes.Duration_s,
+		arrow.FixedWidthTypes.Duration_ns,
+		arrow.FixedWidthTypes.MonthInterval,
+		arrow.FixedWidthTypes.DayTimeInterval,
+		arrow.StructOf(
+			arrow.Field{Name: "d", Type: arrow.FixedWidthTypes.Duration_us},
+			arrow.Field{Name: "i", Type: arrow.FixedWidthTypes.DayTimeInterval},
+		),
+	} {
+		t.Run(dtype.Name(), func(t *testing.T) {
+			b := NewBuilder(mem, dtype)
+			defer b.Release()
+
+			b.AppendNull()
+			arr := b.NewArray()
+			defer arr.Release()
+
+			assert.True(t, arrow.TypeEquals(arr.DataType(), dtype), "invalid type %v", arr.DataType())
+			assert.Equal(t, 1, arr.Len())
+			assert.Equal(t, 1, arr.NullN())
+		})
+	}
+}
+
+// TestBuilderAppendValues runs the same AppendValues scenarios across all the
+// fixed-width primitive builders.
+func TestBuilderAppendValues(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	builders := map[string]func() Builder{
+		"bool":     func() Builder { return NewBooleanBuilder(mem) },
+		"decimal":  func() Builder { return NewDecimal128Builder(mem, &arrow.Decimal128Type{Precision: 10, Scale: 2}) },
+		"float16":  func() Builder { return NewFloat16Builder(mem) },
+		"interval": func() Builder { return NewBuilder(mem, arrow.FixedWidthTypes.DayTimeInterval) },
+	}
+	for _, dtype := range []arrow.DataType{
+		arrow.PrimitiveTypes.Int8, arrow.PrimitiveTypes.Int16, arrow.PrimitiveTypes.Int32, arrow.PrimitiveTypes.Int64,
+		arrow.PrimitiveTypes.Uint8, arrow.PrimitiveTypes.Uint16, arrow.PrimitiveTypes.Uint32, arrow.PrimitiveTypes.Uint64,
+		arrow.PrimitiveTypes.Float32, arrow.PrimitiveTypes.Float64,
+		arrow.PrimitiveTypes.Date32, arrow.PrimitiveTypes.Date64,
+		arrow.FixedWidthTypes.Time32s, arrow.FixedWidthTypes.Time64us,
+		arrow.FixedWidthTypes.Timestamp_ms, arrow.FixedWidthTypes.Duration_ns,
+		arrow.FixedWidthTypes.MonthInterval,
+	} {
+		dtype := dtype
+		builders[dtype.Name()] = func() Builder { return NewBuilder(mem, dtype) }
+	}
+
+	const n = 10
+	appendValues := func(b Builder, valid []bool) {
+		m := reflect.ValueOf(b).MethodByName("AppendValues")
+		vs := reflect.MakeSlice(m.Type().In(0), n, n)
+		m.Call([]reflect.Value{vs, reflect.ValueOf(valid)})
+	}
+
+	for name, newBuilder := range builders {
+		t.Run(name, func(t *testing.T) {
+			b := newBuilder()
+			defer b.Release()
+
+			t.Run("nil-valid", func(t *testing.T) {
+				appendValues(b, nil)
+				assert.Equal(t, n, b.Len())
+				assert.Equal(t, 0, b.NullN())
+				assert.True(t, b.Cap() >= n, "values should have been reserved")
+
+				arr := b.NewArray()
+				defer arr.Release()
+				assert.Equal(t, n, arr.Len())
+				assert.Equal(t, 0, arr.NullN())
+			})
+
+			t.Run("explicit-valid", func(t *testing.T) {
+				valid := make([]bool, n)
+				for i := range valid {
+					valid[i] = i%3 != 0
+				}
+				b.AppendNull()
+				appendValues(b, valid)
+				assert.Equal(t, n+1, b.Len())
+				assert.Equal(t, 5, b.NullN())
+
+				arr := b.NewArray()
+				defer arr.Release()
+				assert.True(t, arr.IsNull(0))
+				for i, v := range valid {
+					assert.Equal(t, v, arr.IsValid(i+1), "invalid validity at %d", i+1)
+				}
+			})
+
+			t.Run("mismatched-lengths", func(t *testing.T) {
+				assert.Panics(t, func() { appendValues(b, make([]bool, n-1)) })
+				assert.Equal(t, 0, b.Len())
+
+				b.SetCollectErrors(true)
+				defer b.SetCollectErrors(false)
+				assert.NotPanics(t, func() { appendValues(b, make([]bool, n-1)) })
+				assert.Error(t, b.Err())
+				assert.Equal(t, 0, b.Len())
+			})
+		})
+	}
+}
+
+func TestBuilderCapacityReuse(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := NewBuilder(mem, arrow.PrimitiveTypes.Int64, WithReuseCapacity(true)).(*Int64Builder)
+	defer b.Release()
+
+	for i := 0; i < 1000; i++ {
+		b.Append(int64(i))
+	}
+	assert.Equal(t, 1024, b.Cap())
+
+	arr := b.NewArray()
+	arr.Release()
+
+	assert.Equal(t, 0, b.Len())
+	assert.Equal(t, 0, b.Cap(), "buffers should have been handed over to the array")
+
+	b.Append(1)
+	assert.Equal(t, 1024, b.Cap(), "capacity of the previous array should have been allocated")
+
+	// the hint tracks the capacity of the last built array.
+	arr = b.NewArray()
+	arr.Release()
+	b.Reserve(2000)
+	assert.Equal(t, 2048, b.Cap())
+}
+
+func TestBuilderCapacityNoReuse(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := NewInt64Builder(mem)
+	defer b.Release()
+
+	for i := 0; i < 1000; i++ {
+		b.Append(int64(i))
+	}
+	b.NewArray().Release()
+
+	// by default, a small batch after a large one only allocates what it needs.
+	b.Append(1)
+	assert.Equal(t, minBuilderCapacity, b.Cap())
+}
+
+func TestBuilderGrowth(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const n = 140000
+
+	def := NewBuilder(mem, arrow.PrimitiveTypes.Int64).(*Int64Builder)
+	defer def.Release()
+	b := NewBuilder(mem, arrow.PrimitiveTypes.Int64, WithGrowthFactor(1.5)).(*Int64Builder)
+	defer b.Release()
+	for i := 0; i < n; i++ {
+		def.Append(int64(i))
+		b.Append(int64(i))
+	}
+	assert.Equal(t, 262144, def.Cap())
+	assert.True(t, b.Cap() >= n && b.Cap() < n*3/2, "invalid capacity %d for %d elements", b.Cap(), n)
+
+	sb := NewBuilder(mem, arrow.BinaryTypes.String, WithGrowthFactor(1.5)).(*StringBuilder)
+	defer sb.Release()
+	for i := 0; i < n; i++ {
+		sb.Append("x")
+	}
+	assert.True(t, sb.Cap() >= n && sb.Cap() < n*3/2, "invalid capacity %d for %d elements", sb.Cap(), n)
+	values := sb.builder.values.Cap()
+	assert.True(t, values >= n && values < n*3/2, "invalid values capacity %d for %d bytes", values, n)
+
+	mb := NewBuilder(mem, arrow.PrimitiveTypes.Int64, WithMinGrowth(1000)).(*Int64Builder)
+	defer mb.Release()
+	mb.Append(1)
+	assert.Equal(t, 1000, mb.Cap())
+	mb.AppendValues(make([]int64, 1000), nil)
+	assert.Equal(t, 2000, mb.Cap())
+
+	// options apply to nested builders.
+	rb := NewRecordBuilder(mem, arrow.NewSchema([]arrow.Field{
+		{Name: "s", Type: arrow.StructOf(arrow.Field{Name: "l", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32)})},
+	}, nil), WithMinGrowth(100))
+	defer rb.Release()
+	lb := rb.Field(0).(*StructBuilder).FieldBuilder(0).(*ListBuilder)
+	lb.ValueBuilder().AppendNull()
+	assert.Equal(t, 100, lb.ValueBuilder().Cap())
+
+	assert.Panics(t, func() { WithGrowthFactor(1) })
+	assert.Panics(t, func() { WithMinGrowth(-1) })
+}
+
+func TestBuilderCollectErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	t.Run("default-panics", func(t *testing.T) {
+		b := NewInt64Builder(mem)
+		defer b.Release()
+
+		assert.Panics(t, func() { b.AppendValues([]int64{1, 2}, []bool{true}) })
+		assert.NoError(t, b.Err())
+	})
+
+	for _, tc := range []struct {
+		name   string
+		bldr   Builder
+		append func(b Builder)
+		err    string
+	}{
+		{
+			name: "int64",
+			bldr: NewInt64Builder(mem),
+			append: func(b Builder) {
+				b.(*Int64Builder).AppendValues([]int64{1, 2}, []bool{true})
+			},
+			err: "arrow/array: *array.Int64Builder.AppendValues: len(v) != len(valid) && len(valid) != 0",
+		},
+		{
+			name: "string",
+			bldr: NewStringBuilder(mem),
+			append: func(b Builder) {
+				b.(*StringBuilder).AppendValues([]string{"a"}, []bool{true, false})
+			},
+			err: "arrow/array: *array.StringBuilder.AppendValues: len(v) != len(valid) && len(valid) != 0",
+		},
+		{
+			name: "fixed-size-binary",
+			bldr: NewFixedSizeBinaryBuilder(mem, &arrow.FixedSizeBinaryType{ByteWidth: 2}),
+			append: func(b Builder) {
+				b.(*FixedSizeBinaryBuilder).AppendValues([][]byte{[]byte("ab"), []byte("abc")}, nil)
+			},
+			err: "arrow/array: *array.FixedSizeBinaryBuilder.AppendValues: invalid binary length (got=3, want=2)",
+		},
+		{
+			name: "list-values",
+			bldr: NewListBuilder(mem, arrow.PrimitiveTypes.Float64),
+			append: func(b Builder) {
+				lb := b.(*ListBuilder)
+				lb.Append(true)
+				lb.ValueBuilder().(*Float64Builder).AppendValues([]float64{1}, []bool{true, true})
+			},
+			err: "arrow/array: *array.Float64Builder.AppendValues: len(v) != len(valid) && len(valid) != 0",
+		},
+		{
+			name: "struct-field",
+			bldr: NewStructBuilder(mem, arrow.StructOf(arrow.Field{Name: "f", Type: arrow.FixedWidthTypes.Boolean})),
+			append: func(b Builder) {
+				sb := b.(*StructBuilder)
+				sb.Append(true)
+				sb.FieldBuilder(0).(*BooleanBuilder).AppendValues([]bool{true}, []bool{true, true})
+			},
+			err: "arrow/array: *array.BooleanBuilder.AppendValues: len(v) != len(valid) && len(valid) != 0",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b := tc.bldr
+			defer b.Release()
+
+			b.SetCollectErrors(true)
+			b.AppendNull()
+			assert.NotPanics(t, func() { tc.append(b) })
+			b.AppendNull()
+
+			arr, err := NewArrayChecked(b)
+			assert.Nil(t, arr)
+			if assert.Error(t, err) {
+				assert.Equal(t, tc.err, err.Error())
+			}
+
+			// the builder was reset: it can be reused.
+			assert.Equal(t, 0, b.Len())
+			assert.NoError(t, b.Err())
+
+			b.AppendNull()
+			arr, err = NewArrayChecked(b)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, arr.Len())
+			arr.Release()
+		})
+	}
+}
+
+func BenchmarkBuilderCycles(b *testing.B) {
+	const n = 10000
+
+	build := func(bldr *Int64Builder) {
+		for i := 0; i < n; i++ {
+			bldr.Append(int64(i))
+		}
+		bldr.NewArray().Release()
+	}
+
+	b.Run("new-builder", func(b *testing.B) {
+		mem := memory.NewGoAllocator()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bldr := NewInt64Builder(mem)
+			build(bldr)
+			bldr.Release()
+		}
+	})
+
+	b.Run("reused-builder", func(b *testing.B) {
+		mem := memory.NewGoAllocator()
+		bldr := NewBuilder(mem, arrow.PrimitiveTypes.Int64, WithReuseCapacity(true)).(*Int64Builder)
+		defer bldr.Release()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			build(bldr)
+		}
+	})
+}
+
+func BenchmarkBuilderFill(b *testing.B) {
+	const n = 10000
+
+	mem := memory.NewGoAllocator()
+	bldr := NewInt64Builder(mem)
+	defer bldr.Release()
+
+	b.Run("append", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < n; j++ {
+				bldr.Append(int64(j))
+			}
+			bldr.NewArray().Release()
+		}
+	})
+
+	b.Run("reserve-unsafe-append", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			bldr.Reserve(n)
+			for j := 0; j < n; j++ {
+				bldr.UnsafeAppend(int64(j))
+			}
+			bldr.NewArray().Release()
+		}
+	})
+}
+
+func TestDefaultBuilder(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	defer memory.SetDefaultAllocator(memory.SetDefaultAllocator(mem))
+
+	b := NewDefaultBuilder(arrow.PrimitiveTypes.Int64).(*Int64Builder)
+	b.AppendValues([]int64{1, 2, 3}, nil)
+	assert.NotZero(t, mem.CurrentAlloc(), "builder must allocate with the default allocator")
+
+	arr := b.NewArray()
+	b.Release()
+	assert.NotZero(t, mem.CurrentAlloc(), "array must hold memory of the default allocator")
+	arr.Release()
+	mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "s", Type: arrow.BinaryTypes.String}}, nil)
+	rb := NewDefaultRecordBuilder(schema)
+	rb.Field(0).(*StringBuilder).Append("a")
+	assert.NotZero(t, mem.CurrentAlloc(), "record builder must allocate with the default allocator")
+	rb.Release()
+}
+
+func TestBuilderAppendNulls(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const n = 1000
+	for _, dtype := range []arrow.DataType{
+		arrow.Null,
+		arrow.FixedWidthTypes.Boolean,
+		arrow.PrimitiveTypes.Int64,
+		arrow.FixedWidthTypes.Float16,
+		arrow.FixedWidthTypes.DayTimeInterval,
+		&arrow.FixedSizeBinaryType{ByteWidth: 3},
+		arrow.BinaryTypes.String,
+		arrow.BinaryTypes.LargeString,
+		arrow.ListOf(arrow.PrimitiveTypes.Int32),
+		arrow.LargeListOf(arrow.BinaryTypes.String),
+		arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int8),
+		arrow.StructOf(
+			arrow.Field{Name: "i", Type: arrow.PrimitiveTypes.Int32},
+			arrow.Field{Name: "l", Type: arrow.ListOf(arrow.BinaryTypes.String)},
+		),
+	} {
+		t.Run(dtype.Name(), func(t *testing.T) {
+			b := NewBuilder(mem, dtype)
+			defer b.Release()
+
+			b.AppendEmptyValue()
+			b.AppendNulls(n)
+			b.AppendNulls(0)
+			b.AppendEmptyValue()
+
+			arr := b.NewArray()
+			defer arr.Release()
+
+			nulls := n
+			if dtype.ID() == arrow.NULL {
+				nulls += 2
+			}
+			assert.Equal(t, n+2, arr.Len())
+			assert.Equal(t, nulls, arr.NullN())
+			if dtype.ID() != arrow.NULL {
+				assert.True(t, arr.IsValid(0))
+				assert.True(t, arr.IsValid(n+1))
+			}
+			for i := 1; i <= n; i++ {
+				if arr.IsValid(i) {
+					t.Fatalf("element %d should be null", i)
+				}
+			}
+
+			switch arr := arr.(type) {
+			case *String:
+				assert.Equal(t, "", arr.Value(n+1))
+				assert.Equal(t, 0, arr.ValueOffset(n+1))
+			case *List:
+				offsets := arr.Offsets()
+				assert.Equal(t, n+3, len(offsets))
+				for i, off := range offsets {
+					if off != 0 {
+						t.Fatalf("offset %d should be 0, got=%d", i, off)
+					}
+				}
+			case *FixedSizeList:
+				assert.Equal(t, 2*(n+2), arr.ListValues().Len())
+				assert.Equal(t, 2*n, arr.ListValues().NullN())
+			case *Struct:
+				for i := 0; i < arr.NumField(); i++ {
+					f := arr.Field(i)
+					assert.Equal(t, n+2, f.Len())
+					assert.Equal(t, n, f.NullN())
+				}
+				assert.Equal(t, int32(0), arr.Field(0).(*Int32).Value(n+1))
+				assert.Equal(t, []int32{0, 0}, arr.Field(1).(*List).Offsets()[n+1:])
+			}
+		})
+	}
+}
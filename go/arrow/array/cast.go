@@ -0,0 +1,188 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// CastOption configures the Cast kernel.
+type CastOption func(*castConfig)
+
+type castConfig struct {
+	errOnParse bool
+}
+
+// WithErrorOnParseFailure specifies whether Cast returns an error when a value
+// cannot be parsed into the target data type. Otherwise, such values are
+// converted to nulls. The default is false.
+func WithErrorOnParseFailure(v bool) CastOption {
+	return func(cfg *castConfig) {
+		cfg.errOnParse = v
+	}
+}
+
+// Cast returns a new array holding the elements of arr converted to the
+// data type to.
+//
+// Cast supports String and LargeString arrays, parsing their elements into
+// integer, floating-point, boolean, date and timestamp arrays. Floating-point
+// values may use the scientific notation, dates are parsed in the
+// "2006-01-02" layout and timestamps in the RFC 3339 layout. Booleans are
+// parsed with strconv.ParseBool.
+//
+// Null elements and empty strings map to null outputs.
+func Cast(arr Interface, to arrow.DataType, mem memory.Allocator, opts ...CastOption) (Interface, error) {
+	var cfg castConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var value func(i int) string
+	switch arr := arr.(type) {
+	case *String:
+		value = arr.Value
+	case *LargeString:
+		value = arr.Value
+	default:
+		return nil, fmt.Errorf("arrow/array: unsupported cast from %v to %v", arr.DataType(), to)
+	}
+
+	parse, err := stringParser(to)
+	if err != nil {
+		return nil, err
+	}
+
+	bldr := NewBuilder(mem, to)
+	defer bldr.Release()
+
+	bldr.Reserve(arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) || value(i) == "" {
+			bldr.AppendNull()
+			continue
+		}
+		v, err := parse(value(i))
+		if err != nil {
+			if cfg.errOnParse {
+				return nil, fmt.Errorf("arrow/array: row %d: cannot parse %q as %v", i, value(i), to)
+			}
+			v = nil
+		}
+		if err := appendGoValue(bldr, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return bldr.NewArray(), nil
+}
+
+// stringParser returns a function parsing a string into a Go value of the
+// data type dt, suitable for appendGoValue.
+func stringParser(dt arrow.DataType) (func(string) (interface{}, error), error) {
+	switch dt := dt.(type) {
+	case *arrow.Int8Type:
+		return func(s string) (interface{}, error) {
+			v, err := strconv.ParseInt(s, 10, 8)
+			return int8(v), err
+		}, nil
+	case *arrow.Int16Type:
+		return func(s string) (interface{}, error) {
+			v, err := strconv.ParseInt(s, 10, 16)
+			return int16(v), err
+		}, nil
+	case *arrow.Int32Type:
+		return func(s string) (interface{}, error) {
+			v, err := strconv.ParseInt(s, 10, 32)
+			return int32(v), err
+		}, nil
+	case *arrow.Int64Type:
+		return func(s string) (interface{}, error) {
+			return strconv.ParseInt(s, 10, 64)
+		}, nil
+	case *arrow.Uint8Type:
+		return func(s string) (interface{}, error) {
+			v, err := strconv.ParseUint(s, 10, 8)
+			return uint8(v), err
+		}, nil
+	case *arrow.Uint16Type:
+		return func(s string) (interface{}, error) {
+			v, err := strconv.ParseUint(s, 10, 16)
+			return uint16(v), err
+		}, nil
+	case *arrow.Uint32Type:
+		return func(s string) (interface{}, error) {
+			v, err := strconv.ParseUint(s, 10, 32)
+			return uint32(v), err
+		}, nil
+	case *arrow.Uint64Type:
+		return func(s string) (interface{}, error) {
+			return strconv.ParseUint(s, 10, 64)
+		}, nil
+	case *arrow.Float32Type:
+		return func(s string) (interface{}, error) {
+			v, err := strconv.ParseFloat(s, 32)
+			return float32(v), err
+		}, nil
+	case *arrow.Float64Type:
+		return func(s string) (interface{}, error) {
+			return strconv.ParseFloat(s, 64)
+		}, nil
+	case *arrow.BooleanType:
+		return func(s string) (interface{}, error) {
+			return strconv.ParseBool(s)
+		}, nil
+	case *arrow.Date32Type:
+		return func(s string) (interface{}, error) {
+			t, err := time.Parse("2006-01-02", s)
+			return int32(t.Unix() / 86400), err
+		}, nil
+	case *arrow.Date64Type:
+		return func(s string) (interface{}, error) {
+			t, err := time.Parse("2006-01-02", s)
+			return t.Unix() * 1000, err
+		}, nil
+	case *arrow.TimestampType:
+		var div int64
+		switch dt.Unit {
+		case arrow.Second:
+			div = int64(time.Second)
+		case arrow.Millisecond:
+			div = int64(time.Millisecond)
+		case arrow.Microsecond:
+			div = int64(time.Microsecond)
+		case arrow.Nanosecond:
+			div = int64(time.Nanosecond)
+		}
+		return func(s string) (interface{}, error) {
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return nil, err
+			}
+			sec := t.Unix()
+			nsec := int64(t.Nanosecond())
+			return sec*(int64(time.Second)/div) + nsec/div, nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("arrow/array: unsupported cast from string to %v", dt)
+	}
+}
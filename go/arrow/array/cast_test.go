@@ -0,0 +1,143 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestCastString(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, tc := range []struct {
+		name string
+		vs   []string
+		to   arrow.DataType
+		want string
+	}{
+		{
+			name: "int64",
+			vs:   []string{"1", "-42", "", "x", "9223372036854775807"},
+			to:   arrow.PrimitiveTypes.Int64,
+			want: "[1 -42 (null) (null) 9223372036854775807]",
+		},
+		{
+			name: "int8-overflow",
+			vs:   []string{"127", "128"},
+			to:   arrow.PrimitiveTypes.Int8,
+			want: "[127 (null)]",
+		},
+		{
+			name: "uint32",
+			vs:   []string{"7", "-1"},
+			to:   arrow.PrimitiveTypes.Uint32,
+			want: "[7 (null)]",
+		},
+		{
+			name: "float64",
+			vs:   []string{"1.5", "-2e3", "6.02E+23", "", "NaN", "1.5x"},
+			to:   arrow.PrimitiveTypes.Float64,
+			want: "[1.5 -2000 6.02e+23 (null) NaN (null)]",
+		},
+		{
+			name: "bool",
+			vs:   []string{"true", "0", "T", "yes"},
+			to:   arrow.FixedWidthTypes.Boolean,
+			want: "[true false true (null)]",
+		},
+		{
+			name: "date32",
+			vs:   []string{"1970-01-02", "2000-02-30"},
+			to:   arrow.FixedWidthTypes.Date32,
+			want: "[1 (null)]",
+		},
+		{
+			name: "date64",
+			vs:   []string{"1969-12-31"},
+			to:   arrow.FixedWidthTypes.Date64,
+			want: "[-86400000]",
+		},
+		{
+			name: "timestamp-ms",
+			vs:   []string{"1970-01-01T00:00:01.5Z", "1970-01-01T01:00:00+01:00", "1969-12-31T23:59:59.999Z", "1970-01-01"},
+			to:   arrow.FixedWidthTypes.Timestamp_ms,
+			want: "[1500 0 -1 (null)]",
+		},
+		{
+			name: "timestamp-ns",
+			vs:   []string{"2001-02-03T04:05:06.000000007Z"},
+			to:   arrow.FixedWidthTypes.Timestamp_ns,
+			want: "[981173106000000007]",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bldr := array.NewStringBuilder(mem)
+			defer bldr.Release()
+			bldr.AppendValues(tc.vs, nil)
+			bldr.AppendNull()
+
+			arr := bldr.NewStringArray()
+			defer arr.Release()
+
+			out, err := array.Cast(arr, tc.to, mem)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer out.Release()
+
+			if got, want := out.DataType(), tc.to; !arrow.TypeEquals(got, want) {
+				t.Fatalf("invalid data type: got=%v, want=%v", got, want)
+			}
+			want := strings.TrimSuffix(tc.want, "]") + " (null)]"
+			if got := fmt.Sprintf("%v", out); got != want {
+				t.Fatalf("invalid result:\ngot= %s\nwant=%s", got, want)
+			}
+		})
+	}
+}
+
+func TestCastStringErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]string{"1", "", "2.5"}, nil)
+
+	arr := bldr.NewStringArray()
+	defer arr.Release()
+
+	_, err := array.Cast(arr, arrow.PrimitiveTypes.Int32, mem, array.WithErrorOnParseFailure(true))
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if got, want := err.Error(), `arrow/array: row 2: cannot parse "2.5" as int32`; got != want {
+		t.Fatalf("invalid error: got=%q, want=%q", got, want)
+	}
+
+	_, err = array.Cast(arr, arrow.BinaryTypes.Binary, mem)
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+}
@@ -24,12 +24,12 @@ import (
 	"github.com/pkg/errors"
 )
 
-// RecordEqual reports whether the two provided records are equal.
-func RecordEqual(left, right Record) bool {
-	switch {
-	case left.NumCols() != right.NumCols():
-		return false
-	case left.NumRows() != right.NumRows():
+// RecordEqual reports whether the two provided records are equal: whether
+// they have equal schemas, as per arrow.Schema.Equal, and equal columns.
+// Of the EqualOptions, only WithIgnoreMetadata applies to RecordEqual.
+func RecordEqual(left, right Record, opts ...EqualOption) bool {
+	opt := newEqualOption(opts...)
+	if !baseRecordEqual(left, right, opt) {
 		return false
 	}
 
@@ -46,15 +46,11 @@ func RecordEqual(left, right Record) bool {
 // RecordApproxEqual reports whether the two provided records are approximately equal.
 // For non-floating point columns, it is equivalent to RecordEqual.
 func RecordApproxEqual(left, right Record, opts ...EqualOption) bool {
-	switch {
-	case left.NumCols() != right.NumCols():
-		return false
-	case left.NumRows() != right.NumRows():
+	opt := newEqualOption(opts...)
+	if !baseRecordEqual(left, right, opt) {
 		return false
 	}
 
-	opt := newEqualOption(opts...)
-
 	for i := range left.Columns() {
 		lc := left.Column(i)
 		rc := right.Column(i)
@@ -65,6 +61,33 @@ func RecordApproxEqual(left, right Record, opts ...EqualOption) bool {
 	return true
 }
 
+// baseRecordEqual reports whether the two provided records have the same
+// number of rows and equal schemas.
+func baseRecordEqual(left, right Record, opt equalOption) bool {
+	switch {
+	case left.NumCols() != right.NumCols():
+		return false
+	case left.NumRows() != right.NumRows():
+		return false
+	}
+	return schemaEqual(left.Schema(), right.Schema(), opt)
+}
+
+func schemaEqual(left, right *arrow.Schema, opt equalOption) bool {
+	if !opt.noMeta {
+		return left.Equal(right)
+	}
+	if len(left.Fields()) != len(right.Fields()) {
+		return false
+	}
+	for i, f := range left.Fields() {
+		if !f.EqualIgnoringMetadata(right.Field(i)) {
+			return false
+		}
+	}
+	return true
+}
+
 // ArrayEqual reports whether the two provided arrays are equal.
 func ArrayEqual(left, right Interface) bool {
 	switch {
@@ -95,6 +118,12 @@ func ArrayEqual(left, right Interface) bool {
 	case *String:
 		r := right.(*String)
 		return arrayEqualString(l, r)
+	case *LargeBinary:
+		r := right.(*LargeBinary)
+		return arrayEqualLargeBinary(l, r)
+	case *LargeString:
+		r := right.(*LargeString)
+		return arrayEqualLargeString(l, r)
 	case *Int8:
 		r := right.(*Int8)
 		return arrayEqualInt8(l, r)
@@ -131,6 +160,9 @@ func ArrayEqual(left, right Interface) bool {
 	case *Decimal128:
 		r := right.(*Decimal128)
 		return arrayEqualDecimal128(l, r)
+	case *Decimal256:
+		r := right.(*Decimal256)
+		return arrayEqualDecimal256(l, r)
 	case *Date32:
 		r := right.(*Date32)
 		return arrayEqualDate32(l, r)
@@ -149,12 +181,25 @@ func ArrayEqual(left, right Interface) bool {
 	case *List:
 		r := right.(*List)
 		return arrayEqualList(l, r)
+	case *LargeList:
+		r := right.(*LargeList)
+		return arrayEqualLargeList(l, r)
 	case *FixedSizeList:
 		r := right.(*FixedSizeList)
 		return arrayEqualFixedSizeList(l, r)
 	case *Struct:
 		r := right.(*Struct)
 		return arrayEqualStruct(l, r)
+	case *RunEndEncoded:
+		r := right.(*RunEndEncoded)
+		return arrayEqualRunEndEncoded(l, r, func(lv Interface, i int, rv Interface, j int) bool {
+			return ArraySliceEqual(lv, int64(i), int64(i+1), rv, int64(j), int64(j+1))
+		})
+	case *Dictionary:
+		r := right.(*Dictionary)
+		return arrayEqualDictionary(l, r, func(lv Interface, i int, rv Interface, j int) bool {
+			return ArraySliceEqual(lv, int64(i), int64(i+1), rv, int64(j), int64(j+1))
+		})
 	case *MonthInterval:
 		r := right.(*MonthInterval)
 		return arrayEqualMonthInterval(l, r)
@@ -184,37 +229,30 @@ const defaultAbsoluteTolerance = 1e-5
 
 type equalOption struct {
 	atol   float64 // absolute tolerance
+	rtol   float64 // relative tolerance
 	nansEq bool    // whether NaNs are considered equal.
+	noMeta bool    // whether the metadata of record fields is ignored.
 }
 
 func (eq equalOption) f16(f1, f2 float16.Num) bool {
-	v1 := float64(f1.Float32())
-	v2 := float64(f2.Float32())
-	switch {
-	case eq.nansEq:
-		return math.Abs(v1-v2) <= eq.atol || (math.IsNaN(v1) && math.IsNaN(v2))
-	default:
-		return math.Abs(v1-v2) <= eq.atol
-	}
+	return eq.f64(float64(f1.Float32()), float64(f2.Float32()))
 }
 
 func (eq equalOption) f32(f1, f2 float32) bool {
-	v1 := float64(f1)
-	v2 := float64(f2)
-	switch {
-	case eq.nansEq:
-		return math.Abs(v1-v2) <= eq.atol || (math.IsNaN(v1) && math.IsNaN(v2))
-	default:
-		return math.Abs(v1-v2) <= eq.atol
-	}
+	return eq.f64(float64(f1), float64(f2))
 }
 
 func (eq equalOption) f64(v1, v2 float64) bool {
 	switch {
-	case eq.nansEq:
-		return math.Abs(v1-v2) <= eq.atol || (math.IsNaN(v1) && math.IsNaN(v2))
+	case v1 == v2:
+		// also handles infinities, which have no finite difference.
+		return true
+	case math.IsNaN(v1) || math.IsNaN(v2):
+		return eq.nansEq && math.IsNaN(v1) && math.IsNaN(v2)
+	case math.IsInf(v1, 0) || math.IsInf(v2, 0):
+		return false
 	default:
-		return math.Abs(v1-v2) <= eq.atol
+		return math.Abs(v1-v2) <= eq.atol+eq.rtol*math.Max(math.Abs(v1), math.Abs(v2))
 	}
 }
 
@@ -240,6 +278,23 @@ func WithNaNsEqual(v bool) EqualOption {
 	}
 }
 
+// WithIgnoreMetadata configures the record comparison functions so that
+// the metadata of the schema fields is ignored.
+func WithIgnoreMetadata(v bool) EqualOption {
+	return func(o *equalOption) {
+		o.noMeta = v
+	}
+}
+
+// WithRelTolerance configures the comparison functions so that 2 floating point values
+// v1 and v2 are considered equal if |v1-v2| <= atol + rtol*max(|v1|, |v2|).
+// The relative tolerance defaults to 0.
+func WithRelTolerance(rtol float64) EqualOption {
+	return func(o *equalOption) {
+		o.rtol = rtol
+	}
+}
+
 // WithAbsTolerance configures the comparison functions so that 2 floating point values
 // v1 and v2 are considered equal if |v1-v2| <= atol.
 func WithAbsTolerance(atol float64) EqualOption {
@@ -250,6 +305,10 @@ func WithAbsTolerance(atol float64) EqualOption {
 
 // ArrayApproxEqual reports whether the two provided arrays are approximately equal.
 // For non-floating point arrays, it is equivalent to ArrayEqual.
+//
+// NaN values are considered unequal, even to other NaNs, unless WithNaNsEqual is set.
+// As with ArrayEqual, null counts are compared once materialized: an array with an
+// unknown null count compares equal to an otherwise identical array with a known one.
 func ArrayApproxEqual(left, right Interface, opts ...EqualOption) bool {
 	opt := newEqualOption(opts...)
 	return arrayApproxEqual(left, right, opt)
@@ -284,6 +343,12 @@ func arrayApproxEqual(left, right Interface, opt equalOption) bool {
 	case *String:
 		r := right.(*String)
 		return arrayEqualString(l, r)
+	case *LargeBinary:
+		r := right.(*LargeBinary)
+		return arrayEqualLargeBinary(l, r)
+	case *LargeString:
+		r := right.(*LargeString)
+		return arrayEqualLargeString(l, r)
 	case *Int8:
 		r := right.(*Int8)
 		return arrayEqualInt8(l, r)
@@ -320,6 +385,9 @@ func arrayApproxEqual(left, right Interface, opt equalOption) bool {
 	case *Decimal128:
 		r := right.(*Decimal128)
 		return arrayEqualDecimal128(l, r)
+	case *Decimal256:
+		r := right.(*Decimal256)
+		return arrayEqualDecimal256(l, r)
 	case *Date32:
 		r := right.(*Date32)
 		return arrayEqualDate32(l, r)
@@ -338,12 +406,33 @@ func arrayApproxEqual(left, right Interface, opt equalOption) bool {
 	case *List:
 		r := right.(*List)
 		return arrayApproxEqualList(l, r, opt)
+	case *LargeList:
+		r := right.(*LargeList)
+		return arrayApproxEqualLargeList(l, r, opt)
 	case *FixedSizeList:
 		r := right.(*FixedSizeList)
 		return arrayApproxEqualFixedSizeList(l, r, opt)
 	case *Struct:
 		r := right.(*Struct)
 		return arrayApproxEqualStruct(l, r, opt)
+	case *RunEndEncoded:
+		r := right.(*RunEndEncoded)
+		return arrayEqualRunEndEncoded(l, r, func(lv Interface, i int, rv Interface, j int) bool {
+			ls := NewSlice(lv, int64(i), int64(i+1))
+			defer ls.Release()
+			rs := NewSlice(rv, int64(j), int64(j+1))
+			defer rs.Release()
+			return arrayApproxEqual(ls, rs, opt)
+		})
+	case *Dictionary:
+		r := right.(*Dictionary)
+		return arrayEqualDictionary(l, r, func(lv Interface, i int, rv Interface, j int) bool {
+			ls := NewSlice(lv, int64(i), int64(i+1))
+			defer ls.Release()
+			rs := NewSlice(rv, int64(j), int64(j+1))
+			defer rs.Release()
+			return arrayApproxEqual(ls, rs, opt)
+		})
 	case *MonthInterval:
 		r := right.(*MonthInterval)
 		return arrayEqualMonthInterval(l, r)
@@ -444,6 +533,25 @@ func arrayApproxEqualList(left, right *List, opt equalOption) bool {
 	return true
 }
 
+func arrayApproxEqualLargeList(left, right *LargeList, opt equalOption) bool {
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) {
+			continue
+		}
+		o := func() bool {
+			l := left.newListValue(i)
+			defer l.Release()
+			r := right.newListValue(i)
+			defer r.Release()
+			return arrayApproxEqual(l, r, opt)
+		}()
+		if !o {
+			return false
+		}
+	}
+	return true
+}
+
 func arrayApproxEqualFixedSizeList(left, right *FixedSizeList, opt equalOption) bool {
 	for i := 0; i < left.Len(); i++ {
 		if left.IsNull(i) {
@@ -466,7 +574,16 @@ func arrayApproxEqualFixedSizeList(left, right *FixedSizeList, opt equalOption)
 func arrayApproxEqualStruct(left, right *Struct, opt equalOption) bool {
 	for i, lf := range left.fields {
 		rf := right.fields[i]
-		if !arrayApproxEqual(lf, rf, opt) {
+		eq := true
+		validRuns(left, func(beg, end int) bool {
+			ls := NewSlice(lf, int64(beg), int64(end))
+			defer ls.Release()
+			rs := NewSlice(rf, int64(beg), int64(end))
+			defer rs.Release()
+			eq = arrayApproxEqual(ls, rs, opt)
+			return eq
+		})
+		if !eq {
 			return false
 		}
 	}
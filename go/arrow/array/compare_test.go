@@ -21,6 +21,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/float16"
 	"github.com/apache/arrow/go/arrow/internal/arrdata"
@@ -283,6 +284,47 @@ func TestArrayApproxEqualFloats(t *testing.T) {
 			opts: []array.EqualOption{array.WithNaNsEqual(true), array.WithAbsTolerance(1)},
 			want: true,
 		},
+		{
+			name: "f64-rel-tol-ko",
+			a1:   []float64{1, 2, 3, 4, 5, 1000},
+			a2:   []float64{1, 2, 3, 4, 5, 1001},
+			opts: []array.EqualOption{array.WithRelTolerance(1e-4)},
+			want: false,
+		},
+		{
+			name: "f64-rel-tol-ok",
+			a1:   []float64{1, 2, 3, 4, 5, 1000},
+			a2:   []float64{1, 2, 3, 4, 5, 1001},
+			opts: []array.EqualOption{array.WithRelTolerance(1e-3)},
+			want: true,
+		},
+		{
+			name: "f64-rel-tol-no-abs",
+			a1:   []float64{0, 2, 3, 4, 5, 6},
+			a2:   []float64{1e-6, 2, 3, 4, 5, 6},
+			opts: []array.EqualOption{array.WithAbsTolerance(0), array.WithRelTolerance(1e-3)},
+			want: false,
+		},
+		{
+			name: "f64-inf",
+			a1:   []float64{1, 2, 3, 4, math.Inf(-1), math.Inf(+1)},
+			a2:   []float64{1, 2, 3, 4, math.Inf(-1), math.Inf(+1)},
+			want: true,
+		},
+		{
+			name: "f64-inf-sign",
+			a1:   []float64{1, 2, 3, 4, 5, math.Inf(-1)},
+			a2:   []float64{1, 2, 3, 4, 5, math.Inf(+1)},
+			opts: []array.EqualOption{array.WithRelTolerance(1)},
+			want: false,
+		},
+		{
+			name: "f32-rel-tol-ok",
+			a1:   []float32{1, 2, 3, 4, 5, 1000},
+			a2:   []float32{1, 2, 3, 4, 5, 1001},
+			opts: []array.EqualOption{array.WithRelTolerance(1e-3)},
+			want: true,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
@@ -300,6 +342,29 @@ func TestArrayApproxEqualFloats(t *testing.T) {
 	}
 }
 
+func TestArrayApproxEqualUnknownNullCount(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	a1 := arrayOf(mem, []float64{1, 2, 3, 4}, []bool{true, false, true, true})
+	defer a1.Release()
+
+	data := array.NewData(
+		a1.DataType(), a1.Len(), a1.Data().Buffers(), nil,
+		array.UnknownNullCount, 0,
+	)
+	defer data.Release()
+	a2 := array.MakeFromData(data)
+	defer a2.Release()
+
+	if !array.ArrayEqual(a1, a2) {
+		t.Fatalf("arrays should be equal:\na1: %v\na2: %v", a1, a2)
+	}
+	if !array.ArrayApproxEqual(a2, a1) {
+		t.Fatalf("arrays should be approximately equal:\na1: %v\na2: %v", a1, a2)
+	}
+}
+
 func arrayOf(mem memory.Allocator, a interface{}, valids []bool) array.Interface {
 	if mem == nil {
 		mem = memory.NewGoAllocator()
@@ -480,6 +545,110 @@ func TestArrayEqualDifferentMaskedValues(t *testing.T) {
 	}
 }
 
+func TestArrayEqualSlicedNested(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	newList := func(vs ...[]int32) array.Interface {
+		lb := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int32)
+		defer lb.Release()
+		vb := lb.ValueBuilder().(*array.Int32Builder)
+		for _, v := range vs {
+			lb.Append(true)
+			vb.AppendValues(v, nil)
+		}
+		return lb.NewArray()
+	}
+
+	dtype := arrow.StructOf(
+		arrow.Field{Name: "i", Type: arrow.PrimitiveTypes.Int32},
+		arrow.Field{Name: "s", Type: arrow.BinaryTypes.String},
+	)
+	newStruct := func(is []int32, ss []string, valids []bool) array.Interface {
+		sb := array.NewStructBuilder(mem, dtype)
+		defer sb.Release()
+		sb.AppendValues(valids)
+		sb.FieldBuilder(0).(*array.Int32Builder).AppendValues(is, nil)
+		sb.FieldBuilder(1).(*array.StringBuilder).AppendValues(ss, nil)
+		return sb.NewArray()
+	}
+
+	slice := func(arr array.Interface, i, j int64) array.Interface {
+		defer arr.Release()
+		return array.NewSlice(arr, i, j)
+	}
+
+	for _, tc := range []struct {
+		name        string
+		left, right array.Interface
+		want        bool
+	}{
+		{
+			name:  "list",
+			left:  slice(newList([]int32{0, 1}, []int32{2}, []int32{3, 4, 5}, []int32{6}), 1, 3),
+			right: newList([]int32{2}, []int32{3, 4, 5}),
+			want:  true,
+		},
+		{
+			name:  "list-differ",
+			left:  slice(newList([]int32{0, 1}, []int32{2}, []int32{3, 4, 5}, []int32{6}), 1, 3),
+			right: newList([]int32{2}, []int32{3, 4, 6}),
+			want:  false,
+		},
+		{
+			name:  "struct",
+			left:  slice(newStruct([]int32{1, 2, 3, 4}, []string{"a", "b", "c", "d"}, []bool{true, true, true, true}), 1, 3),
+			right: newStruct([]int32{2, 3}, []string{"b", "c"}, []bool{true, true}),
+			want:  true,
+		},
+		{
+			name:  "struct-differ",
+			left:  slice(newStruct([]int32{1, 2, 3, 4}, []string{"a", "b", "c", "d"}, []bool{true, true, true, true}), 1, 3),
+			right: newStruct([]int32{2, 3}, []string{"b", "x"}, []bool{true, true}),
+			want:  false,
+		},
+		{
+			name:  "struct-masked",
+			left:  slice(newStruct([]int32{1, 2, 3, 4}, []string{"a", "b", "c", "d"}, []bool{true, false, true, true}), 1, 3),
+			right: newStruct([]int32{0, 3}, []string{"", "c"}, []bool{false, true}),
+			want:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer tc.left.Release()
+			defer tc.right.Release()
+
+			if got := array.ArrayEqual(tc.left, tc.right); got != tc.want {
+				t.Fatalf("ArrayEqual(%v, %v): got=%v, want=%v", tc.left, tc.right, got, tc.want)
+			}
+			if got := array.ArrayApproxEqual(tc.left, tc.right); got != tc.want {
+				t.Fatalf("ArrayApproxEqual(%v, %v): got=%v, want=%v", tc.left, tc.right, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStructSliceFields(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	sb := array.NewStructBuilder(mem, arrow.StructOf(arrow.Field{Name: "i", Type: arrow.PrimitiveTypes.Int32}))
+	defer sb.Release()
+	sb.AppendValues([]bool{true, true, true, true})
+	sb.FieldBuilder(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3, 4}, nil)
+
+	arr := sb.NewStructArray()
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 1, 3).(*array.Struct)
+	defer slice.Release()
+
+	field := slice.Field(0).(*array.Int32)
+	if got, want := fmt.Sprint(field.Int32Values()), "[2 3]"; got != want {
+		t.Fatalf("invalid sliced field: got=%s, want=%s", got, want)
+	}
+}
+
 func TestRecordEqual(t *testing.T) {
 	for name, recs := range arrdata.Records {
 		t.Run(name, func(t *testing.T) {
@@ -529,3 +698,93 @@ func TestRecordApproxEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordFloatTolerance(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	meta := arrow.NewMetadata([]string{"k"}, []string{"v"})
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i32", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	}, nil)
+	mschema := arrow.NewSchema([]arrow.Field{
+		{Name: "i32", Type: arrow.PrimitiveTypes.Int32, Metadata: meta},
+		{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	}, nil)
+
+	newRecord := func(schema *arrow.Schema, f float64) array.Record {
+		b := array.NewRecordBuilder(mem, schema)
+		defer b.Release()
+		b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3}, nil)
+		b.Field(1).(*array.Float64Builder).AppendValues([]float64{0.5, 0, f}, []bool{true, false, true})
+		return b.NewRecord()
+	}
+
+	rec := newRecord(schema, 1.5)
+	defer rec.Release()
+	within := newRecord(schema, 1.5+1e-7)
+	defer within.Release()
+	outside := newRecord(schema, 1.75)
+	defer outside.Release()
+	withMeta := newRecord(mschema, 1.5)
+	defer withMeta.Release()
+
+	for _, tc := range []struct {
+		name   string
+		other  array.Record
+		opts   []array.EqualOption
+		equal  bool
+		approx bool
+		diff   string
+	}{
+		{name: "identical", other: rec, equal: true, approx: true},
+		{name: "within-tolerance", other: within, approx: true},
+		{name: "outside-tolerance", other: outside, diff: `column 1 "f64", row 2: left=1.5, right=1.75`},
+		{
+			name:  "outside-custom-tolerance",
+			other: within,
+			opts:  []array.EqualOption{array.WithAbsTolerance(1e-9)},
+			diff:  `column 1 "f64", row 2: left=1.5, right=1.5000001`,
+		},
+		{
+			name:   "within-custom-tolerance",
+			other:  outside,
+			opts:   []array.EqualOption{array.WithAbsTolerance(0.5)},
+			approx: true,
+		},
+		{
+			name:  "metadata",
+			other: withMeta,
+			diff:  "schema mismatch:\nleft: " + schema.String() + "\nright: " + mschema.String(),
+		},
+		{
+			name:   "ignore-metadata",
+			other:  withMeta,
+			opts:   []array.EqualOption{array.WithIgnoreMetadata(true)},
+			equal:  true,
+			approx: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, want := array.RecordEqual(rec, tc.other, tc.opts...), tc.equal; got != want {
+				t.Fatalf("invalid RecordEqual: got=%v, want=%v", got, want)
+			}
+			if got, want := array.RecordApproxEqual(rec, tc.other, tc.opts...), tc.approx; got != want {
+				t.Fatalf("invalid RecordApproxEqual: got=%v, want=%v", got, want)
+			}
+			if got, want := array.RecordDiff(rec, tc.other, tc.opts...), tc.diff; got != want {
+				t.Fatalf("invalid RecordDiff:\ngot= %q\nwant=%q", got, want)
+			}
+		})
+	}
+
+	short := rec.NewSlice(0, 2)
+	defer short.Release()
+	if array.RecordApproxEqual(rec, short) {
+		t.Fatalf("records with different numbers of rows should not compare equal")
+	}
+	if got, want := array.RecordDiff(rec, short), "number of rows mismatch: left=3, right=2"; got != want {
+		t.Fatalf("invalid RecordDiff: got=%q, want=%q", got, want)
+	}
+}
@@ -0,0 +1,72 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// CumulativeOption configures the cumulative kernels.
+type CumulativeOption func(*cumulativeConfig)
+
+type cumulativeConfig struct {
+	skipNulls bool
+}
+
+// WithSkipNulls specifies whether the cumulative kernels skip null elements.
+//
+// When skipping nulls, a null element maps to the cumulative value of the
+// elements before it, or to null if there are none.
+// Otherwise nulls propagate: a null element and all the elements after it
+// map to null outputs. This is the default.
+func WithSkipNulls(skip bool) CumulativeOption {
+	return func(cfg *cumulativeConfig) {
+		cfg.skipNulls = skip
+	}
+}
+
+// cumulativeOp identifies the operation folded by the cumulative kernels.
+type cumulativeOp int
+
+const (
+	cumulativeSum cumulativeOp = iota
+	cumulativeMax
+	cumulativeMin
+)
+
+// CumulativeSum returns a new array where each element is the sum of the
+// elements of arr up to and including it. Integer sums wrap around on overflow.
+//
+// CumulativeSum supports integer and floating-point arrays, and the
+// returned array has the same data type as arr.
+func CumulativeSum(arr Interface, mem memory.Allocator, opts ...CumulativeOption) (Interface, error) {
+	return cumulative(arr, mem, opts, cumulativeSum)
+}
+
+// CumulativeMax returns a new array where each element is the maximum of the
+// elements of arr up to and including it. See CumulativeSum for the
+// supported data types.
+func CumulativeMax(arr Interface, mem memory.Allocator, opts ...CumulativeOption) (Interface, error) {
+	return cumulative(arr, mem, opts, cumulativeMax)
+}
+
+// CumulativeMin returns a new array where each element is the minimum of the
+// elements of arr up to and including it. See CumulativeSum for the
+// supported data types.
+func CumulativeMin(arr Interface, mem memory.Allocator, opts ...CumulativeOption) (Interface, error) {
+	return cumulative(arr, mem, opts, cumulativeMin)
+}
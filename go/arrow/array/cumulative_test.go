@@ -0,0 +1,124 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestCumulative(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int32{0, 3, -1, 0, 4, 2}, []bool{false, true, true, false, true, true})
+
+	ints := ib.NewInt32Array()
+	defer ints.Release()
+
+	fb := array.NewFloat64Builder(mem)
+	defer fb.Release()
+	fb.AppendValues([]float64{1.5, 0, -2, 4}, []bool{true, false, true, true})
+
+	floats := fb.NewFloat64Array()
+	defer floats.Release()
+
+	sliced := array.NewSlice(ints, 1, 6)
+	defer sliced.Release()
+
+	type cumulativeFunc func(array.Interface, memory.Allocator, ...array.CumulativeOption) (array.Interface, error)
+	skip := []array.CumulativeOption{array.WithSkipNulls(true)}
+
+	for _, tc := range []struct {
+		name string
+		fn   cumulativeFunc
+		arr  array.Interface
+		opts []array.CumulativeOption
+		want string
+	}{
+		{name: "sum-i32", fn: array.CumulativeSum, arr: ints, want: "[(null) (null) (null) (null) (null) (null)]"},
+		{name: "sum-i32-skip", fn: array.CumulativeSum, arr: ints, opts: skip, want: "[(null) 3 2 2 6 8]"},
+		{name: "max-i32-skip", fn: array.CumulativeMax, arr: ints, opts: skip, want: "[(null) 3 3 3 4 4]"},
+		{name: "min-i32-skip", fn: array.CumulativeMin, arr: ints, opts: skip, want: "[(null) 3 -1 -1 -1 -1]"},
+		{name: "sum-i32-sliced", fn: array.CumulativeSum, arr: sliced, opts: skip, want: "[3 2 2 6 8]"},
+		{name: "min-i32-sliced", fn: array.CumulativeMin, arr: sliced, want: "[3 -1 (null) (null) (null)]"},
+		{name: "sum-f64", fn: array.CumulativeSum, arr: floats, want: "[1.5 (null) (null) (null)]"},
+		{name: "sum-f64-skip", fn: array.CumulativeSum, arr: floats, opts: skip, want: "[1.5 1.5 -0.5 3.5]"},
+		{name: "max-f64", fn: array.CumulativeMax, arr: floats, want: "[1.5 (null) (null) (null)]"},
+		{name: "max-f64-skip", fn: array.CumulativeMax, arr: floats, opts: skip, want: "[1.5 1.5 1.5 4]"},
+		{name: "min-f64-skip", fn: array.CumulativeMin, arr: floats, opts: skip, want: "[1.5 1.5 -2 -2]"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := tc.fn(tc.arr, mem, tc.opts...)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer out.Release()
+
+			if got, want := out.DataType(), tc.arr.DataType(); !arrow.TypeEquals(got, want) {
+				t.Fatalf("invalid data type: got=%v, want=%v", got, want)
+			}
+			if got, want := fmt.Sprintf("%v", out), tc.want; got != want {
+				t.Fatalf("invalid result:\ngot= %s\nwant=%s", got, want)
+			}
+		})
+	}
+}
+
+func TestCumulativeSumOverflow(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewInt8Builder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]int8{100, 27, 1}, nil)
+
+	arr := bldr.NewInt8Array()
+	defer arr.Release()
+
+	out, err := array.CumulativeSum(arr, mem)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer out.Release()
+
+	if got, want := fmt.Sprintf("%v", out), "[100 127 -128]"; got != want {
+		t.Fatalf("invalid result:\ngot= %s\nwant=%s", got, want)
+	}
+}
+
+func TestCumulativeUnsupported(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+	bldr.Append("a")
+
+	arr := bldr.NewStringArray()
+	defer arr.Release()
+
+	if _, err := array.CumulativeSum(arr, mem); err == nil {
+		t.Fatalf("expected an error")
+	}
+}
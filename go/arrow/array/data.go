@@ -90,6 +90,7 @@ func (d *Data) NullN() int                { return d.nulls }
 func (d *Data) Len() int                  { return d.length }
 func (d *Data) Offset() int               { return d.offset }
 func (d *Data) Buffers() []*memory.Buffer { return d.buffers }
+func (d *Data) Children() []*Data         { return d.childData }
 
 // NewSliceData returns a new slice that shares backing data with the input.
 // The returned Data slice starts at i and extends j-i elements, such as:
@@ -99,7 +100,7 @@ func (d *Data) Buffers() []*memory.Buffer { return d.buffers }
 // NewSliceData panics if the slice is outside the valid range of the input Data.
 // NewSliceData panics if j < i.
 func NewSliceData(data *Data, i, j int64) *Data {
-	if j > int64(data.length) || i > j || data.offset+int(i) > data.offset+data.length {
+	if i < 0 || j > int64(data.length) || i > j || data.offset+int(i) > data.offset+data.length {
 		panic("arrow/array: index out of range")
 	}
 
@@ -125,6 +125,9 @@ func (b *Decimal128Builder) Append(v decimal128.Num) {
 	b.UnsafeAppend(v)
 }
 
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Decimal128Builder) UnsafeAppend(v decimal128.Num) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
@@ -136,6 +139,19 @@ func (b *Decimal128Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Decimal128Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Decimal128Builder) AppendEmptyValue() {
+	b.Append(decimal128.Num{})
+}
+
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Decimal128Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -150,7 +166,8 @@ func (b *Decimal128Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Decimal128Builder) AppendValues(v []decimal128.Num, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -0,0 +1,119 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// RescaleOption is a functional option type used to configure Rescale.
+type RescaleOption func(*rescaleConfig)
+
+type rescaleConfig struct {
+	round bool
+}
+
+// WithRound configures Rescale so that values losing digits when their
+// scale decreases are rounded half away from zero, instead of reported as
+// errors. Rounding is disabled by default.
+func WithRound(v bool) RescaleOption {
+	return func(cfg *rescaleConfig) {
+		cfg.round = v
+	}
+}
+
+// Rescale returns a copy of arr where values are converted to the scale
+// newScale, multiplying or dividing them by a power of ten.
+// The precision of the returned array grows or shrinks along with the
+// scale, within the [1, 38] range. Null elements stay null.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+//
+// Rescale returns an error if a value has more digits than the returned
+// precision once rescaled, or if a value loses non-zero digits while
+// rounding is disabled.
+func Rescale(arr *Decimal128, newScale int32, opts ...RescaleOption) (*Decimal128, error) {
+	var cfg rescaleConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	dtype := arr.DataType().(*arrow.Decimal128Type)
+	delta := newScale - dtype.Scale
+	precision := dtype.Precision + delta
+	switch {
+	case precision < 1:
+		precision = 1
+	case precision > decimal128.MaxPrecision:
+		precision = decimal128.MaxPrecision
+	}
+
+	bldr := NewDecimal128Builder(memory.DefaultAllocator, &arrow.Decimal128Type{Precision: precision, Scale: newScale})
+	defer bldr.Release()
+
+	abs := delta
+	if abs < 0 {
+		abs = -abs
+	}
+	var (
+		factor = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs)), nil)
+		half   = new(big.Int).Rsh(factor, 1)
+		rem    = new(big.Int)
+		max    = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	)
+
+	bldr.Reserve(arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+
+		v := arr.Value(i).BigInt()
+		switch {
+		case delta > 0:
+			v.Mul(v, factor)
+		case delta < 0:
+			// QuoRem truncates towards zero: the remainder has the sign of v.
+			v.QuoRem(v, factor, rem)
+			if rem.Sign() != 0 {
+				if !cfg.round {
+					return nil, fmt.Errorf("arrow/array: rescale row %d: value %v loses digits from scale %d to scale %d", i, arr.Value(i).BigInt(), dtype.Scale, newScale)
+				}
+				if rem.CmpAbs(half) >= 0 {
+					v.Add(v, big.NewInt(int64(rem.Sign())))
+				}
+			}
+		}
+
+		if v.CmpAbs(max) >= 0 {
+			return nil, fmt.Errorf("arrow/array: rescale row %d: value %v exceeds precision %d", i, v, precision)
+		}
+		n, err := decimal128.FromBigInt(v)
+		if err != nil {
+			return nil, fmt.Errorf("arrow/array: rescale row %d: %v", i, err)
+		}
+		bldr.Append(n)
+	}
+
+	return bldr.NewDecimal128Array(), nil
+}
@@ -129,6 +129,35 @@ func TestDecimal128Builder_Empty(t *testing.T) {
 	a.Release()
 }
 
+func TestDecimal128NewBuilder(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.Decimal128Type{Precision: 38, Scale: 10}
+	bldr := array.NewBuilder(mem, dtype)
+	defer bldr.Release()
+
+	b, ok := bldr.(*array.Decimal128Builder)
+	if !ok {
+		t.Fatalf("invalid builder type: %T", bldr)
+	}
+	b.Append(decimal128.New(1, 2))
+	b.AppendNull()
+
+	arr := b.NewArray().(*array.Decimal128)
+	defer arr.Release()
+
+	if got, want := arr.DataType(), arrow.DataType(dtype); got != want {
+		t.Fatalf("invalid data type: got=%v, want=%v", got, want)
+	}
+	if got, want := arr.Value(0), decimal128.New(1, 2); got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+	if !arr.IsNull(1) {
+		t.Fatalf("expected a null value")
+	}
+}
+
 func TestDecimal128Slice(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -177,3 +206,102 @@ func TestDecimal128Slice(t *testing.T) {
 		t.Fatalf("invalid offset: got=%d, want=%d", got, want)
 	}
 }
+
+func TestDecimal128Rescale(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+	defer memory.SetDefaultAllocator(memory.SetDefaultAllocator(mem))
+
+	b := array.NewDecimal128Builder(mem, &arrow.Decimal128Type{Precision: 10, Scale: 2})
+	defer b.Release()
+
+	// 1.25, null, -3.50, 0.04
+	b.AppendValues(
+		[]decimal128.Num{decimal128.FromI64(125), {}, decimal128.FromI64(-350), decimal128.FromI64(4)},
+		[]bool{true, false, true, true},
+	)
+	arr := b.NewDecimal128Array()
+	defer arr.Release()
+
+	rescaled := func(v ...int64) []decimal128.Num {
+		out := make([]decimal128.Num, len(v))
+		for i, v := range v {
+			out[i] = decimal128.FromI64(v)
+		}
+		return out
+	}
+
+	for _, tc := range []struct {
+		name  string
+		scale int32
+		opts  []array.RescaleOption
+		want  []decimal128.Num
+		dtype arrow.DataType
+		err   string
+	}{
+		{
+			name:  "up",
+			scale: 4,
+			want:  rescaled(12500, 0, -35000, 400),
+			dtype: &arrow.Decimal128Type{Precision: 12, Scale: 4},
+		},
+		{
+			name:  "same",
+			scale: 2,
+			want:  rescaled(125, 0, -350, 4),
+			dtype: &arrow.Decimal128Type{Precision: 10, Scale: 2},
+		},
+		{
+			name:  "down",
+			scale: 1,
+			err:   "rescale row 0: value 125 loses digits from scale 2 to scale 1",
+		},
+		{
+			name:  "down-round",
+			scale: 1,
+			opts:  []array.RescaleOption{array.WithRound(true)},
+			want:  rescaled(13, 0, -35, 0),
+			dtype: &arrow.Decimal128Type{Precision: 9, Scale: 1},
+		},
+		{
+			name:  "down-round-units",
+			scale: 0,
+			opts:  []array.RescaleOption{array.WithRound(true)},
+			want:  rescaled(1, 0, -4, 0),
+			dtype: &arrow.Decimal128Type{Precision: 8, Scale: 0},
+		},
+		{
+			name:  "precision",
+			scale: 38, // 1.25 fits in 128 bits, but not in 38 digits.
+			err:   "rescale row 0: value 125000000000000000000000000000000000000 exceeds precision 38",
+		},
+		{
+			name:  "overflow",
+			scale: 40,
+			err:   "rescale row 0:",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := array.Rescale(arr, tc.scale, tc.opts...)
+			if tc.err != "" {
+				if err == nil {
+					out.Release()
+					t.Fatalf("expected an error")
+				}
+				assert.Contains(t, err.Error(), tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			defer out.Release()
+
+			assert.Equal(t, tc.dtype, out.DataType())
+			assert.Equal(t, 1, out.NullN())
+			assert.False(t, out.IsValid(1))
+			for i, want := range tc.want {
+				if out.IsValid(i) {
+					assert.Equal(t, want, out.Value(i), "value %d", i)
+				}
+			}
+		})
+	}
+}
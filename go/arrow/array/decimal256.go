@@ -0,0 +1,256 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array // import "github.com/apache/arrow/go/arrow/array"
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/decimal256"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// A type which represents an immutable sequence of 256-bit decimal values.
+type Decimal256 struct {
+	array
+
+	values []decimal256.Num
+	scale  int32
+}
+
+// NewDecimal256Data returns a new Decimal256 array value, from data.
+func NewDecimal256Data(data *Data) *Decimal256 {
+	a := &Decimal256{}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+// Value returns the unscaled value of the i-th element of the array.
+func (a *Decimal256) Value(i int) decimal256.Num { return a.values[i] }
+
+// Values returns the unscaled values of the array.
+func (a *Decimal256) Values() []decimal256.Num { return a.values }
+
+func (a *Decimal256) String() string {
+	o := new(strings.Builder)
+	o.WriteString("[")
+	for i := 0; i < a.Len(); i++ {
+		if i > 0 {
+			fmt.Fprintf(o, " ")
+		}
+		switch {
+		case a.IsNull(i):
+			o.WriteString("(null)")
+		default:
+			o.WriteString(a.Value(i).ToString(a.scale))
+		}
+	}
+	o.WriteString("]")
+	return o.String()
+}
+
+func (a *Decimal256) setData(data *Data) {
+	a.array.setData(data)
+	a.scale = data.dtype.(*arrow.Decimal256Type).Scale
+	vals := data.buffers[1]
+	if vals != nil {
+		a.values = arrow.Decimal256Traits.CastFromBytes(vals.Bytes())
+		beg := a.array.data.offset
+		end := beg + a.array.data.length
+		a.values = a.values[beg:end]
+	}
+}
+
+func arrayEqualDecimal256(left, right *Decimal256) bool {
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) {
+			continue
+		}
+		if left.Value(i) != right.Value(i) {
+			return false
+		}
+	}
+	return true
+}
+
+type Decimal256Builder struct {
+	builder
+
+	dtype   *arrow.Decimal256Type
+	data    *memory.Buffer
+	rawData []decimal256.Num
+}
+
+// NewDecimal256Builder returns a builder of Decimal256 arrays, using the provided memory allocator.
+func NewDecimal256Builder(mem memory.Allocator, dtype *arrow.Decimal256Type) *Decimal256Builder {
+	return &Decimal256Builder{
+		builder: builder{refCount: 1, mem: mem},
+		dtype:   dtype,
+	}
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+func (b *Decimal256Builder) Release() {
+	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&b.refCount, -1) == 0 {
+		if b.nullBitmap != nil {
+			b.nullBitmap.Release()
+			b.nullBitmap = nil
+		}
+		if b.data != nil {
+			b.data.Release()
+			b.data = nil
+			b.rawData = nil
+		}
+	}
+}
+
+func (b *Decimal256Builder) Append(v decimal256.Num) {
+	b.Reserve(1)
+	b.UnsafeAppend(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
+func (b *Decimal256Builder) UnsafeAppend(v decimal256.Num) {
+	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
+	b.rawData[b.length] = v
+	b.length++
+}
+
+func (b *Decimal256Builder) AppendNull() {
+	b.Reserve(1)
+	b.UnsafeAppendBoolToBitmap(false)
+}
+
+func (b *Decimal256Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Decimal256Builder) AppendEmptyValue() {
+	b.Append(decimal256.Num{})
+}
+
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
+func (b *Decimal256Builder) UnsafeAppendBoolToBitmap(isValid bool) {
+	if isValid {
+		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
+	} else {
+		b.nulls++
+	}
+	b.length++
+}
+
+// AppendValues will append the values in the v slice. The valid slice determines which values
+// in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
+// all values in v are appended and considered valid.
+func (b *Decimal256Builder) AppendValues(v []decimal256.Num, valid []bool) {
+	if len(v) != len(valid) && len(valid) != 0 {
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
+	}
+
+	if len(v) == 0 {
+		return
+	}
+
+	b.Reserve(len(v))
+	arrow.Decimal256Traits.Copy(b.rawData[b.length:], v)
+	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
+}
+
+func (b *Decimal256Builder) init(capacity int) {
+	b.builder.init(capacity)
+
+	b.data = memory.NewResizableBuffer(b.mem)
+	bytesN := arrow.Decimal256Traits.BytesRequired(capacity)
+	b.data.Resize(bytesN)
+	b.rawData = arrow.Decimal256Traits.CastFromBytes(b.data.Bytes())
+}
+
+// Reserve ensures there is enough space for appending n elements
+// by checking the capacity and calling Resize if necessary.
+func (b *Decimal256Builder) Reserve(n int) {
+	b.builder.reserve(n, b.Resize)
+}
+
+// Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
+// additional memory will be allocated. If n is smaller, the allocated memory may reduced.
+func (b *Decimal256Builder) Resize(n int) {
+	nBuilder := n
+	if n < minBuilderCapacity {
+		n = minBuilderCapacity
+	}
+
+	if b.capacity == 0 {
+		b.init(n)
+	} else {
+		b.builder.resize(nBuilder, b.init)
+		b.data.Resize(arrow.Decimal256Traits.BytesRequired(n))
+		b.rawData = arrow.Decimal256Traits.CastFromBytes(b.data.Bytes())
+	}
+}
+
+// NewArray creates a Decimal256 array from the memory buffers used by the builder and resets the Decimal256Builder
+// so it can be used to build a new array.
+func (b *Decimal256Builder) NewArray() Interface {
+	return b.NewDecimal256Array()
+}
+
+// NewDecimal256Array creates a Decimal256 array from the memory buffers used by the builder and resets the Decimal256Builder
+// so it can be used to build a new array.
+func (b *Decimal256Builder) NewDecimal256Array() (a *Decimal256) {
+	data := b.newData()
+	a = NewDecimal256Data(data)
+	data.Release()
+	return
+}
+
+func (b *Decimal256Builder) newData() (data *Data) {
+	bytesRequired := arrow.Decimal256Traits.BytesRequired(b.length)
+	if bytesRequired > 0 && bytesRequired < b.data.Len() {
+		// trim buffers
+		b.data.Resize(bytesRequired)
+	}
+	data = NewData(b.dtype, b.length, []*memory.Buffer{b.nullBitmap, b.data}, nil, b.nulls, 0)
+	b.reset()
+
+	if b.data != nil {
+		b.data.Release()
+		b.data = nil
+		b.rawData = nil
+	}
+
+	return
+}
+
+var (
+	_ Interface = (*Decimal256)(nil)
+	_ Builder   = (*Decimal256Builder)(nil)
+)
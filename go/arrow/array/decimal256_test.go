@@ -0,0 +1,138 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal256"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func dec256(t *testing.T, s string, dtype *arrow.Decimal256Type) decimal256.Num {
+	t.Helper()
+	v, err := decimal256.FromString(s, dtype.Precision, dtype.Scale)
+	if err != nil {
+		t.Fatalf("could not parse %q: %+v", s, err)
+	}
+	return v
+}
+
+func TestNewDecimal256Builder(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype, err := arrow.NewDecimal256Type(50, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ab := array.NewDecimal256Builder(mem, dtype)
+	defer ab.Release()
+
+	want := []decimal256.Num{
+		dec256(t, "1.5", dtype),
+		dec256(t, "-2.25", dtype),
+		{},
+		dec256(t, "123456789012345678901234567890.12", dtype),
+		dec256(t, "0.01", dtype).Negate(),
+	}
+	valids := []bool{true, true, false, true, true}
+
+	for i, valid := range valids {
+		switch {
+		case valid:
+			ab.Append(want[i])
+		default:
+			ab.AppendNull()
+		}
+	}
+
+	assert.Equal(t, 5, ab.Len(), "unexpected Len()")
+	assert.Equal(t, 1, ab.NullN(), "unexpected NullN()")
+
+	a := ab.NewArray().(*array.Decimal256)
+	defer a.Release()
+
+	assert.Zero(t, ab.Len(), "unexpected ArrayBuilder.Len(), NewDecimal256Array did not reset state")
+	assert.Zero(t, ab.NullN(), "unexpected ArrayBuilder.NullN(), NewDecimal256Array did not reset state")
+
+	assert.Equal(t, arrow.DECIMAL256, a.DataType().ID())
+	assert.Equal(t, 1, a.NullN(), "unexpected null count")
+	assert.Equal(t, want, a.Values(), "unexpected Decimal256Values")
+	assert.Equal(t, 5*arrow.Decimal256SizeBytes, a.Data().Buffers()[1].Len())
+	assert.Equal(t, `[1.50 -2.25 (null) 123456789012345678901234567890.12 -0.01]`, a.String())
+
+	ab.AppendValues(want[:2], nil)
+	b := ab.NewDecimal256Array()
+	defer b.Release()
+
+	assert.Equal(t, 0, b.NullN())
+	assert.Equal(t, want[:2], b.Values())
+}
+
+func TestDecimal256Slice(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	dtype := &arrow.Decimal256Type{Precision: 10, Scale: 1}
+	b := array.NewDecimal256Builder(mem, dtype)
+	defer b.Release()
+
+	var data = []decimal256.Num{
+		decimal256.FromI64(-1),
+		decimal256.FromI64(+0),
+		decimal256.FromI64(+1),
+		decimal256.FromI64(44),
+	}
+	b.AppendValues(data[:2], nil)
+	b.AppendNull()
+	b.Append(data[3])
+
+	arr := b.NewDecimal256Array()
+	defer arr.Release()
+
+	if got, want := arr.Len(), len(data); got != want {
+		t.Fatalf("invalid array length: got=%d, want=%d", got, want)
+	}
+
+	slice := array.NewSliceData(arr.Data(), 1, 4)
+	defer slice.Release()
+
+	sub := array.MakeFromData(slice).(*array.Decimal256)
+	defer sub.Release()
+
+	if got, want := sub.String(), `[0.0 (null) 4.4]`; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+	if got, want := sub.NullN(), 1; got != want {
+		t.Fatalf("got=%d, want=%d", got, want)
+	}
+	if got, want := sub.Value(2), data[3]; got != want {
+		t.Fatalf("invalid value: got=%v, want=%v", got, want)
+	}
+
+	if !array.ArrayEqual(sub, sub) {
+		t.Fatalf("slice should be equal to itself")
+	}
+	if array.ArrayEqual(arr, sub) {
+		t.Fatalf("arrays of different lengths should not be equal")
+	}
+}
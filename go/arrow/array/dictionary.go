@@ -0,0 +1,578 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Dictionary represents an immutable sequence of dictionary-encoded values.
+//
+// Dictionary holds the indices of its elements in its own buffers, and the
+// dictionary of the distinct values as its only child array. Null elements
+// are null indices.
+type Dictionary struct {
+	array
+	indices Interface
+	dict    Interface
+
+	index func(i int) int // returns the i-th index, relative to the array offset.
+}
+
+// NewDictionaryData returns a new Dictionary array value, from data.
+func NewDictionaryData(data *Data) *Dictionary {
+	a := &Dictionary{}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+// NewDictionaryArray returns a new Dictionary array of type typ, from the
+// provided indices and dictionary values.
+//
+// NewDictionaryArray panics if the data types of indices and dict do not
+// match typ.
+func NewDictionaryArray(typ *arrow.DictionaryType, indices, dict Interface) *Dictionary {
+	switch {
+	case !arrow.ValidDictionaryIndexType(typ.IndexType):
+		panic(fmt.Errorf("arrow/array: invalid dictionary index type %v", typ.IndexType))
+	case !arrow.TypeEquals(indices.DataType(), typ.IndexType):
+		panic(fmt.Errorf("arrow/array: dictionary index type mismatch (got=%v, want=%v)", indices.DataType(), typ.IndexType))
+	case !arrow.TypeEquals(dict.DataType(), typ.ValueType):
+		panic(fmt.Errorf("arrow/array: dictionary value type mismatch (got=%v, want=%v)", dict.DataType(), typ.ValueType))
+	}
+
+	idata := indices.Data()
+	data := NewData(typ, idata.Len(), idata.Buffers(), []*Data{dict.Data()}, idata.NullN(), idata.Offset())
+	defer data.Release()
+	return NewDictionaryData(data)
+}
+
+// Indices returns the array of the indices of the elements in the dictionary.
+func (a *Dictionary) Indices() Interface { return a.indices }
+
+// Dictionary returns the array of the dictionary values.
+func (a *Dictionary) Dictionary() Interface { return a.dict }
+
+// GetValueIndex returns the index, in the dictionary, of the value of the
+// i-th element. The result is undefined for null elements.
+func (a *Dictionary) GetValueIndex(i int) int { return a.index(i) }
+
+func (a *Dictionary) String() string {
+	o := new(strings.Builder)
+	newStringerConfig(nil).writeArray(o, a)
+	return o.String()
+}
+
+func (a *Dictionary) setData(data *Data) {
+	a.array.setData(data)
+
+	typ := data.dtype.(*arrow.DictionaryType)
+	idata := NewData(typ.IndexType, data.length, data.buffers, nil, data.nulls, data.offset)
+	defer idata.Release()
+	a.indices = MakeFromData(idata)
+	a.dict = MakeFromData(data.childData[0])
+
+	switch indices := a.indices.(type) {
+	case *Int8:
+		a.index = func(i int) int { return int(indices.Value(i)) }
+	case *Int16:
+		a.index = func(i int) int { return int(indices.Value(i)) }
+	case *Int32:
+		a.index = func(i int) int { return int(indices.Value(i)) }
+	case *Int64:
+		a.index = func(i int) int { return int(indices.Value(i)) }
+	case *Uint8:
+		a.index = func(i int) int { return int(indices.Value(i)) }
+	case *Uint16:
+		a.index = func(i int) int { return int(indices.Value(i)) }
+	case *Uint32:
+		a.index = func(i int) int { return int(indices.Value(i)) }
+	case *Uint64:
+		a.index = func(i int) int { return int(indices.Value(i)) }
+	default:
+		panic(fmt.Errorf("arrow/array: invalid dictionary index type %v", a.indices.DataType()))
+	}
+}
+
+// arrayEqualDictionary reports whether the logical elements of left and
+// right are equal, comparing their dictionary values with eq, which
+// compares the value at index i of lv with the value at index j of rv.
+func arrayEqualDictionary(left, right *Dictionary, eq func(lv Interface, i int, rv Interface, j int) bool) bool {
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) {
+			continue
+		}
+		if !eq(left.dict, left.index(i), right.dict, right.index(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *Dictionary) Retain() {
+	a.array.Retain()
+	a.indices.Retain()
+	a.dict.Retain()
+}
+
+func (a *Dictionary) Release() {
+	a.array.Release()
+	a.indices.Release()
+	a.dict.Release()
+}
+
+// DictionaryEncode returns the dictionary-encoded form of arr, with indices
+// of type indexType. The dictionary holds the distinct non-null elements of
+// arr, in first-seen order, and null elements of arr are null indices.
+//
+// DictionaryEncode supports boolean, numeric, temporal and string arrays.
+// NaN elements are considered equal to each other.
+// DictionaryEncode returns an error if indexType is not an integer type, or
+// if it cannot represent the index of every distinct element of arr.
+// The returned array must be Release()'d after use.
+func DictionaryEncode(arr Interface, indexType arrow.DataType, mem memory.Allocator) (*Dictionary, error) {
+	if !arrow.ValidDictionaryIndexType(indexType) {
+		return nil, fmt.Errorf("arrow/array: invalid dictionary index type %v", indexType)
+	}
+
+	if arr.DataType().ID() == arrow.DICTIONARY {
+		return nil, fmt.Errorf("arrow/array: %v array already dictionary-encoded", arr.DataType())
+	}
+	value, ok := goValueFunc(arr)
+	if !ok {
+		return nil, fmt.Errorf("arrow/array: hashing not supported for %v arrays", arr.DataType())
+	}
+
+	var (
+		n      = arr.Len()
+		ids    = make([]int, n)
+		firsts []int
+		lookup = make(map[interface{}]int)
+	)
+	for i := 0; i < n; i++ {
+		if arr.IsNull(i) {
+			continue
+		}
+		k := hashKey(value(i))
+		id, ok := lookup[k]
+		if !ok {
+			id = len(firsts)
+			lookup[k] = id
+			firsts = append(firsts, i)
+		}
+		ids[i] = id
+	}
+
+	if len(firsts) > 0 && uint64(len(firsts)-1) > maxDictionaryIndex(indexType) {
+		return nil, fmt.Errorf("arrow/array: %d distinct values exceed the range of dictionary index type %v", len(firsts), indexType)
+	}
+
+	dict, err := Take(arr, firsts, mem)
+	if err != nil {
+		return nil, err
+	}
+	defer dict.Release()
+
+	bldr := NewBuilder(mem, indexType)
+	defer bldr.Release()
+
+	bldr.Reserve(n)
+	for i, id := range ids {
+		if arr.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+		if err := appendGoValue(bldr, id); err != nil {
+			return nil, err
+		}
+	}
+	indices := bldr.NewArray()
+	defer indices.Release()
+
+	typ := &arrow.DictionaryType{IndexType: indexType, ValueType: arr.DataType()}
+	return NewDictionaryArray(typ, indices, dict), nil
+}
+
+// DictionaryDecode returns the plain array of the values of the elements of
+// d, gathering the dictionary value at the index of each element.
+// Null elements of d are null in the returned array, whose data type is the
+// value type of d.
+// The returned array must be Release()'d after use.
+func DictionaryDecode(d *Dictionary, mem memory.Allocator) (Interface, error) {
+	var (
+		n       = d.Len()
+		indices = make([]int, n)
+	)
+	for i := range indices {
+		if d.IsNull(i) {
+			continue // gather the first value, masked out below.
+		}
+		indices[i] = d.index(i)
+	}
+
+	if d.dict.Len() == 0 {
+		if n != d.NullN() {
+			return nil, fmt.Errorf("arrow/array: dictionary index %d out of range [0, 0)", d.index(0))
+		}
+		bldr := NewBuilder(mem, d.dict.DataType())
+		defer bldr.Release()
+		bldr.AppendNulls(n)
+		return bldr.NewArray(), nil
+	}
+
+	out, err := Take(d.dict, indices, mem)
+	if err != nil {
+		return nil, err
+	}
+	if d.NullN() == 0 {
+		return out, nil
+	}
+	defer out.Release()
+
+	var (
+		validity = newZeroedBuffer(mem, int(bitutil.BytesForBits(int64(n))))
+		bitmap   = validity.Bytes()
+		nulls    = 0
+	)
+	defer validity.Release()
+	for i := 0; i < n; i++ {
+		if d.IsNull(i) || out.IsNull(i) {
+			nulls++
+			continue
+		}
+		bitutil.SetBit(bitmap, i)
+	}
+
+	odata := out.Data()
+	buffers := append([]*memory.Buffer{validity}, odata.Buffers()[1:]...)
+	data := NewData(odata.DataType(), n, buffers, odata.Children(), nulls, 0)
+	defer data.Release()
+	return MakeFromData(data), nil
+}
+
+// UnifyDictionaries merges the value dictionaries of dicts, which must share
+// the same value type, into a single dictionary holding their distinct
+// values, in first-seen order. Null values are merged into a single null
+// value.
+//
+// transposeMaps[i][j] is the index in unified of the j-th value of the
+// dictionary of dicts[i], so that the indices of dicts[i] can be remapped to
+// unified, e.g. to combine dictionary arrays from separate batches into one
+// chunked column with a shared dictionary.
+//
+// UnifyDictionaries supports the value types supported by DictionaryEncode.
+// The unified array is allocated with memory.DefaultAllocator and must be
+// released after use.
+func UnifyDictionaries(dicts []*Dictionary) (unified Interface, transposeMaps [][]int32, err error) {
+	if len(dicts) == 0 {
+		return nil, nil, fmt.Errorf("arrow/array: no dictionaries to unify")
+	}
+
+	dtype := dicts[0].dict.DataType()
+	values := make([]func(int) interface{}, len(dicts))
+	for i, d := range dicts {
+		if dt := d.dict.DataType(); !arrow.TypeEquals(dt, dtype) {
+			return nil, nil, fmt.Errorf("arrow/array: dictionary value type mismatch (dictionary %d: got=%v, want=%v)", i, dt, dtype)
+		}
+		value, ok := goValueFunc(d.dict)
+		if !ok {
+			return nil, nil, fmt.Errorf("arrow/array: hashing not supported for %v arrays", dtype)
+		}
+		values[i] = value
+	}
+
+	bldr := NewBuilder(memory.DefaultAllocator, dtype)
+	defer bldr.Release()
+
+	var (
+		lookup = make(map[interface{}]int32)
+		nullID = int32(-1)
+	)
+	transposeMaps = make([][]int32, len(dicts))
+	for i, d := range dicts {
+		transpose := make([]int32, d.dict.Len())
+		for j := range transpose {
+			if d.dict.IsNull(j) {
+				if nullID < 0 {
+					nullID = int32(bldr.Len())
+					bldr.AppendNull()
+				}
+				transpose[j] = nullID
+				continue
+			}
+			v := values[i](j)
+			k := hashKey(v)
+			id, ok := lookup[k]
+			if !ok {
+				id = int32(bldr.Len())
+				lookup[k] = id
+				if err := appendGoValue(bldr, v); err != nil {
+					return nil, nil, err
+				}
+			}
+			transpose[j] = id
+		}
+		transposeMaps[i] = transpose
+	}
+
+	return bldr.NewArray(), transposeMaps, nil
+}
+
+// maxDictionaryIndex returns the largest index representable by the integer
+// data type dt.
+func maxDictionaryIndex(dt arrow.DataType) uint64 {
+	bits := uint(dt.(arrow.FixedWidthDataType).BitWidth())
+	switch dt.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64:
+		return 1<<(bits-1) - 1
+	default:
+		return 1<<bits - 1
+	}
+}
+
+// DictionaryBuilder builds Dictionary arrays from plain values, which it
+// deduplicates: each distinct value is appended once to the dictionary, and
+// each element is appended as the index of its value in the dictionary.
+//
+// DictionaryBuilder supports numeric, string and binary values. NaN values
+// are considered equal to each other.
+type DictionaryBuilder struct {
+	builder
+
+	dtype    *arrow.DictionaryType
+	indices  Builder             // builder of the indices, of the index type.
+	values   Builder             // builder of the dictionary values.
+	lookup   map[interface{}]int // dictionary index of each distinct value, by key.
+	maxIndex uint64              // largest index representable by the index type.
+	zero     interface{}         // empty value of the value type.
+}
+
+// NewDictionaryBuilder returns a builder, using the provided memory allocator.
+// The created builder will create dictionary-encoded arrays of type dtype.
+//
+// NewDictionaryBuilder panics if the index type of dtype is not an integer
+// type, or if its value type is not supported.
+func NewDictionaryBuilder(mem memory.Allocator, dtype *arrow.DictionaryType) *DictionaryBuilder {
+	if !arrow.ValidDictionaryIndexType(dtype.IndexType) {
+		panic(fmt.Errorf("arrow/array: invalid dictionary index type %v", dtype.IndexType))
+	}
+	var zero interface{} = 0
+	if id := dtype.ValueType.ID(); id == arrow.STRING || id == arrow.BINARY {
+		zero = ""
+	}
+	zero, _, err := dictionaryValue(dtype.ValueType, zero)
+	if err != nil {
+		panic(fmt.Errorf("arrow/array: unsupported dictionary value type %v", dtype.ValueType))
+	}
+
+	return &DictionaryBuilder{
+		builder:  builder{refCount: 1, mem: mem},
+		dtype:    dtype,
+		indices:  NewBuilder(mem, dtype.IndexType),
+		values:   NewBuilder(mem, dtype.ValueType),
+		lookup:   make(map[interface{}]int),
+		maxIndex: maxDictionaryIndex(dtype.IndexType),
+		zero:     zero,
+	}
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+func (b *DictionaryBuilder) Release() {
+	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&b.refCount, -1) == 0 {
+		if b.indices != nil {
+			b.indices.Release()
+			b.indices = nil
+		}
+		if b.values != nil {
+			b.values.Release()
+			b.values = nil
+		}
+	}
+}
+
+func (b *DictionaryBuilder) setGrowth(g growthPolicy) {
+	b.builder.setGrowth(g)
+	b.indices.setGrowth(g)
+	b.values.setGrowth(g)
+}
+
+// DictionaryLen returns the number of distinct values appended to the
+// dictionary so far.
+func (b *DictionaryBuilder) DictionaryLen() int { return b.values.Len() }
+
+// AppendValue appends the Go value v, converted to the value type as
+// RecordFromMaps does. A nil v appends a null element.
+//
+// AppendValue appends v to the dictionary only if it does not hold an equal
+// value yet. It returns an error if v cannot be converted to the value type,
+// or if the index of a new value would overflow the index type.
+func (b *DictionaryBuilder) AppendValue(v interface{}) error {
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+
+	value, key, err := dictionaryValue(b.dtype.ValueType, v)
+	if err != nil {
+		return err
+	}
+
+	idx, ok := b.lookup[key]
+	if !ok {
+		idx = b.values.Len()
+		if uint64(idx) > b.maxIndex {
+			return fmt.Errorf("arrow/array: %d distinct values exceed the range of dictionary index type %v", idx+1, b.dtype.IndexType)
+		}
+		if err := appendGoValue(b.values, value); err != nil {
+			return err
+		}
+		b.lookup[key] = idx
+	}
+
+	if err := appendGoValue(b.indices, idx); err != nil {
+		return err
+	}
+	b.length++
+	return nil
+}
+
+// AppendNull appends a null element, as a null index.
+func (b *DictionaryBuilder) AppendNull() {
+	b.indices.AppendNull()
+	b.length++
+	b.nulls++
+}
+
+// AppendNulls appends n null elements, as null indices.
+func (b *DictionaryBuilder) AppendNulls(n int) {
+	b.indices.AppendNulls(n)
+	b.length += n
+	b.nulls += n
+}
+
+// AppendEmptyValue appends the zero value of the value type: zero, or an
+// empty string or binary value.
+func (b *DictionaryBuilder) AppendEmptyValue() {
+	if err := b.AppendValue(b.zero); err != nil {
+		b.invalid(b, "AppendEmptyValue", err)
+	}
+}
+
+// Reserve ensures there is enough space for appending n elements.
+func (b *DictionaryBuilder) Reserve(n int) { b.indices.Reserve(n) }
+
+// Resize adjusts the space allocated by b to n elements.
+func (b *DictionaryBuilder) Resize(n int) { b.indices.Resize(n) }
+
+func (*DictionaryBuilder) init(capacity int)                  {}
+func (*DictionaryBuilder) resize(newBits int, init func(int)) {}
+
+// NewArray creates a Dictionary array from the memory buffers used by the builder and resets the DictionaryBuilder
+// so it can be used to build a new array, with a new dictionary.
+func (b *DictionaryBuilder) NewArray() Interface {
+	return b.NewDictionaryArray()
+}
+
+// NewDictionaryArray creates a Dictionary array from the memory buffers used by the builder and resets the DictionaryBuilder
+// so it can be used to build a new array, with a new dictionary.
+func (b *DictionaryBuilder) NewDictionaryArray() *Dictionary {
+	indices := b.indices.NewArray()
+	defer indices.Release()
+	values := b.values.NewArray()
+	defer values.Release()
+
+	b.reset()
+	b.lookup = make(map[interface{}]int)
+
+	return NewDictionaryArray(b.dtype, indices, values)
+}
+
+// dictionaryValue returns the Go value v converted to the value type dt of
+// a dictionary, and the key identifying that value in the dictionary.
+func dictionaryValue(dt arrow.DataType, v interface{}) (value, key interface{}, err error) {
+	switch dt.ID() {
+	case arrow.INT8:
+		var i int64
+		i, err = coerceInt(v, math.MinInt8, math.MaxInt8, dt)
+		value = int8(i)
+	case arrow.INT16:
+		var i int64
+		i, err = coerceInt(v, math.MinInt16, math.MaxInt16, dt)
+		value = int16(i)
+	case arrow.INT32:
+		var i int64
+		i, err = coerceInt(v, math.MinInt32, math.MaxInt32, dt)
+		value = int32(i)
+	case arrow.INT64:
+		value, err = coerceInt(v, math.MinInt64, math.MaxInt64, dt)
+	case arrow.UINT8:
+		var u uint64
+		u, err = coerceUint(v, math.MaxUint8, dt)
+		value = uint8(u)
+	case arrow.UINT16:
+		var u uint64
+		u, err = coerceUint(v, math.MaxUint16, dt)
+		value = uint16(u)
+	case arrow.UINT32:
+		var u uint64
+		u, err = coerceUint(v, math.MaxUint32, dt)
+		value = uint32(u)
+	case arrow.UINT64:
+		value, err = coerceUint(v, math.MaxUint64, dt)
+	case arrow.FLOAT16:
+		var f float64
+		f, err = coerceFloat(v, dt)
+		value = float16.New(float32(f)).Float32()
+	case arrow.FLOAT32:
+		var f float64
+		f, err = coerceFloat(v, dt)
+		value = float32(f)
+	case arrow.FLOAT64:
+		value, err = coerceFloat(v, dt)
+	case arrow.STRING, arrow.BINARY:
+		switch v := v.(type) {
+		case string:
+			value, key = v, v
+		case []byte:
+			value, key = v, string(v)
+		default:
+			err = errCoerce(v, dt)
+		}
+		return value, key, err
+	default:
+		return nil, nil, fmt.Errorf("unsupported dictionary value type %v", dt)
+	}
+	return value, hashKey(value), err
+}
+
+var (
+	_ Interface = (*Dictionary)(nil)
+	_ Builder   = (*DictionaryBuilder)(nil)
+)
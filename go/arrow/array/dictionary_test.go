@@ -0,0 +1,409 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestDictionaryEncode(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		colors = []string{"red", "green", "blue"}
+		valids = make([]bool, 100)
+		values = make([]string, 100)
+	)
+	for i := range values {
+		values[i] = colors[i%len(colors)]
+		valids[i] = i%7 != 0
+	}
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues(values, valids)
+	arr := sb.NewStringArray()
+	defer arr.Release()
+
+	for _, idx := range []arrow.DataType{
+		arrow.PrimitiveTypes.Int8,
+		arrow.PrimitiveTypes.Uint16,
+		arrow.PrimitiveTypes.Int32,
+		arrow.PrimitiveTypes.Uint64,
+	} {
+		t.Run(idx.Name(), func(t *testing.T) {
+			dict, err := array.DictionaryEncode(arr, idx, mem)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer dict.Release()
+
+			want := &arrow.DictionaryType{IndexType: idx, ValueType: arrow.BinaryTypes.String}
+			if got := dict.DataType(); !arrow.TypeEquals(got, want) {
+				t.Fatalf("invalid type: got=%v, want=%v", got, want)
+			}
+			if got, want := dict.Len(), arr.Len(); got != want {
+				t.Fatalf("invalid length: got=%d, want=%d", got, want)
+			}
+			if got, want := dict.NullN(), arr.NullN(); got != want {
+				t.Fatalf("invalid number of nulls: got=%d, want=%d", got, want)
+			}
+
+			// element 0 is null: the dictionary starts with the first non-null element.
+			values := dict.Dictionary().(*array.String)
+			if got, want := fmt.Sprint(values), `["green" "blue" "red"]`; got != want {
+				t.Fatalf("invalid dictionary: got=%s, want=%s", got, want)
+			}
+
+			for i := 0; i < arr.Len(); i++ {
+				if got, want := dict.IsNull(i), arr.IsNull(i); got != want {
+					t.Fatalf("invalid validity of element %d: got=%v, want=%v", i, got, want)
+				}
+				if arr.IsNull(i) {
+					continue
+				}
+				if got, want := values.Value(dict.GetValueIndex(i)), arr.Value(i); got != want {
+					t.Fatalf("invalid element %d: got=%q, want=%q", i, got, want)
+				}
+			}
+
+			if !array.ArrayEqual(dict, dict) {
+				t.Fatalf("dictionary array does not equal itself")
+			}
+			if got, want := dict.String(), `[(null) "green" "blue" "red"`; !strings.HasPrefix(got, want) {
+				t.Fatalf("invalid string: got=%s, want=%s...", got, want)
+			}
+		})
+	}
+}
+
+func TestDictionaryEncodeErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+	for i := 0; i < 200; i++ {
+		ib.Append(int64(i % 130))
+	}
+	arr := ib.NewArray()
+	defer arr.Release()
+
+	for _, tc := range []struct {
+		idx arrow.DataType
+		err string
+	}{
+		{arrow.PrimitiveTypes.Int8, "130 distinct values exceed the range of dictionary index type int8"},
+		{arrow.PrimitiveTypes.Float32, "invalid dictionary index type float32"},
+	} {
+		t.Run(tc.idx.Name(), func(t *testing.T) {
+			_, err := array.DictionaryEncode(arr, tc.idx, mem)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+			}
+		})
+	}
+
+	// uint8 indices can address up to 256 distinct values.
+	dict, err := array.DictionaryEncode(arr, arrow.PrimitiveTypes.Uint8, mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dict.Release()
+	if got, want := dict.Dictionary().Len(), 130; got != want {
+		t.Fatalf("invalid dictionary length: got=%d, want=%d", got, want)
+	}
+}
+
+func TestDictionaryDecode(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues(
+		[]string{"a", "b", "", "a", "c", "", "b", "b"},
+		[]bool{true, true, false, true, true, false, true, true},
+	)
+	strs := sb.NewArray()
+	defer strs.Release()
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int64{4, 4, 2, 0, 2, 4}, nil)
+	ints := ib.NewArray()
+	defer ints.Release()
+
+	nulls := array.NewBuilder(mem, arrow.PrimitiveTypes.Float64)
+	defer nulls.Release()
+	nulls.AppendNulls(5)
+	allNulls := nulls.NewArray()
+	defer allNulls.Release()
+
+	strSlice := array.NewSlice(strs, 2, 7)
+	defer strSlice.Release()
+
+	for _, tc := range []struct {
+		name string
+		arr  array.Interface
+	}{
+		{"strings", strs},
+		{"strings-slice", strSlice},
+		{"ints", ints},
+		{"all-nulls", allNulls},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dict, err := array.DictionaryEncode(tc.arr, arrow.PrimitiveTypes.Int16, mem)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer dict.Release()
+
+			sliced := array.NewSlice(dict, 1, int64(dict.Len())).(*array.Dictionary)
+			defer sliced.Release()
+
+			for _, d := range []*array.Dictionary{dict, sliced} {
+				got, err := array.DictionaryDecode(d, mem)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer got.Release()
+
+				want := array.NewSlice(tc.arr, int64(dict.Len()-d.Len()), int64(dict.Len()))
+				defer want.Release()
+
+				if !arrow.TypeEquals(got.DataType(), want.DataType()) {
+					t.Fatalf("invalid type: got=%v, want=%v", got.DataType(), want.DataType())
+				}
+				if !array.ArrayEqual(got, want) {
+					t.Fatalf("invalid decoded array:\ngot= %v\nwant=%v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDictionaryBuilder(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	t.Run("string", func(t *testing.T) {
+		dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Uint8, ValueType: arrow.BinaryTypes.String}
+		bldr := array.NewBuilder(mem, dtype).(*array.DictionaryBuilder)
+		defer bldr.Release()
+
+		for _, v := range []interface{}{"red", "green", nil, "red", []byte("blue"), "green"} {
+			if err := bldr.AppendValue(v); err != nil {
+				t.Fatal(err)
+			}
+		}
+		bldr.AppendEmptyValue()
+		if got, want := bldr.DictionaryLen(), 4; got != want {
+			t.Fatalf("invalid dictionary length: got=%d, want=%d", got, want)
+		}
+
+		arr := bldr.NewDictionaryArray()
+		defer arr.Release()
+
+		if got, want := arr.DataType(), arrow.DataType(dtype); got != want {
+			t.Fatalf("invalid data type: got=%v, want=%v", got, want)
+		}
+		if got, want := arr.Dictionary().(*array.String).String(), `["red" "green" "blue" ""]`; got != want {
+			t.Fatalf("invalid dictionary: got=%s, want=%s", got, want)
+		}
+		if got, want := arr.Indices().(*array.Uint8).String(), "[0 1 (null) 0 2 1 3]"; got != want {
+			t.Fatalf("invalid indices: got=%s, want=%s", got, want)
+		}
+		if got, want := arr.NullN(), 1; got != want {
+			t.Fatalf("invalid nulls: got=%d, want=%d", got, want)
+		}
+
+		// the dictionary is reset along with the builder.
+		if err := bldr.AppendValue("green"); err != nil {
+			t.Fatal(err)
+		}
+		arr2 := bldr.NewDictionaryArray()
+		defer arr2.Release()
+		if got, want := arr2.Dictionary().(*array.String).String(), `["green"]`; got != want {
+			t.Fatalf("invalid dictionary: got=%s, want=%s", got, want)
+		}
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int16, ValueType: arrow.PrimitiveTypes.Float64}
+		bldr := array.NewDictionaryBuilder(mem, dtype)
+		defer bldr.Release()
+
+		for _, v := range []interface{}{1.5, 2, 1.5, math.NaN(), int64(2), math.NaN()} {
+			if err := bldr.AppendValue(v); err != nil {
+				t.Fatal(err)
+			}
+		}
+		bldr.AppendNulls(2)
+
+		arr := bldr.NewDictionaryArray()
+		defer arr.Release()
+
+		if got, want := arr.Dictionary().(*array.Float64).String(), "[1.5 2 NaN]"; got != want {
+			t.Fatalf("invalid dictionary: got=%s, want=%s", got, want)
+		}
+		if got, want := arr.Indices().(*array.Int16).String(), "[0 1 0 2 1 2 (null) (null)]"; got != want {
+			t.Fatalf("invalid indices: got=%s, want=%s", got, want)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		dtype := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.PrimitiveTypes.Int32}
+		bldr := array.NewDictionaryBuilder(mem, dtype)
+		defer bldr.Release()
+
+		err := bldr.AppendValue("one")
+		if got, want := fmt.Sprint(err), "cannot convert value one (type string) to int32"; got != want {
+			t.Fatalf("invalid error: got=%q, want=%q", got, want)
+		}
+
+		for i := 0; i < 128; i++ {
+			if err := bldr.AppendValue(i); err != nil {
+				t.Fatal(err)
+			}
+		}
+		err = bldr.AppendValue(128)
+		if got, want := fmt.Sprint(err), "arrow/array: 129 distinct values exceed the range of dictionary index type int8"; got != want {
+			t.Fatalf("invalid error: got=%q, want=%q", got, want)
+		}
+		if got, want := bldr.Len(), 128; got != want {
+			t.Fatalf("invalid length: got=%d, want=%d", got, want)
+		}
+
+		arr := bldr.NewArray()
+		arr.Release()
+
+		defer func() {
+			if e := recover(); e == nil {
+				t.Fatalf("expected a panic")
+			}
+		}()
+		array.NewDictionaryBuilder(mem, &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.FixedWidthTypes.Boolean})
+	})
+}
+
+func TestUnifyDictionaries(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	encode := func(vs []string, valids []bool) *array.Dictionary {
+		sb := array.NewStringBuilder(mem)
+		defer sb.Release()
+		sb.AppendValues(vs, valids)
+		arr := sb.NewArray()
+		defer arr.Release()
+
+		dict, err := array.DictionaryEncode(arr, arrow.PrimitiveTypes.Int8, mem)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dict
+	}
+
+	d1 := encode([]string{"a", "b", "a", "c"}, nil)
+	defer d1.Release()
+	d2 := encode([]string{"c", "d", "", "a"}, []bool{true, true, false, true})
+	defer d2.Release()
+
+	unified, transposeMaps, err := array.UnifyDictionaries([]*array.Dictionary{d1, d2})
+	if err != nil {
+		t.Fatalf("could not unify dictionaries: %+v", err)
+	}
+	defer unified.Release()
+
+	if got, want := fmt.Sprintf("%v", unified), `["a" "b" "c" "d"]`; got != want {
+		t.Fatalf("invalid unified dictionary: got=%s, want=%s", got, want)
+	}
+	if got, want := fmt.Sprint(transposeMaps), "[[0 1 2] [2 3 0]]"; got != want {
+		t.Fatalf("invalid transpose maps: got=%s, want=%s", got, want)
+	}
+
+	// remapped indices refer to the same values.
+	values := unified.(*array.String)
+	for i, d := range []*array.Dictionary{d1, d2} {
+		dict := d.Dictionary().(*array.String)
+		for j := 0; j < d.Len(); j++ {
+			if d.IsNull(j) {
+				continue
+			}
+			idx := d.GetValueIndex(j)
+			if got, want := values.Value(int(transposeMaps[i][idx])), dict.Value(idx); got != want {
+				t.Fatalf("invalid value of element %d of dictionary %d: got=%q, want=%q", j, i, got, want)
+			}
+		}
+	}
+}
+
+func TestUnifyDictionariesErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int64{1, 2}, nil)
+	ints := ib.NewArray()
+	defer ints.Release()
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues([]string{"a"}, nil)
+	strs := sb.NewArray()
+	defer strs.Release()
+
+	di, err := array.DictionaryEncode(ints, arrow.PrimitiveTypes.Int8, mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer di.Release()
+	ds, err := array.DictionaryEncode(strs, arrow.PrimitiveTypes.Int8, mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ds.Release()
+
+	for _, tc := range []struct {
+		name  string
+		dicts []*array.Dictionary
+		err   string
+	}{
+		{"empty", nil, "arrow/array: no dictionaries to unify"},
+		{"type-mismatch", []*array.Dictionary{di, ds}, "arrow/array: dictionary value type mismatch (dictionary 1: got=utf8, want=int64)"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := array.UnifyDictionaries(tc.dicts)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if got, want := err.Error(), tc.err; got != want {
+				t.Fatalf("invalid error: got=%q, want=%q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+// Edit is one entry of an edit script transforming a base array into a
+// target array: an insertion or a deletion of one element, followed by a
+// run of RunLength elements shared by both arrays.
+type Edit struct {
+	Insert    bool  // whether an element is inserted into (true) or deleted from (false) base.
+	RunLength int64 // number of shared elements following the insertion or deletion.
+}
+
+// Edits is an edit script transforming a base array into a target array.
+//
+// The edit script begins with a run of shared elements: the Insert field
+// of its first Edit is meaningless and must be ignored.
+// An empty edit script, or one holding a single Edit, means both arrays
+// are equal.
+type Edits []Edit
+
+// Diff computes an edit script transforming base into target, using the
+// Myers O(ND) difference algorithm.
+// Elements are compared as with ArrayEqual: null elements are equal to
+// each other and different from any valid element.
+//
+// Diff returns an error if base and target have different data types.
+// Equal arrays yield an empty edit script.
+func Diff(base, target Interface) (Edits, error) {
+	if !arrow.TypeEquals(base.DataType(), target.DataType()) {
+		return nil, fmt.Errorf("arrow/array: cannot diff arrays of different types (%v and %v)", base.DataType(), target.DataType())
+	}
+	if ArrayEqual(base, target) {
+		return nil, nil
+	}
+
+	eq := elemEqualFunc(base, target)
+	ops := myersDiff(base.Len(), target.Len(), eq)
+
+	edits := Edits{{}}
+	for _, op := range ops {
+		switch op {
+		case diffEqual:
+			edits[len(edits)-1].RunLength++
+		case diffInsert:
+			edits = append(edits, Edit{Insert: true})
+		case diffDelete:
+			edits = append(edits, Edit{Insert: false})
+		}
+	}
+	return edits, nil
+}
+
+// UnifiedDiff renders the edit script, computed by Diff(base, target), as
+// one hunk per sequence of consecutive insertions and deletions, such as:
+//  @@ -2, +2 @@
+//  -3
+//  +4
+//  +5
+// where each hunk header holds the indices of the first changed element
+// in base and target.
+func (e Edits) UnifiedDiff(base, target Interface) string {
+	var (
+		o    = new(strings.Builder)
+		cfg  = newStringerConfig(nil)
+		bi   int
+		ti   int
+		hunk = false
+	)
+	for k, edit := range e {
+		if k > 0 {
+			if !hunk {
+				fmt.Fprintf(o, "@@ -%d, +%d @@\n", bi, ti)
+				hunk = true
+			}
+			if edit.Insert {
+				o.WriteString("+")
+				cfg.writeValue(o, target, ti)
+				ti++
+			} else {
+				o.WriteString("-")
+				cfg.writeValue(o, base, bi)
+				bi++
+			}
+			o.WriteString("\n")
+		}
+		if edit.RunLength > 0 {
+			hunk = false
+			bi += int(edit.RunLength)
+			ti += int(edit.RunLength)
+		}
+	}
+	return o.String()
+}
+
+// RecordDiff describes the first difference between the two provided
+// records, or returns an empty string if they are approximately equal, as
+// per RecordApproxEqual with the same options.
+// The description names the first differing column and row, such as:
+//  column 1 "f64", row 2: left=1.5, right=1.75
+// when the schemas and numbers of rows of both records match.
+func RecordDiff(left, right Record, opts ...EqualOption) string {
+	opt := newEqualOption(opts...)
+	switch {
+	case !schemaEqual(left.Schema(), right.Schema(), opt):
+		return fmt.Sprintf("schema mismatch:\nleft: %v\nright: %v", left.Schema(), right.Schema())
+	case left.NumRows() != right.NumRows():
+		return fmt.Sprintf("number of rows mismatch: left=%d, right=%d", left.NumRows(), right.NumRows())
+	}
+
+	cfg := newStringerConfig(nil)
+	for i, lc := range left.Columns() {
+		rc := right.Column(i)
+		if arrayApproxEqual(lc, rc, opt) {
+			continue
+		}
+		for j := 0; j < lc.Len(); j++ {
+			if elemApproxEqual(lc, rc, j, opt) {
+				continue
+			}
+			o := new(strings.Builder)
+			fmt.Fprintf(o, "column %d %q, row %d: left=", i, left.ColumnName(i), j)
+			cfg.writeValue(o, lc, j)
+			o.WriteString(", right=")
+			cfg.writeValue(o, rc, j)
+			return o.String()
+		}
+	}
+	return ""
+}
+
+// elemApproxEqual reports whether the i-th elements of left and right are
+// approximately equal.
+func elemApproxEqual(left, right Interface, i int, opt equalOption) bool {
+	l := NewSlice(left, int64(i), int64(i+1))
+	defer l.Release()
+	r := NewSlice(right, int64(i), int64(i+1))
+	defer r.Release()
+	return arrayApproxEqual(l, r, opt)
+}
+
+type diffOp int8
+
+const (
+	diffEqual diffOp = iota
+	diffInsert
+	diffDelete
+)
+
+// myersDiff returns the sequence of operations transforming a sequence of n
+// elements into a sequence of m elements, where eq reports whether the i-th
+// element of the former equals the j-th element of the latter.
+// Deletions are placed before insertions.
+func myersDiff(n, m int, eq func(i, j int) bool) []diffOp {
+	var (
+		max   = n + m
+		v     = make([]int, 2*max+2) // furthest x reached on each diagonal k, at index k+max.
+		trace [][]int
+	)
+
+loop:
+	for d := 0; d <= max; d++ {
+		// furthest points before step d, on the diagonals in [-d, d]: the
+		// only ones read when backtracking through step d.
+		trace = append(trace, append([]int(nil), v[max-d:max+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[k-1+max] < v[k+1+max]) {
+				x = v[k+1+max] // move down: insertion.
+			} else {
+				x = v[k-1+max] + 1 // move right: deletion.
+			}
+			y := x - k
+			for x < n && y < m && eq(x, y) {
+				x++
+				y++
+			}
+			v[k+max] = x
+			if x >= n && y >= m {
+				break loop
+			}
+		}
+	}
+
+	// backtrack from (n, m) to (0, 0).
+	var (
+		ops  = make([]diffOp, 0, max)
+		x, y = n, m
+	)
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d] // diagonal k at index k+d.
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[k-1+d] < v[k+1+d]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[prevK+d]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			ops = append(ops, diffEqual)
+			x--
+			y--
+		}
+		if x == prevX {
+			ops = append(ops, diffInsert)
+		} else {
+			ops = append(ops, diffDelete)
+		}
+		x, y = prevX, prevY
+	}
+	for x > 0 && y > 0 {
+		ops = append(ops, diffEqual)
+		x--
+		y--
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+// elemEqualFunc returns a function reporting whether the i-th element of
+// left equals the j-th element of right.
+// left and right must have the same data type.
+func elemEqualFunc(left, right Interface) func(i, j int) bool {
+	var eq func(i, j int) bool
+	switch l := left.(type) {
+	case *Boolean:
+		r := right.(*Boolean)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *Int8:
+		r := right.(*Int8)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *Int16:
+		r := right.(*Int16)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *Int32:
+		r := right.(*Int32)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *Int64:
+		r := right.(*Int64)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *Uint8:
+		r := right.(*Uint8)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *Uint16:
+		r := right.(*Uint16)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *Uint32:
+		r := right.(*Uint32)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *Uint64:
+		r := right.(*Uint64)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *Float32:
+		r := right.(*Float32)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *Float64:
+		r := right.(*Float64)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *String:
+		r := right.(*String)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *LargeString:
+		r := right.(*LargeString)
+		eq = func(i, j int) bool { return l.Value(i) == r.Value(j) }
+	case *Binary:
+		r := right.(*Binary)
+		eq = func(i, j int) bool { return bytes.Equal(l.Value(i), r.Value(j)) }
+	case *LargeBinary:
+		r := right.(*LargeBinary)
+		eq = func(i, j int) bool { return bytes.Equal(l.Value(i), r.Value(j)) }
+	default:
+		eq = func(i, j int) bool {
+			return ArraySliceEqual(left, int64(i), int64(i+1), right, int64(j), int64(j+1))
+		}
+	}
+
+	return func(i, j int) bool {
+		lnull, rnull := left.IsNull(i), right.IsNull(j)
+		switch {
+		case lnull || rnull:
+			return lnull && rnull
+		default:
+			return eq(i, j)
+		}
+	}
+}
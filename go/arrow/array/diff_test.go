@@ -0,0 +1,182 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestDiff(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	newInt32 := func(vs []int32, valid []bool) array.Interface {
+		b := array.NewInt32Builder(mem)
+		defer b.Release()
+		b.AppendValues(vs, valid)
+		return b.NewArray()
+	}
+	newString := func(vs []string, valid []bool) array.Interface {
+		b := array.NewStringBuilder(mem)
+		defer b.Release()
+		b.AppendValues(vs, valid)
+		return b.NewArray()
+	}
+
+	for _, tc := range []struct {
+		name         string
+		base, target array.Interface
+		edits        array.Edits
+		diff         string
+	}{
+		{
+			name:   "equal",
+			base:   newInt32([]int32{1, 2, 3}, nil),
+			target: newInt32([]int32{1, 2, 3}, nil),
+		},
+		{
+			name:   "empty-base",
+			base:   newInt32(nil, nil),
+			target: newInt32([]int32{1, 2}, nil),
+			edits:  array.Edits{{}, {Insert: true}, {Insert: true}},
+			diff:   "@@ -0, +0 @@\n+1\n+2\n",
+		},
+		{
+			name:   "empty-target",
+			base:   newInt32([]int32{1, 2}, nil),
+			target: newInt32(nil, nil),
+			edits:  array.Edits{{}, {}, {}},
+			diff:   "@@ -0, +0 @@\n-1\n-2\n",
+		},
+		{
+			name:   "replace",
+			base:   newInt32([]int32{1, 2, 3, 4}, nil),
+			target: newInt32([]int32{1, 2, 5, 6, 4}, nil),
+			edits:  array.Edits{{RunLength: 2}, {}, {Insert: true}, {Insert: true, RunLength: 1}},
+			diff:   "@@ -2, +2 @@\n-3\n+5\n+6\n",
+		},
+		{
+			name:   "nulls",
+			base:   newInt32([]int32{1, 0, 3}, []bool{true, false, true}),
+			target: newInt32([]int32{1, 2, 3}, nil),
+			edits:  array.Edits{{RunLength: 1}, {}, {Insert: true, RunLength: 1}},
+			diff:   "@@ -1, +1 @@\n-(null)\n+2\n",
+		},
+		{
+			name:   "strings",
+			base:   newString([]string{"a", "b", "c", "d"}, nil),
+			target: newString([]string{"b", "c", "e", "d"}, nil),
+			edits:  array.Edits{{}, {RunLength: 2}, {Insert: true, RunLength: 1}},
+			diff:   "@@ -0, +0 @@\n-\"a\"\n@@ -3, +2 @@\n+\"e\"\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer tc.base.Release()
+			defer tc.target.Release()
+
+			edits, err := array.Diff(tc.base, tc.target)
+			if err != nil {
+				t.Fatalf("could not diff arrays: %+v", err)
+			}
+			if got, want := len(edits), len(tc.edits); got != want {
+				t.Fatalf("invalid number of edits: got=%d, want=%d\ngot= %+v\nwant=%+v", got, want, edits, tc.edits)
+			}
+			for i := range edits {
+				if got, want := edits[i], tc.edits[i]; got != want {
+					t.Fatalf("invalid edit %d: got=%+v, want=%+v", i, got, want)
+				}
+			}
+			if got, want := edits.UnifiedDiff(tc.base, tc.target), tc.diff; got != want {
+				t.Fatalf("invalid unified diff:\ngot:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestDiffRandom(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	// applying the edit script to base must yield target.
+	base := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 3, 4, 5}
+	target := []int64{0, 2, 3, 5, 6, 11, 12, 8, 9, 3, 4, 4, 5, 13}
+
+	bb := array.NewInt64Builder(mem)
+	defer bb.Release()
+	bb.AppendValues(base, nil)
+	barr := bb.NewArray()
+	defer barr.Release()
+	bb.AppendValues(target, nil)
+	tarr := bb.NewArray()
+	defer tarr.Release()
+
+	edits, err := array.Diff(barr, tarr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		got []int64
+		bi  int
+	)
+	for i, e := range edits {
+		if i > 0 {
+			if e.Insert {
+				got = append(got, tarr.(*array.Int64).Value(len(got)))
+			} else {
+				bi++
+			}
+		}
+		for j := int64(0); j < e.RunLength; j++ {
+			got = append(got, base[bi])
+			bi++
+		}
+	}
+	if bi != len(base) {
+		t.Fatalf("edit script does not consume base: got=%d, want=%d", bi, len(base))
+	}
+	if len(got) != len(target) {
+		t.Fatalf("invalid patched length: got=%d, want=%d", len(got), len(target))
+	}
+	for i := range got {
+		if got[i] != target[i] {
+			t.Fatalf("invalid patched value %d: got=%d, want=%d", i, got[i], target[i])
+		}
+	}
+}
+
+func TestDiffTypeMismatch(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	a := array.NewNull(2)
+	defer a.Release()
+	b := array.NewBuilder(mem, arrow.PrimitiveTypes.Int8)
+	defer b.Release()
+	b.AppendNull()
+	b.AppendNull()
+	arr := b.NewArray()
+	defer arr.Release()
+
+	if _, err := array.Diff(a, arr); err == nil {
+		t.Fatalf("expected an error")
+	}
+}
@@ -0,0 +1,114 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// FillNull returns a copy of arr where null elements are replaced with
+// value. The returned array has no null elements.
+//
+// value must be a Go value convertible to the element type of arr, as
+// accepted by RecordFromMaps, e.g. an int64 for an Int64 array or a string
+// for a String array.
+//
+// FillNull supports boolean, numeric, temporal and string arrays.
+func FillNull(arr Interface, value interface{}, pool memory.Allocator) (Interface, error) {
+	get, ok := goValueFunc(arr)
+	if !ok {
+		return nil, fmt.Errorf("arrow/array: fill null not supported for %v arrays", arr.DataType())
+	}
+	if value == nil {
+		return nil, fmt.Errorf("arrow/array: nil fill value")
+	}
+
+	bldr := NewBuilder(pool, arr.DataType())
+	defer bldr.Release()
+
+	// convert value once, to report type mismatches even without nulls.
+	if err := appendGoValue(bldr, value); err != nil {
+		return nil, fmt.Errorf("arrow/array: invalid fill value: %v", err)
+	}
+	bldr.NewArray().Release()
+
+	bldr.Reserve(arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		v := value
+		if arr.IsValid(i) {
+			v = get(i)
+		}
+		if err := appendGoValue(bldr, v); err != nil {
+			return nil, err
+		}
+	}
+	return bldr.NewArray(), nil
+}
+
+// Coalesce returns an array holding, for each index, the first valid
+// element at that index across arrs, or null if all of them are null.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+//
+// arrs must hold at least one array, and all of them must have the same
+// data type and length.
+// Coalesce supports boolean, numeric, temporal and string arrays.
+func Coalesce(arrs ...Interface) (Interface, error) {
+	if len(arrs) == 0 {
+		return nil, fmt.Errorf("arrow/array: no array to coalesce")
+	}
+
+	var (
+		dtype = arrs[0].DataType()
+		n     = arrs[0].Len()
+		gets  = make([]func(int) interface{}, len(arrs))
+	)
+	for i, arr := range arrs {
+		switch {
+		case !arrow.TypeEquals(arr.DataType(), dtype):
+			return nil, fmt.Errorf("arrow/array: coalesce type mismatch (array %d: got=%v, want=%v)", i, arr.DataType(), dtype)
+		case arr.Len() != n:
+			return nil, fmt.Errorf("arrow/array: coalesce length mismatch (array %d: got=%d, want=%d)", i, arr.Len(), n)
+		}
+		get, ok := goValueFunc(arr)
+		if !ok {
+			return nil, fmt.Errorf("arrow/array: coalesce not supported for %v arrays", dtype)
+		}
+		gets[i] = get
+	}
+
+	bldr := NewBuilder(memory.DefaultAllocator, dtype)
+	defer bldr.Release()
+
+	bldr.Reserve(n)
+elems:
+	for i := 0; i < n; i++ {
+		for j, arr := range arrs {
+			if arr.IsValid(i) {
+				if err := appendGoValue(bldr, gets[j](i)); err != nil {
+					return nil, err
+				}
+				continue elems
+			}
+		}
+		bldr.AppendNull()
+	}
+	return bldr.NewArray(), nil
+}
@@ -0,0 +1,176 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestFillNull(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, tc := range []struct {
+		name  string
+		arr   func() array.Interface
+		value interface{}
+		want  string
+		err   bool
+	}{
+		{
+			name: "int32",
+			arr: func() array.Interface {
+				b := array.NewInt32Builder(mem)
+				defer b.Release()
+				b.AppendValues([]int32{1, 0, 3, 0}, []bool{true, false, true, false})
+				return b.NewArray()
+			},
+			value: int32(-1),
+			want:  "[1 -1 3 -1]",
+		},
+		{
+			name: "float64",
+			arr: func() array.Interface {
+				b := array.NewFloat64Builder(mem)
+				defer b.Release()
+				b.AppendValues([]float64{0, 1.5, math.NaN()}, []bool{false, true, true})
+				return b.NewArray()
+			},
+			value: 2.5,
+			want:  "[2.5 1.5 NaN]",
+		},
+		{
+			name: "string",
+			arr: func() array.Interface {
+				b := array.NewStringBuilder(mem)
+				defer b.Release()
+				b.AppendValues([]string{"a", "", ""}, []bool{true, false, true})
+				return b.NewArray()
+			},
+			value: "n/a",
+			want:  `["a" "n/a" ""]`,
+		},
+		{
+			name: "no-nulls",
+			arr: func() array.Interface {
+				b := array.NewInt64Builder(mem)
+				defer b.Release()
+				b.AppendValues([]int64{1, 2}, nil)
+				return b.NewArray()
+			},
+			value: int64(0),
+			want:  "[1 2]",
+		},
+		{
+			name: "type-mismatch",
+			arr: func() array.Interface {
+				b := array.NewInt64Builder(mem)
+				defer b.Release()
+				b.AppendValues([]int64{1, 2}, nil)
+				return b.NewArray()
+			},
+			value: "0",
+			err:   true,
+		},
+		{
+			name: "nil-value",
+			arr: func() array.Interface {
+				b := array.NewStringBuilder(mem)
+				defer b.Release()
+				b.AppendNull()
+				return b.NewArray()
+			},
+			err: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			arr := tc.arr()
+			defer arr.Release()
+
+			out, err := array.FillNull(arr, tc.value, mem)
+			switch {
+			case tc.err:
+				if err == nil {
+					out.Release()
+					t.Fatalf("expected an error")
+				}
+				return
+			case err != nil:
+				t.Fatalf("could not fill nulls: %+v", err)
+			}
+			defer out.Release()
+
+			if got := array.NewStringer(out).String(); got != tc.want {
+				t.Fatalf("invalid array: got=%s, want=%s", got, tc.want)
+			}
+			if got := out.NullN(); got != 0 {
+				t.Fatalf("invalid number of nulls: got=%d, want=0", got)
+			}
+		})
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+
+	b.AppendValues([]string{"a", "", "", ""}, []bool{true, false, false, false})
+	a1 := b.NewArray()
+	defer a1.Release()
+	b.AppendValues([]string{"x", "b", "", ""}, []bool{true, true, false, false})
+	a2 := b.NewArray()
+	defer a2.Release()
+	b.AppendValues([]string{"y", "z", "c", ""}, []bool{true, true, true, false})
+	a3 := b.NewArray()
+	defer a3.Release()
+
+	out, err := array.Coalesce(a1, a2, a3)
+	if err != nil {
+		t.Fatalf("could not coalesce arrays: %+v", err)
+	}
+	defer out.Release()
+
+	if got, want := array.NewStringer(out).String(), `["a" "b" "c" (null)]`; got != want {
+		t.Fatalf("invalid array: got=%s, want=%s", got, want)
+	}
+
+	ib := array.NewInt8Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int8{1, 2, 3, 4}, nil)
+	i8 := ib.NewArray()
+	defer i8.Release()
+
+	short := array.NewSlice(a1, 0, 2)
+	defer short.Release()
+
+	for _, arrs := range [][]array.Interface{
+		nil,
+		{a1, i8},
+		{a1, short},
+	} {
+		if _, err := array.Coalesce(arrs...); err == nil {
+			t.Fatalf("expected an error coalescing %v", arrs)
+		}
+	}
+}
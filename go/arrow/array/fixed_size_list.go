@@ -28,6 +28,9 @@ import (
 )
 
 // FixedSizeList represents an immutable sequence of N array values.
+//
+// FixedSizeList has no offsets buffer: element i holds the child values
+// in the range [i*N, (i+1)*N).
 type FixedSizeList struct {
 	array
 	n      int32
@@ -42,8 +45,17 @@ func NewFixedSizeListData(data *Data) *FixedSizeList {
 	return a
 }
 
+// ListValues returns the child array holding the values of all the lists.
+// ListValues is not affected by slicing: it always holds the full child
+// array, including values not referenced by the elements of a sliced FixedSizeList.
+// Use ValueSlice to retrieve the values of a single element.
 func (a *FixedSizeList) ListValues() Interface { return a.values }
 
+// ValueSlice returns a zero-copy slice of ListValues holding the values of
+// the i-th element of the array, relative to the offset of a sliced array.
+// The returned array must be Release()'d after use.
+func (a *FixedSizeList) ValueSlice(i int) Interface { return a.newListValue(i) }
+
 func (a *FixedSizeList) String() string {
 	o := new(strings.Builder)
 	o.WriteString("[")
@@ -125,7 +137,7 @@ func NewFixedSizeListBuilder(mem memory.Allocator, n int32, etype arrow.DataType
 		builder: builder{refCount: 1, mem: mem},
 		etype:   etype,
 		n:       n,
-		values:  newBuilder(mem, etype),
+		values:  NewBuilder(mem, etype),
 	}
 }
 
@@ -146,16 +158,66 @@ func (b *FixedSizeListBuilder) Release() {
 	}
 }
 
+// SetCollectErrors configures how the builder and its value builder handle
+// invalid calls. See Builder.SetCollectErrors.
+func (b *FixedSizeListBuilder) SetCollectErrors(v bool) {
+	b.builder.SetCollectErrors(v)
+	b.values.SetCollectErrors(v)
+}
+
+func (b *FixedSizeListBuilder) setGrowth(g growthPolicy) {
+	b.builder.setGrowth(g)
+	b.values.setGrowth(g)
+}
+
+// Err returns the first error recorded by the builder, or else by its value
+// builder, since they were last reset.
+func (b *FixedSizeListBuilder) Err() error {
+	if b.err != nil {
+		return b.err
+	}
+	return b.values.Err()
+}
+
+// Append appends a list element with the provided validity to the builder.
+// The n values of the element must be appended to the ValueBuilder,
+// even when v is false.
 func (b *FixedSizeListBuilder) Append(v bool) {
 	b.Reserve(1)
 	b.unsafeAppendBoolToBitmap(v)
 }
 
+// AppendNull appends a null list element to the builder.
+// AppendNull also appends n nulls to the ValueBuilder, so that the child
+// values stay aligned with the list elements.
 func (b *FixedSizeListBuilder) AppendNull() {
 	b.Reserve(1)
 	b.unsafeAppendBoolToBitmap(false)
+	b.values.Reserve(int(b.n))
+	for i := int32(0); i < b.n; i++ {
+		b.values.AppendNull()
+	}
+}
+
+// AppendNulls appends n null list elements to the builder, along with the
+// corresponding null values of the ValueBuilder.
+func (b *FixedSizeListBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+	b.values.AppendNulls(n * int(b.n))
+}
+
+// AppendEmptyValue appends a valid list element holding the empty values of
+// the ValueBuilder, as appended by its AppendEmptyValue method.
+func (b *FixedSizeListBuilder) AppendEmptyValue() {
+	b.Append(true)
+	for i := int32(0); i < b.n; i++ {
+		b.values.AppendEmptyValue()
+	}
 }
 
+// AppendValues appends list elements with the provided validities to the builder.
+// The values of all elements, valid or not, must be appended to the ValueBuilder.
 func (b *FixedSizeListBuilder) AppendValues(valid []bool) {
 	b.Reserve(len(valid))
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(valid))
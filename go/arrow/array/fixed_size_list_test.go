@@ -213,3 +213,46 @@ func TestFixedSizeListArraySlice(t *testing.T) {
 		t.Fatalf("got=%q, want=%q", got, want)
 	}
 }
+
+func TestFixedSizeListArrayAppendNull(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	const N = 2
+
+	lb := array.NewFixedSizeListBuilder(pool, N, arrow.PrimitiveTypes.Int32)
+	defer lb.Release()
+
+	vb := lb.ValueBuilder().(*array.Int32Builder)
+
+	lb.Append(true)
+	vb.AppendValues([]int32{0, 1}, nil)
+	lb.AppendNull()
+	lb.Append(true)
+	vb.AppendValues([]int32{2, 3}, nil)
+	lb.AppendNull()
+
+	if got, want := vb.Len(), 4*N; got != want {
+		t.Fatalf("invalid child length: got=%d, want=%d", got, want)
+	}
+
+	arr := lb.NewArray().(*array.FixedSizeList)
+	defer arr.Release()
+
+	if got, want := arr.String(), `[[0 1] (null) [2 3] (null)]`; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+	if got, want := arr.ListValues().NullN(), 2*N; got != want {
+		t.Fatalf("invalid child nulls: got=%d, want=%d", got, want)
+	}
+
+	sub := array.NewSlice(arr, 2, 4).(*array.FixedSizeList)
+	defer sub.Release()
+
+	if got, want := sub.String(), `[[2 3] (null)]`; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+	if got, want := sub.NullN(), 1; got != want {
+		t.Fatalf("invalid nulls: got=%d, want=%d", got, want)
+	}
+}
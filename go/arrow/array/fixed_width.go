@@ -0,0 +1,55 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+// FixedWidthData returns the bytes of the values of the fixed-width array
+// arr, and the width in bits of each value. Only the values of the array
+// window are returned: for a sliced array, buf starts with the bytes of its
+// first element and holds exactly arr.Len() values.
+//
+// buf aliases the memory of arr: it must not be modified, and is only valid
+// as long as arr is not released. Null elements hold unspecified bytes.
+//
+// FixedWidthData returns an error if arr is not a fixed-width array, or if
+// its values are bit-packed, as for Boolean arrays.
+func FixedWidthData(arr Interface) (buf []byte, bitWidth int, err error) {
+	dtype := arr.DataType()
+	if _, ok := dtype.(arrow.FixedWidthDataType); !ok {
+		return nil, 0, fmt.Errorf("arrow/array: %v is not a fixed-width data type", dtype)
+	}
+
+	layout := dtype.Layout()
+	if len(layout.Buffers) != 2 || layout.Buffers[1].Kind != arrow.KindFixedWidth {
+		return nil, 0, fmt.Errorf("arrow/array: %v values are not byte-aligned", dtype)
+	}
+
+	var (
+		data = arr.Data()
+		w    = layout.Buffers[1].ByteWidth
+	)
+	if len(data.buffers) > 1 && data.buffers[1] != nil {
+		beg := data.offset * w
+		buf = data.buffers[1].Bytes()[beg : beg+data.length*w]
+	}
+	return buf, 8 * w, nil
+}
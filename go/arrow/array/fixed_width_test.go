@@ -0,0 +1,82 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestFixedWidthData(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewInt32Builder(mem)
+	defer b.Release()
+	b.AppendValues([]int32{1, 2, 3, 4, 5, 6}, nil)
+	arr := b.NewArray()
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 2, 5)
+	defer slice.Release()
+
+	buf, bitWidth, err := array.FixedWidthData(slice)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := bitWidth, 32; got != want {
+		t.Fatalf("invalid bit width: got=%d, want=%d", got, want)
+	}
+	if got, want := buf, arrow.Int32Traits.CastToBytes([]int32{3, 4, 5}); !bytes.Equal(got, want) {
+		t.Fatalf("invalid bytes: got=%v, want=%v", got, want)
+	}
+	if got, want := &buf[0], &arr.Data().Buffers()[1].Bytes()[2*arrow.Int32SizeBytes]; got != want {
+		t.Fatalf("bytes should alias the array memory")
+	}
+
+	bb := array.NewBooleanBuilder(mem)
+	defer bb.Release()
+	bb.Append(true)
+	bools := bb.NewArray()
+	defer bools.Release()
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.Append("a")
+	strs := sb.NewArray()
+	defer strs.Release()
+
+	for _, tc := range []struct {
+		arr array.Interface
+		err string
+	}{
+		{bools, "arrow/array: bool values are not byte-aligned"},
+		{strs, "arrow/array: utf8 is not a fixed-width data type"},
+	} {
+		_, _, err := array.FixedWidthData(tc.arr)
+		if err == nil {
+			t.Fatalf("%v: expected an error", tc.arr.DataType())
+		}
+		if got, want := err.Error(), tc.err; got != want {
+			t.Fatalf("invalid error: got=%q, want=%q", got, want)
+		}
+	}
+}
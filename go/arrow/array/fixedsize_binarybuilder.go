@@ -60,10 +60,15 @@ func (b *FixedSizeBinaryBuilder) Release() {
 	}
 }
 
+func (b *FixedSizeBinaryBuilder) setGrowth(g growthPolicy) {
+	b.builder.setGrowth(g)
+	b.values.setGrowth(g)
+}
+
 func (b *FixedSizeBinaryBuilder) Append(v []byte) {
 	if len(v) != b.dtype.ByteWidth {
-		// TODO(alexandre): should we return an error instead?
-		panic("len(v) != b.dtype.ByteWidth")
+		b.invalid(b, "Append", "len(v) != b.dtype.ByteWidth")
+		return
 	}
 
 	b.Reserve(1)
@@ -77,28 +82,44 @@ func (b *FixedSizeBinaryBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *FixedSizeBinaryBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.values.Advance(n * b.dtype.ByteWidth)
+	b.unsafeAppendNulls(n)
+}
+
+// AppendEmptyValue appends a value of ByteWidth zero bytes.
+func (b *FixedSizeBinaryBuilder) AppendEmptyValue() {
+	b.Append(make([]byte, b.dtype.ByteWidth))
+}
+
 // AppendValues will append the values in the v slice. The valid slice determines which values
 // in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
 // all values in v are appended and considered valid.
 func (b *FixedSizeBinaryBuilder) AppendValues(v [][]byte, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
 		return
 	}
 
+	for _, vv := range v {
+		if n := len(vv); n != 0 && n != b.dtype.ByteWidth {
+			b.invalid(b, "AppendValues", fmt.Sprintf("invalid binary length (got=%d, want=%d)", n, b.dtype.ByteWidth))
+			return
+		}
+	}
+
 	b.Reserve(len(v))
 	for _, vv := range v {
-		switch len(vv) {
-		case 0:
+		if len(vv) == 0 {
 			b.values.Advance(b.dtype.ByteWidth)
-		case b.dtype.ByteWidth:
-			b.values.Append(vv)
-		default:
-			panic(fmt.Errorf("array: invalid binary length (got=%d, want=%d)", len(vv), b.dtype.ByteWidth))
+			continue
 		}
+		b.values.Append(vv)
 	}
 
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
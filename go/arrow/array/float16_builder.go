@@ -60,6 +60,9 @@ func (b *Float16Builder) Append(v float16.Num) {
 	b.UnsafeAppend(v)
 }
 
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Float16Builder) UnsafeAppend(v float16.Num) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
@@ -71,6 +74,19 @@ func (b *Float16Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Float16Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Float16Builder) AppendEmptyValue() {
+	b.Append(float16.New(0))
+}
+
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Float16Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -85,7 +101,8 @@ func (b *Float16Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Float16Builder) AppendValues(v []float16.Num, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
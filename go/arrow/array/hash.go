@@ -0,0 +1,354 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// HashOption configures the hashing kernels.
+type HashOption func(*hashConfig)
+
+type hashConfig struct {
+	hasher func([]byte) uint64
+}
+
+func newHashConfig(opts []HashOption) hashConfig {
+	var cfg hashConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// WithHasher specifies the function hashing the elements of arrays, given
+// their little-endian bytes (UTF-8 bytes for strings, 0 or 1 for booleans).
+// Equal elements are hashed from the same bytes, all the NaNs as the same
+// NaN, and negative zeros as zero.
+//
+// The hasher decides the partition of each row in HashPartitionRecord, so
+// that a hasher matching another system shards records the same way.
+// The other kernels only use it to find equal elements: their group ids
+// and distinct elements are in first-seen order whatever the hasher, and
+// elements with colliding hashes are told apart by comparing them.
+// By default, elements are hashed by the Go map implementation, and with
+// 64-bit FNV-1a by HashPartitionRecord.
+func WithHasher(h func([]byte) uint64) HashOption {
+	return func(cfg *hashConfig) {
+		cfg.hasher = h
+	}
+}
+
+// fnvHash returns the 64-bit FNV-1a hash of b.
+func fnvHash(b []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
+
+// HashToGroups assigns each element of arr a dense group id, starting at 0,
+// such that equal elements share the same group id. Null elements form
+// their own group.
+//
+// HashToGroups returns the group id of each element, and the distinct
+// elements of arr in first-seen order, such that the i-th distinct element
+// is the element of group i.
+// The returned arrays are allocated with memory.DefaultAllocator and must
+// be released after use.
+//
+// HashToGroups supports boolean, numeric, temporal, string and dictionary
+// arrays. NaN elements are considered equal to each other.
+// Elements are hashed with the function given by WithHasher, if any.
+func HashToGroups(arr Interface, opts ...HashOption) (groupIDs *Int32, uniques Interface, err error) {
+	ids, firsts, err := hashGroups(arr, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	uniques, err = Take(arr, firsts, memory.DefaultAllocator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bldr := NewInt32Builder(memory.DefaultAllocator)
+	defer bldr.Release()
+	bldr.AppendValues(ids, nil)
+
+	return bldr.NewInt32Array(), uniques, nil
+}
+
+// hashGroups returns the group id of each element of arr and, for each
+// group, the index of its first element in arr.
+// Null elements share a single group.
+func hashGroups(arr Interface, opts []HashOption) (ids []int32, firsts []int, err error) {
+	cfg := newHashConfig(opts)
+	value, ok := goValueFunc(arr)
+	if !ok {
+		return nil, nil, fmt.Errorf("arrow/array: hashing not supported for %v arrays", arr.DataType())
+	}
+	if cfg.hasher != nil {
+		ids, firsts = hashGroupsWith(arr, value, cfg.hasher)
+		return ids, firsts, nil
+	}
+
+	var (
+		n      = arr.Len()
+		groups = make(map[interface{}]int32)
+	)
+	ids = make([]int32, n)
+	for i := 0; i < n; i++ {
+		var k interface{} // nulls are keyed by nil, which no valid element uses.
+		if arr.IsValid(i) {
+			k = hashKey(value(i))
+		}
+		id, ok := groups[k]
+		if !ok {
+			id = int32(len(firsts))
+			groups[k] = id
+			firsts = append(firsts, i)
+		}
+		ids[i] = id
+	}
+	return ids, firsts, nil
+}
+
+// hashGroupsWith is hashGroups, hashing the elements of arr with hasher.
+func hashGroupsWith(arr Interface, value func(int) interface{}, hasher func([]byte) uint64) (ids []int32, firsts []int) {
+	var (
+		n       = arr.Len()
+		buckets = make(map[uint64][]int32) // group ids, by hash.
+		keys    []interface{}              // key of each group, nil for nulls.
+		nullID  = int32(-1)
+		buf     []byte
+	)
+	ids = make([]int32, n)
+	for i := 0; i < n; i++ {
+		if arr.IsNull(i) {
+			if nullID < 0 {
+				nullID = int32(len(firsts))
+				keys = append(keys, nil)
+				firsts = append(firsts, i)
+			}
+			ids[i] = nullID
+			continue
+		}
+
+		v := value(i)
+		k := hashKey(v)
+		buf = appendHashBytes(buf[:0], v)
+		h := hasher(buf)
+
+		id := int32(-1)
+		for _, g := range buckets[h] {
+			if keys[g] == k {
+				id = g
+				break
+			}
+		}
+		if id < 0 {
+			id = int32(len(firsts))
+			buckets[h] = append(buckets[h], id)
+			keys = append(keys, k)
+			firsts = append(firsts, i)
+		}
+		ids[i] = id
+	}
+	return ids, firsts
+}
+
+// appendHashBytes appends to buf the bytes hashed by the hasher of
+// WithHasher for the Go value v, as returned by goValueFunc.
+func appendHashBytes(buf []byte, v interface{}) []byte {
+	var tmp [8]byte
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return append(buf, 1)
+		}
+		return append(buf, 0)
+	case int8:
+		return append(buf, byte(v))
+	case uint8:
+		return append(buf, v)
+	case int16:
+		binary.LittleEndian.PutUint16(tmp[:], uint16(v))
+		return append(buf, tmp[:2]...)
+	case uint16:
+		binary.LittleEndian.PutUint16(tmp[:], v)
+		return append(buf, tmp[:2]...)
+	case int32:
+		binary.LittleEndian.PutUint32(tmp[:], uint32(v))
+		return append(buf, tmp[:4]...)
+	case uint32:
+		binary.LittleEndian.PutUint32(tmp[:], v)
+		return append(buf, tmp[:4]...)
+	case int64:
+		binary.LittleEndian.PutUint64(tmp[:], uint64(v))
+		return append(buf, tmp[:]...)
+	case uint64:
+		binary.LittleEndian.PutUint64(tmp[:], v)
+		return append(buf, tmp[:]...)
+	case float32:
+		switch {
+		case math.IsNaN(float64(v)):
+			v = float32(math.NaN())
+		case v == 0:
+			v = 0
+		}
+		binary.LittleEndian.PutUint32(tmp[:], math.Float32bits(v))
+		return append(buf, tmp[:4]...)
+	case float64:
+		switch {
+		case math.IsNaN(v):
+			v = math.NaN()
+		case v == 0:
+			v = 0
+		}
+		binary.LittleEndian.PutUint64(tmp[:], math.Float64bits(v))
+		return append(buf, tmp[:]...)
+	case string:
+		return append(buf, v...)
+	default:
+		panic(fmt.Errorf("arrow/array: invalid value type %T", v))
+	}
+}
+
+// goValueFunc returns a function returning the i-th valid element of arr,
+// as a comparable Go value accepted by appendGoValue, and whether arr is
+// supported. Temporal elements are returned as integers.
+func goValueFunc(arr Interface) (func(i int) interface{}, bool) {
+	switch arr := arr.(type) {
+	case *Boolean:
+		return func(i int) interface{} { return arr.Value(i) }, true
+	case *Int8:
+		return func(i int) interface{} { return arr.Value(i) }, true
+	case *Int16:
+		return func(i int) interface{} { return arr.Value(i) }, true
+	case *Int32:
+		return func(i int) interface{} { return arr.Value(i) }, true
+	case *Int64:
+		return func(i int) interface{} { return arr.Value(i) }, true
+	case *Uint8:
+		return func(i int) interface{} { return arr.Value(i) }, true
+	case *Uint16:
+		return func(i int) interface{} { return arr.Value(i) }, true
+	case *Uint32:
+		return func(i int) interface{} { return arr.Value(i) }, true
+	case *Uint64:
+		return func(i int) interface{} { return arr.Value(i) }, true
+	case *Float16:
+		return func(i int) interface{} { return arr.Value(i).Float32() }, true
+	case *Float32:
+		return func(i int) interface{} { return arr.Value(i) }, true
+	case *Float64:
+		return func(i int) interface{} { return arr.Value(i) }, true
+	case *String:
+		return func(i int) interface{} { return arr.Value(i) }, true
+	case *Date32:
+		return func(i int) interface{} { return int32(arr.Value(i)) }, true
+	case *Date64:
+		return func(i int) interface{} { return int64(arr.Value(i)) }, true
+	case *Time32:
+		return func(i int) interface{} { return int32(arr.Value(i)) }, true
+	case *Time64:
+		return func(i int) interface{} { return int64(arr.Value(i)) }, true
+	case *Timestamp:
+		return func(i int) interface{} { return int64(arr.Value(i)) }, true
+	case *Duration:
+		return func(i int) interface{} { return int64(arr.Value(i)) }, true
+	case *Dictionary:
+		// elements are the dictionary values they refer to.
+		value, ok := goValueFunc(arr.Dictionary())
+		if !ok {
+			return nil, false
+		}
+		return func(i int) interface{} { return value(arr.GetValueIndex(i)) }, true
+	default:
+		return nil, false
+	}
+}
+
+// nanKey is the map key of NaN elements, as NaN never equals itself.
+type nanKey struct{}
+
+// hashKey returns the map key of the Go value v, such that all NaNs share
+// the same key.
+func hashKey(v interface{}) interface{} {
+	switch f := v.(type) {
+	case float32:
+		if math.IsNaN(float64(f)) {
+			return nanKey{}
+		}
+	case float64:
+		if math.IsNaN(f) {
+			return nanKey{}
+		}
+	}
+	return v
+}
+
+// Unique returns the distinct elements of arr, in first-seen order.
+// Null elements are considered equal to each other, and NaN elements are
+// considered equal to each other.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+//
+// Unique supports boolean, numeric, temporal, string and dictionary arrays.
+// See HashToGroups for the options.
+func Unique(arr Interface, opts ...HashOption) (Interface, error) {
+	_, firsts, err := hashGroups(arr, opts)
+	if err != nil {
+		return nil, err
+	}
+	return Take(arr, firsts, memory.DefaultAllocator)
+}
+
+// ValueCounts returns the distinct elements of arr, in first-seen order, and
+// the number of occurrences of each of them in arr.
+// Null elements are counted as a single distinct element.
+// The returned arrays are allocated with memory.DefaultAllocator and must be
+// released after use.
+//
+// ValueCounts supports boolean, numeric, temporal, string and dictionary
+// arrays. See HashToGroups for the options.
+func ValueCounts(arr Interface, opts ...HashOption) (values Interface, counts *Int64, err error) {
+	ids, firsts, err := hashGroups(arr, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values, err = Take(arr, firsts, memory.DefaultAllocator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := make([]int64, len(firsts))
+	for _, id := range ids {
+		n[id]++
+	}
+
+	bldr := NewInt64Builder(memory.DefaultAllocator)
+	defer bldr.Release()
+	bldr.AppendValues(n, nil)
+
+	return values, bldr.NewInt64Array(), nil
+}
@@ -0,0 +1,312 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestHashToGroups(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+	bldr.AppendValues(
+		[]string{"b", "a", "", "b", "", "c", "a", "b"},
+		[]bool{true, true, false, true, true, true, true, true},
+	)
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	ids, uniques, err := array.HashToGroups(arr)
+	if err != nil {
+		t.Fatalf("could not hash array: %+v", err)
+	}
+	defer ids.Release()
+	defer uniques.Release()
+
+	if got, want := ids.Int32Values(), []int32{0, 1, 2, 0, 3, 4, 1, 0}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid group ids: got=%v, want=%v", got, want)
+	}
+	if ids.NullN() != 0 {
+		t.Fatalf("invalid number of null group ids: got=%d, want=0", ids.NullN())
+	}
+
+	if got, want := array.NewStringer(uniques).String(), `["b" "a" (null) "" "c"]`; got != want {
+		t.Fatalf("invalid uniques: got=%s, want=%s", got, want)
+	}
+
+	sliced := array.NewSlice(arr, 2, 6)
+	defer sliced.Release()
+	ids2, uniques2, err := array.HashToGroups(sliced)
+	if err != nil {
+		t.Fatalf("could not hash sliced array: %+v", err)
+	}
+	defer ids2.Release()
+	defer uniques2.Release()
+
+	if got, want := ids2.Int32Values(), []int32{0, 1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid sliced group ids: got=%v, want=%v", got, want)
+	}
+	if got, want := array.NewStringer(uniques2).String(), `[(null) "b" "" "c"]`; got != want {
+		t.Fatalf("invalid sliced uniques: got=%s, want=%s", got, want)
+	}
+}
+
+func TestHashToGroupsInt64(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewInt64Builder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]int64{7, 7, 0, -1, 7}, []bool{true, true, false, true, true})
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	ids, uniques, err := array.HashToGroups(arr)
+	if err != nil {
+		t.Fatalf("could not hash array: %+v", err)
+	}
+	defer ids.Release()
+	defer uniques.Release()
+
+	if got, want := ids.Int32Values(), []int32{0, 0, 1, 2, 0}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid group ids: got=%v, want=%v", got, want)
+	}
+	if got, want := array.NewStringer(uniques).String(), `[7 (null) -1]`; got != want {
+		t.Fatalf("invalid uniques: got=%s, want=%s", got, want)
+	}
+}
+
+func TestHashToGroupsUnsupported(t *testing.T) {
+	arr := array.NewNull(3)
+	defer arr.Release()
+
+	if _, _, err := array.HashToGroups(arr); err == nil {
+		t.Fatalf("expected an error")
+	}
+}
+
+func TestHashToGroupsWithHasher(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewFloat64Builder(mem)
+	defer bldr.Release()
+	bldr.AppendValues(
+		[]float64{2, 0, 1.5, math.Copysign(0, -1), math.NaN(), 2, 0, math.NaN(), 1.5},
+		[]bool{true, true, true, true, true, true, false, true, true},
+	)
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	seeded := func(seed uint64) func([]byte) uint64 {
+		return func(b []byte) uint64 {
+			h := fnv.New64a()
+			var s [8]byte
+			binary.LittleEndian.PutUint64(s[:], seed)
+			h.Write(s[:])
+			h.Write(b)
+			return h.Sum64()
+		}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		hasher func([]byte) uint64
+	}{
+		{"seeded", seeded(42)},
+		{"colliding", func([]byte) uint64 { return 0 }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var runs []string
+			for run := 0; run < 2; run++ {
+				ids, uniques, err := array.HashToGroups(arr, array.WithHasher(tc.hasher))
+				if err != nil {
+					t.Fatalf("could not hash to groups: %+v", err)
+				}
+				runs = append(runs, fmt.Sprintf("%v %v", ids, uniques))
+				ids.Release()
+				uniques.Release()
+			}
+
+			if got, want := runs[0], "[0 1 2 1 3 0 4 3 2] [2 0 1.5 NaN (null)]"; got != want {
+				t.Fatalf("invalid groups:\ngot= %s\nwant=%s", got, want)
+			}
+			if runs[1] != runs[0] {
+				t.Fatalf("runs differ:\nrun0=%s\nrun1=%s", runs[0], runs[1])
+			}
+		})
+	}
+}
+
+func TestUnique(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewFloat64Builder(mem)
+	defer bldr.Release()
+	bldr.AppendValues(
+		[]float64{1.5, 0, 2, 1.5, math.NaN(), 0, 2, math.NaN()},
+		[]bool{true, false, true, true, true, false, true, true},
+	)
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	uniques, err := array.Unique(arr)
+	if err != nil {
+		t.Fatalf("could not compute unique elements: %+v", err)
+	}
+	defer uniques.Release()
+
+	if got, want := array.NewStringer(uniques).String(), `[1.5 (null) 2 NaN]`; got != want {
+		t.Fatalf("invalid uniques: got=%s, want=%s", got, want)
+	}
+}
+
+func TestUniqueBoolean(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewBooleanBuilder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]bool{true, true, false, false, true}, []bool{true, true, false, true, true})
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	uniques, err := array.Unique(arr)
+	if err != nil {
+		t.Fatalf("could not compute unique elements: %+v", err)
+	}
+	defer uniques.Release()
+
+	if got, want := array.NewStringer(uniques).String(), `[true (null) false]`; got != want {
+		t.Fatalf("invalid uniques: got=%s, want=%s", got, want)
+	}
+}
+
+func TestUniqueDictionary(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewInt64Builder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]int64{3, 1, 0, 3, 1, 2}, []bool{true, true, false, true, true, true})
+	arr := bldr.NewArray()
+	defer arr.Release()
+
+	dict, err := array.DictionaryEncode(arr, arrow.PrimitiveTypes.Uint8, mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dict.Release()
+
+	uniques, err := array.Unique(dict)
+	if err != nil {
+		t.Fatalf("could not compute unique elements: %+v", err)
+	}
+	defer uniques.Release()
+
+	if got, want := fmt.Sprintf("%v", uniques.(*array.Dictionary).Indices()), `[0 1 (null) 2]`; got != want {
+		t.Fatalf("invalid uniques: got=%s, want=%s", got, want)
+	}
+}
+
+func TestValueCounts(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, tc := range []struct {
+		name   string
+		arr    func() array.Interface
+		values string
+		counts []int64
+	}{
+		{
+			name: "string",
+			arr: func() array.Interface {
+				b := array.NewStringBuilder(mem)
+				defer b.Release()
+				b.AppendValues(
+					[]string{"x", "", "y", "x", "", "x", "z"},
+					[]bool{true, false, true, true, false, true, true},
+				)
+				return b.NewArray()
+			},
+			values: `["x" (null) "y" "z"]`,
+			counts: []int64{3, 2, 1, 1},
+		},
+		{
+			name: "uint16",
+			arr: func() array.Interface {
+				b := array.NewUint16Builder(mem)
+				defer b.Release()
+				b.AppendValues([]uint16{4, 4, 4, 0, 5}, []bool{true, true, true, false, true})
+				return b.NewArray()
+			},
+			values: `[4 (null) 5]`,
+			counts: []int64{3, 1, 1},
+		},
+		{
+			name: "empty",
+			arr: func() array.Interface {
+				b := array.NewInt8Builder(mem)
+				defer b.Release()
+				return b.NewArray()
+			},
+			values: `[]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			arr := tc.arr()
+			defer arr.Release()
+
+			values, counts, err := array.ValueCounts(arr)
+			if err != nil {
+				t.Fatalf("could not count values: %+v", err)
+			}
+			defer values.Release()
+			defer counts.Release()
+
+			if got, want := array.NewStringer(values).String(), tc.values; got != want {
+				t.Fatalf("invalid values: got=%s, want=%s", got, want)
+			}
+			if got, want := counts.Int64Values(), tc.counts; !reflect.DeepEqual(got, want) {
+				t.Fatalf("invalid counts: got=%v, want=%v", got, want)
+			}
+
+			uniques, err := array.Unique(arr)
+			if err != nil {
+				t.Fatalf("could not compute unique elements: %+v", err)
+			}
+			defer uniques.Release()
+			if !array.ArrayEqual(uniques, values) {
+				t.Fatalf("unique elements and counted values differ:\nuniques=%v\nvalues= %v", uniques, values)
+			}
+		})
+	}
+}
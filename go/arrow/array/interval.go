@@ -135,12 +135,28 @@ func (b *MonthIntervalBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *MonthIntervalBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *MonthIntervalBuilder) AppendEmptyValue() {
+	b.Append(0)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *MonthIntervalBuilder) UnsafeAppend(v arrow.MonthInterval) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *MonthIntervalBuilder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -155,7 +171,8 @@ func (b *MonthIntervalBuilder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *MonthIntervalBuilder) AppendValues(v []arrow.MonthInterval, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -328,12 +345,28 @@ func (b *DayTimeIntervalBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *DayTimeIntervalBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *DayTimeIntervalBuilder) AppendEmptyValue() {
+	b.Append(arrow.DayTimeInterval{})
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *DayTimeIntervalBuilder) UnsafeAppend(v arrow.DayTimeInterval) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *DayTimeIntervalBuilder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -348,7 +381,8 @@ func (b *DayTimeIntervalBuilder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *DayTimeIntervalBuilder) AppendValues(v []arrow.DayTimeInterval, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
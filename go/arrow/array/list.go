@@ -42,8 +42,17 @@ func NewListData(data *Data) *List {
 	return a
 }
 
+// ListValues returns the child array holding the values of all the lists.
+// ListValues is not affected by slicing: it always holds the full child
+// array, including values not referenced by the elements of a sliced List.
+// Use ValueSlice to retrieve the values of a single element.
 func (a *List) ListValues() Interface { return a.values }
 
+// ValueSlice returns a zero-copy slice of ListValues holding the values of
+// the i-th element of the array, relative to the offset of a sliced array.
+// The returned array must be Release()'d after use.
+func (a *List) ValueSlice(i int) Interface { return a.newListValue(i) }
+
 func (a *List) String() string {
 	o := new(strings.Builder)
 	o.WriteString("[")
@@ -113,28 +122,21 @@ func (a *List) Release() {
 	a.values.Release()
 }
 
-type ListBuilder struct {
+// baseListBuilder holds the state and logic shared by the ListBuilder and
+// the LargeListBuilder, which only differ by the width of their offsets.
+type baseListBuilder struct {
 	builder
 
 	etype   arrow.DataType // data type of the list's elements.
 	values  Builder        // value builder for the list's elements.
-	offsets *Int32Builder
-}
+	offsets Builder        // offsets builder, either an *Int32Builder or an *Int64Builder.
 
-// NewListBuilder returns a builder, using the provided memory allocator.
-// The created list builder will create a list whose elements will be of type etype.
-func NewListBuilder(mem memory.Allocator, etype arrow.DataType) *ListBuilder {
-	return &ListBuilder{
-		builder: builder{refCount: 1, mem: mem},
-		etype:   etype,
-		values:  newBuilder(mem, etype),
-		offsets: NewInt32Builder(mem),
-	}
+	appendOffset func(v int) // appends a value to the offsets builder.
 }
 
 // Release decreases the reference count by 1.
 // When the reference count goes to zero, the memory is freed.
-func (b *ListBuilder) Release() {
+func (b *baseListBuilder) Release() {
 	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
 
 	if atomic.AddInt64(&b.refCount, -1) == 0 {
@@ -148,34 +150,129 @@ func (b *ListBuilder) Release() {
 	b.offsets.Release()
 }
 
-func (b *ListBuilder) appendNextOffset() {
-	b.offsets.Append(int32(b.values.Len()))
+// SetCollectErrors configures how the builder and its value builder handle
+// invalid calls. See Builder.SetCollectErrors.
+func (b *baseListBuilder) SetCollectErrors(v bool) {
+	b.builder.SetCollectErrors(v)
+	b.values.SetCollectErrors(v)
+}
+
+func (b *baseListBuilder) setGrowth(g growthPolicy) {
+	b.builder.setGrowth(g)
+	b.values.setGrowth(g)
+	b.offsets.setGrowth(g)
+}
+
+// Err returns the first error recorded by the builder, or else by its value
+// builder, since they were last reset.
+func (b *baseListBuilder) Err() error {
+	if b.err != nil {
+		return b.err
+	}
+	return b.values.Err()
+}
+
+// checkOffsets validates the nOffsets offsets, returned by offset, and the
+// nValid validity flags passed to AppendValues. It returns a description of
+// the invalid argument, if any.
+func (b *baseListBuilder) checkOffsets(nOffsets, nValid int, offset func(i int) int) string {
+	if nOffsets == 0 {
+		if nValid != 0 {
+			return "len(offsets) != len(valid)+1"
+		}
+		return ""
+	}
+	if nValid != 0 && nValid != nOffsets-1 {
+		return "len(offsets) != len(valid)+1 && len(valid) != 0"
+	}
+	if b.hasEnd() && offset(0) != b.lastOffset() {
+		return fmt.Sprintf("offsets[0]=%d does not match the end offset %d of the previous slots", offset(0), b.lastOffset())
+	}
+
+	prev := b.lastOffset()
+	for i := 0; i < nOffsets; i++ {
+		v := offset(i)
+		if v < prev {
+			return fmt.Sprintf("offsets are not monotonic non-decreasing: offsets[%d]=%d < %d", i, v, prev)
+		}
+		prev = v
+	}
+	return ""
 }
 
-func (b *ListBuilder) Append(v bool) {
+// checkEnd returns a description of the mismatch between the end offset of
+// the slots appended by AppendValues, if any, and the length of the value
+// builder.
+func (b *baseListBuilder) checkEnd() string {
+	if !b.hasEnd() {
+		return ""
+	}
+	if end := b.lastOffset(); end != b.values.Len() {
+		return fmt.Sprintf("end offset %d does not match the %d values", end, b.values.Len())
+	}
+	return ""
+}
+
+// hasEnd reports whether the offsets builder holds the end offset of the
+// last slot, appended by AppendValues, which is also the start offset of
+// the next slot.
+func (b *baseListBuilder) hasEnd() bool { return b.offsets.Len() == b.length+1 }
+
+// lastOffset returns the last appended offset, or 0.
+func (b *baseListBuilder) lastOffset() int {
+	n := b.offsets.Len()
+	if n == 0 {
+		return 0
+	}
+	switch o := b.offsets.(type) {
+	case *Int32Builder:
+		return int(o.rawData[n-1])
+	case *Int64Builder:
+		return int(o.rawData[n-1])
+	default:
+		panic("arrow/array: invalid offsets builder")
+	}
+}
+
+// appendNextOffset appends the start offset of the next slot, unless the
+// offsets builder already holds it as the end offset of the last slot.
+func (b *baseListBuilder) appendNextOffset() {
+	if !b.hasEnd() {
+		b.appendOffset(b.values.Len())
+	}
+}
+
+func (b *baseListBuilder) Append(v bool) {
 	b.Reserve(1)
-	b.unsafeAppendBoolToBitmap(v)
 	b.appendNextOffset()
+	b.unsafeAppendBoolToBitmap(v)
 }
 
-func (b *ListBuilder) AppendNull() {
+func (b *baseListBuilder) AppendNull() {
 	b.Reserve(1)
-	b.unsafeAppendBoolToBitmap(false)
 	b.appendNextOffset()
+	b.unsafeAppendBoolToBitmap(false)
 }
 
-func (b *ListBuilder) AppendValues(offsets []int32, valid []bool) {
-	b.Reserve(len(valid))
-	b.offsets.AppendValues(offsets, nil)
-	b.builder.unsafeAppendBoolsToBitmap(valid, len(valid))
+func (b *baseListBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	for i := 0; i < n; i++ {
+		b.appendNextOffset()
+		b.unsafeAppendBoolToBitmap(false)
+	}
 }
 
-func (b *ListBuilder) unsafeAppend(v bool) {
+// AppendEmptyValue appends an empty list.
+func (b *baseListBuilder) AppendEmptyValue() {
+	b.Append(true)
+}
+
+func (b *baseListBuilder) unsafeAppend(v bool) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.length++
 }
 
-func (b *ListBuilder) unsafeAppendBoolToBitmap(isValid bool) {
+func (b *baseListBuilder) unsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	} else {
@@ -184,20 +281,20 @@ func (b *ListBuilder) unsafeAppendBoolToBitmap(isValid bool) {
 	b.length++
 }
 
-func (b *ListBuilder) init(capacity int) {
+func (b *baseListBuilder) init(capacity int) {
 	b.builder.init(capacity)
 	b.offsets.init(capacity + 1)
 }
 
 // Reserve ensures there is enough space for appending n elements
 // by checking the capacity and calling Resize if necessary.
-func (b *ListBuilder) Reserve(n int) {
+func (b *baseListBuilder) Reserve(n int) {
 	b.builder.reserve(n, b.Resize)
 }
 
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
-func (b *ListBuilder) Resize(n int) {
+func (b *baseListBuilder) Resize(n int) {
 	if n < minBuilderCapacity {
 		n = minBuilderCapacity
 	}
@@ -206,45 +303,29 @@ func (b *ListBuilder) Resize(n int) {
 		b.init(n)
 	} else {
 		b.builder.resize(n, b.builder.init)
-		b.offsets.resize(n+1, b.offsets.init)
+		b.offsets.Resize(n + 1)
 	}
 }
 
-func (b *ListBuilder) ValueBuilder() Builder {
+func (b *baseListBuilder) ValueBuilder() Builder {
 	return b.values
 }
 
-// NewArray creates a List array from the memory buffers used by the builder and resets the ListBuilder
-// so it can be used to build a new array.
-func (b *ListBuilder) NewArray() Interface {
-	return b.NewListArray()
-}
-
-// NewListArray creates a List array from the memory buffers used by the builder and resets the ListBuilder
-// so it can be used to build a new array.
-func (b *ListBuilder) NewListArray() (a *List) {
-	if b.offsets.Len() != b.length+1 {
-		b.appendNextOffset()
-	}
-	data := b.newData()
-	a = NewListData(data)
-	data.Release()
-	return
-}
+func (b *baseListBuilder) newData(dtype arrow.DataType) (data *Data) {
+	b.appendNextOffset()
 
-func (b *ListBuilder) newData() (data *Data) {
 	values := b.values.NewArray()
 	defer values.Release()
 
 	var offsets *memory.Buffer
 	if b.offsets != nil {
-		arr := b.offsets.NewInt32Array()
+		arr := b.offsets.NewArray()
 		defer arr.Release()
 		offsets = arr.Data().buffers[1]
 	}
 
 	data = NewData(
-		arrow.ListOf(b.etype), b.length,
+		dtype, b.length,
 		[]*memory.Buffer{
 			b.nullBitmap,
 			offsets,
@@ -258,7 +339,246 @@ func (b *ListBuilder) newData() (data *Data) {
 	return
 }
 
+type ListBuilder struct {
+	baseListBuilder
+}
+
+// NewListBuilder returns a builder, using the provided memory allocator.
+// The created list builder will create a list whose elements will be of type etype.
+func NewListBuilder(mem memory.Allocator, etype arrow.DataType) *ListBuilder {
+	offsets := NewInt32Builder(mem)
+	return &ListBuilder{
+		baseListBuilder{
+			builder:      builder{refCount: 1, mem: mem},
+			etype:        etype,
+			values:       NewBuilder(mem, etype),
+			offsets:      offsets,
+			appendOffset: func(v int) { offsets.Append(int32(v)) },
+		},
+	}
+}
+
+// AppendValues appends len(offsets)-1 list slots at once, given the offsets
+// of their values in the value builder, which the caller fills separately,
+// before or after calling AppendValues.
+//
+// offsets holds the start offset of each slot, followed by the end offset of
+// the last slot, which is also the start offset of the next appended slot.
+// valid holds the validity of each slot, or is empty if all slots are valid.
+//
+// The offsets must be monotonic non-decreasing, starting at or after the
+// offset of the previously appended slot, or at its end offset if it was
+// appended by AppendValues. Invalid offsets are reported as described by
+// Builder.SetCollectErrors, and no slot is appended.
+// A slot appended next with Append or AppendNull starts at the end offset,
+// which must match the length of the value builder when the array is built:
+// NewArray panics otherwise, and NewArrayChecked returns an error.
+func (b *ListBuilder) AppendValues(offsets []int32, valid []bool) {
+	if err := b.checkOffsets(len(offsets), len(valid), func(i int) int { return int(offsets[i]) }); err != "" {
+		b.invalid(b, "AppendValues", err)
+		return
+	}
+	if len(offsets) == 0 {
+		return
+	}
+	n := len(offsets) - 1
+	if b.hasEnd() {
+		// offsets[0] is already held as the end offset of the last slot.
+		offsets = offsets[1:]
+	}
+	b.Reserve(n)
+	b.offsets.(*Int32Builder).AppendValues(offsets, nil)
+	b.builder.unsafeAppendBoolsToBitmap(valid, n)
+}
+
+// NewArray creates a List array from the memory buffers used by the builder and resets the ListBuilder
+// so it can be used to build a new array.
+func (b *ListBuilder) NewArray() Interface {
+	return b.NewListArray()
+}
+
+// NewListArray creates a List array from the memory buffers used by the builder and resets the ListBuilder
+// so it can be used to build a new array.
+func (b *ListBuilder) NewListArray() (a *List) {
+	if err := b.checkEnd(); err != "" {
+		b.invalid(b, "NewArray", err)
+	}
+	data := b.newData(arrow.ListOf(b.etype))
+	a = NewListData(data)
+	data.Release()
+	return
+}
+
+func (b *ListBuilder) checkNewArray() error {
+	if err := b.checkEnd(); err != "" {
+		return fmt.Errorf("arrow/array: %T.NewArray: %s", b, err)
+	}
+	return nil
+}
+
+// LargeList represents an immutable sequence of array values,
+// using 64-bit offsets.
+type LargeList struct {
+	array
+	values  Interface
+	offsets []int64
+}
+
+// NewLargeListData returns a new LargeList array value, from data.
+func NewLargeListData(data *Data) *LargeList {
+	a := &LargeList{}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+// ListValues returns the child array holding the values of all the lists.
+// ListValues is not affected by slicing: it always holds the full child
+// array, including values not referenced by the elements of a sliced LargeList.
+// Use ValueSlice to retrieve the values of a single element.
+func (a *LargeList) ListValues() Interface { return a.values }
+
+// ValueSlice returns a zero-copy slice of ListValues holding the values of
+// the i-th element of the array, relative to the offset of a sliced array.
+// The returned array must be Release()'d after use.
+func (a *LargeList) ValueSlice(i int) Interface { return a.newListValue(i) }
+
+func (a *LargeList) String() string {
+	o := new(strings.Builder)
+	o.WriteString("[")
+	for i := 0; i < a.Len(); i++ {
+		if i > 0 {
+			o.WriteString(" ")
+		}
+		if !a.IsValid(i) {
+			o.WriteString("(null)")
+			continue
+		}
+		sub := a.newListValue(i)
+		fmt.Fprintf(o, "%v", sub)
+		sub.Release()
+	}
+	o.WriteString("]")
+	return o.String()
+}
+
+func (a *LargeList) newListValue(i int) Interface {
+	j := i + a.array.data.offset
+	beg := a.offsets[j]
+	end := a.offsets[j+1]
+	return NewSlice(a.values, beg, end)
+}
+
+func (a *LargeList) setData(data *Data) {
+	a.array.setData(data)
+	vals := data.buffers[1]
+	if vals != nil {
+		a.offsets = arrow.Int64Traits.CastFromBytes(vals.Bytes())
+	}
+	a.values = MakeFromData(data.childData[0])
+}
+
+func arrayEqualLargeList(left, right *LargeList) bool {
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) {
+			continue
+		}
+		o := func() bool {
+			l := left.newListValue(i)
+			defer l.Release()
+			r := right.newListValue(i)
+			defer r.Release()
+			return ArrayEqual(l, r)
+		}()
+		if !o {
+			return false
+		}
+	}
+	return true
+}
+
+// Len returns the number of elements in the array.
+func (a *LargeList) Len() int { return a.array.Len() }
+
+func (a *LargeList) Offsets() []int64 { return a.offsets }
+
+func (a *LargeList) Retain() {
+	a.array.Retain()
+	a.values.Retain()
+}
+
+func (a *LargeList) Release() {
+	a.array.Release()
+	a.values.Release()
+}
+
+type LargeListBuilder struct {
+	baseListBuilder
+}
+
+// NewLargeListBuilder returns a builder, using the provided memory allocator.
+// The created list builder will create a large list whose elements will be of type etype.
+func NewLargeListBuilder(mem memory.Allocator, etype arrow.DataType) *LargeListBuilder {
+	offsets := NewInt64Builder(mem)
+	return &LargeListBuilder{
+		baseListBuilder{
+			builder:      builder{refCount: 1, mem: mem},
+			etype:        etype,
+			values:       NewBuilder(mem, etype),
+			offsets:      offsets,
+			appendOffset: func(v int) { offsets.Append(int64(v)) },
+		},
+	}
+}
+
+// AppendValues appends multiple list slots at once, given the offsets of
+// their values in the value builder. See ListBuilder.AppendValues.
+func (b *LargeListBuilder) AppendValues(offsets []int64, valid []bool) {
+	if err := b.checkOffsets(len(offsets), len(valid), func(i int) int { return int(offsets[i]) }); err != "" {
+		b.invalid(b, "AppendValues", err)
+		return
+	}
+	if len(offsets) == 0 {
+		return
+	}
+	n := len(offsets) - 1
+	if b.hasEnd() {
+		// offsets[0] is already held as the end offset of the last slot.
+		offsets = offsets[1:]
+	}
+	b.Reserve(n)
+	b.offsets.(*Int64Builder).AppendValues(offsets, nil)
+	b.builder.unsafeAppendBoolsToBitmap(valid, n)
+}
+
+// NewArray creates a LargeList array from the memory buffers used by the builder and resets the LargeListBuilder
+// so it can be used to build a new array.
+func (b *LargeListBuilder) NewArray() Interface {
+	return b.NewLargeListArray()
+}
+
+// NewLargeListArray creates a LargeList array from the memory buffers used by the builder and resets the LargeListBuilder
+// so it can be used to build a new array.
+func (b *LargeListBuilder) NewLargeListArray() (a *LargeList) {
+	if err := b.checkEnd(); err != "" {
+		b.invalid(b, "NewArray", err)
+	}
+	data := b.newData(arrow.LargeListOf(b.etype))
+	a = NewLargeListData(data)
+	data.Release()
+	return
+}
+
+func (b *LargeListBuilder) checkNewArray() error {
+	if err := b.checkEnd(); err != "" {
+		return fmt.Errorf("arrow/array: %T.NewArray: %s", b, err)
+	}
+	return nil
+}
+
 var (
 	_ Interface = (*List)(nil)
 	_ Builder   = (*ListBuilder)(nil)
+	_ Interface = (*LargeList)(nil)
+	_ Builder   = (*LargeListBuilder)(nil)
 )
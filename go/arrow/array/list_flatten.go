@@ -0,0 +1,72 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// ListFlatten returns the values of the valid elements of l, in order, as a
+// flat array. Values under null elements of l are dropped.
+// The returned array must be Release()'d after use.
+func ListFlatten(l *List, mem memory.Allocator) (Interface, error) {
+	if l.NullN() == 0 {
+		if l.Len() == 0 {
+			return NewSlice(l.values, 0, 0), nil
+		}
+		off := l.data.offset
+		beg, end := int64(l.offsets[off]), int64(l.offsets[off+l.Len()])
+		return NewSlice(l.values, beg, end), nil
+	}
+
+	var indices []int
+	listValidRanges(l, func(i, beg, end int) {
+		for j := beg; j < end; j++ {
+			indices = append(indices, j)
+		}
+	})
+	return Take(l.values, indices, mem)
+}
+
+// ListParentIndices returns, for each value of the flattened list l, as
+// returned by ListFlatten, the index of the element of l holding it.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// Release()'d after use.
+func ListParentIndices(l *List) *Int32 {
+	bldr := NewInt32Builder(memory.DefaultAllocator)
+	defer bldr.Release()
+
+	listValidRanges(l, func(i, beg, end int) {
+		bldr.Reserve(end - beg)
+		for j := beg; j < end; j++ {
+			bldr.UnsafeAppend(int32(i))
+		}
+	})
+	return bldr.NewInt32Array()
+}
+
+// listValidRanges calls fn with the index of each valid element of l, and
+// the [beg, end) range of its values in the values of l.
+func listValidRanges(l *List, fn func(i, beg, end int)) {
+	off := l.data.offset
+	for i := 0; i < l.Len(); i++ {
+		if l.IsNull(i) {
+			continue
+		}
+		fn(i, int(l.offsets[off+i]), int(l.offsets[off+i+1]))
+	}
+}
@@ -18,6 +18,7 @@ package array_test
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow"
@@ -211,3 +212,326 @@ func TestListArraySlice(t *testing.T) {
 		t.Fatalf("got=%q, want=%q", got, want)
 	}
 }
+
+func TestLargeListArray(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	var (
+		vs      = []int32{0, 1, 2, 3, 4, 5, 6}
+		lengths = []int{3, 0, 4}
+		isValid = []bool{true, false, true}
+		offsets = []int64{0, 3, 3, 7}
+	)
+
+	lb := array.NewLargeListBuilder(pool, arrow.PrimitiveTypes.Int32)
+	defer lb.Release()
+
+	for i := 0; i < 10; i++ {
+		vb := lb.ValueBuilder().(*array.Int32Builder)
+		vb.Reserve(len(vs))
+
+		pos := 0
+		for i, length := range lengths {
+			lb.Append(isValid[i])
+			for j := 0; j < length; j++ {
+				vb.Append(vs[pos])
+				pos++
+			}
+		}
+
+		arr := lb.NewArray().(*array.LargeList)
+		defer arr.Release()
+
+		if got, want := arr.DataType(), arrow.LargeListOf(arrow.PrimitiveTypes.Int32); !arrow.TypeEquals(got, want) {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+
+		if got, want := arr.Len(), len(isValid); got != want {
+			t.Fatalf("got=%d, want=%d", got, want)
+		}
+
+		for i := range lengths {
+			if got, want := arr.IsValid(i), isValid[i]; got != want {
+				t.Fatalf("got[%d]=%v, want[%d]=%v", i, got, i, want)
+			}
+		}
+
+		if got, want := arr.Offsets(), offsets; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+
+		varr := arr.ListValues().(*array.Int32)
+		if got, want := varr.Int32Values(), vs; !reflect.DeepEqual(got, want) {
+			t.Fatalf("got=%v, want=%v", got, want)
+		}
+	}
+}
+
+func TestLargeListArraySlice(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	var (
+		vs      = []int32{0, 1, 2, 3, 4, 5, 6}
+		isValid = []bool{true, false, true}
+	)
+
+	lb := array.NewLargeListBuilder(pool, arrow.PrimitiveTypes.Int32)
+	defer lb.Release()
+	vb := lb.ValueBuilder().(*array.Int32Builder)
+
+	lb.AppendValues([]int64{0, 3, 3, 7}, isValid)
+	vb.AppendValues(vs, nil)
+
+	arr := lb.NewArray().(*array.LargeList)
+	defer arr.Release()
+
+	// build the same list with 32-bit offsets, to compare their behaviors.
+	sb := array.NewListBuilder(pool, arrow.PrimitiveTypes.Int32)
+	defer sb.Release()
+	sb.AppendValues([]int32{0, 3, 3, 7}, isValid)
+	sb.ValueBuilder().(*array.Int32Builder).AppendValues(vs, nil)
+
+	ref := sb.NewArray().(*array.List)
+	defer ref.Release()
+
+	if got, want := arr.String(), ref.String(); got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+
+	for _, tc := range [][2]int64{{0, 3}, {1, 3}, {2, 3}, {0, 1}, {1, 1}} {
+		sub := array.NewSlice(arr, tc[0], tc[1]).(*array.LargeList)
+		want := array.NewSlice(ref, tc[0], tc[1]).(*array.List)
+
+		if got, want := sub.String(), want.String(); got != want {
+			t.Fatalf("slice %v: got=%q, want=%q", tc, got, want)
+		}
+		if got, want := sub.NullN(), want.NullN(); got != want {
+			t.Fatalf("slice %v: invalid nulls: got=%d, want=%d", tc, got, want)
+		}
+		for i := 0; i < sub.Len(); i++ {
+			got := sub.ValueSlice(i)
+			want := want.ValueSlice(i)
+			if !array.ArrayEqual(got, want) {
+				t.Fatalf("slice %v: invalid value %d: got=%v, want=%v", tc, i, got, want)
+			}
+			got.Release()
+			want.Release()
+		}
+
+		sub.Release()
+		want.Release()
+	}
+
+	other := array.NewSlice(arr, 0, 3)
+	defer other.Release()
+	if !array.ArrayEqual(arr, other) {
+		t.Fatalf("large lists should compare equal")
+	}
+}
+
+func TestListBuilderAppendValuesOffsets(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	// same data as in Example_listArray, given in two batches of offsets
+	// ahead of the values.
+	lb := array.NewListBuilder(pool, arrow.PrimitiveTypes.Int64)
+	defer lb.Release()
+
+	vb := lb.ValueBuilder().(*array.Int64Builder)
+	lb.AppendValues([]int32{0, 3, 3, 4, 6}, []bool{true, false, true, true})
+	lb.AppendValues([]int32{6, 9, 9, 10}, []bool{true, false, true})
+	vb.AppendValues([]int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, nil)
+
+	arr := lb.NewArray().(*array.List)
+	defer arr.Release()
+
+	if got, want := arr.NullN(), 2; got != want {
+		t.Fatalf("invalid nulls: got=%d, want=%d", got, want)
+	}
+	if got, want := arr.Offsets(), []int32{0, 3, 3, 4, 6, 9, 9, 10}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid offsets: got=%v, want=%v", got, want)
+	}
+	if got, want := arr.String(), "[[0 1 2] (null) [3] [4 5] [6 7 8] (null) [9]]"; got != want {
+		t.Fatalf("invalid array: got=%s, want=%s", got, want)
+	}
+
+	// the last offset is the end offset, with or without validity.
+	for _, valid := range [][]bool{nil, {true, true}} {
+		vb.AppendValues([]int64{1, 2, 3}, nil)
+		lb.AppendValues([]int32{0, 2, 3}, valid)
+		arr := lb.NewListArray()
+		defer arr.Release()
+		if got, want := arr.String(), "[[1 2] [3]]"; got != want {
+			t.Fatalf("invalid array with validity %v: got=%s, want=%s", valid, got, want)
+		}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		offsets []int32
+		valid   []bool
+		err     string
+	}{
+		{name: "length", offsets: []int32{0, 1, 2, 3}, valid: []bool{true, true}, err: "len(offsets) != len(valid)+1"},
+		{name: "no-offsets", valid: []bool{true}, err: "len(offsets) != len(valid)+1"},
+		{name: "decreasing", offsets: []int32{0, 2, 1}, err: "offsets are not monotonic non-decreasing: offsets[2]=1 < 2"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			lb := array.NewListBuilder(pool, arrow.PrimitiveTypes.Int64)
+			defer lb.Release()
+			lb.SetCollectErrors(true)
+			lb.ValueBuilder().(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+
+			lb.AppendValues(tc.offsets, tc.valid)
+			if err := lb.Err(); err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+			}
+			if got, want := lb.Len(), 0; got != want {
+				t.Fatalf("invalid length: got=%d, want=%d", got, want)
+			}
+		})
+	}
+
+	t.Run("end", func(t *testing.T) {
+		lb := array.NewListBuilder(pool, arrow.PrimitiveTypes.Int64)
+		defer lb.Release()
+		lb.SetCollectErrors(true)
+		lb.ValueBuilder().(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+
+		lb.AppendValues([]int32{0, 1, 2}, nil)
+		want := "end offset 2 does not match the 3 values"
+		if _, err := array.NewArrayChecked(lb); err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("invalid error: got=%v, want=%q", err, want)
+		}
+		if got, want := lb.Len(), 0; got != want {
+			t.Fatalf("invalid length: got=%d, want=%d", got, want)
+		}
+
+		lb.SetCollectErrors(false)
+		lb.AppendValues([]int32{0, 1, 2}, nil)
+		defer func() {
+			if e := recover(); e == nil {
+				t.Fatalf("expected a panic")
+			}
+		}()
+		lb.NewArray()
+	})
+
+	t.Run("previous-offset", func(t *testing.T) {
+		lb := array.NewLargeListBuilder(pool, arrow.PrimitiveTypes.Int64)
+		defer lb.Release()
+		lb.ValueBuilder().(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+		lb.AppendValues([]int64{0, 2, 3}, nil)
+
+		defer func() {
+			if e := recover(); e == nil {
+				t.Fatalf("expected a panic")
+			}
+		}()
+		lb.AppendValues([]int64{2, 3}, nil)
+	})
+}
+
+func TestListBuilderAppendValuesEndOffset(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	t.Run("list", func(t *testing.T) {
+		lb := array.NewListBuilder(pool, arrow.PrimitiveTypes.Int64)
+		defer lb.Release()
+
+		vb := lb.ValueBuilder().(*array.Int64Builder)
+		vb.AppendValues([]int64{1, 2, 3}, nil)
+		lb.AppendValues([]int32{0, 2, 3}, nil)
+
+		// the end offset must not create a slot before the next appended one.
+		lb.Append(true)
+		vb.AppendValues([]int64{4, 5}, nil)
+
+		arr := lb.NewListArray()
+		defer arr.Release()
+
+		if got, want := arr.Len(), 3; got != want {
+			t.Fatalf("invalid length: got=%d, want=%d", got, want)
+		}
+		if got, want := arr.Offsets(), []int32{0, 2, 3, 5}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("invalid offsets: got=%v, want=%v", got, want)
+		}
+		if got, want := arr.String(), "[[1 2] [3] [4 5]]"; got != want {
+			t.Fatalf("invalid array: got=%s, want=%s", got, want)
+		}
+	})
+
+	t.Run("large-list", func(t *testing.T) {
+		lb := array.NewLargeListBuilder(pool, arrow.PrimitiveTypes.Int64)
+		defer lb.Release()
+
+		vb := lb.ValueBuilder().(*array.Int64Builder)
+		vb.AppendValues([]int64{1, 2, 3}, nil)
+		lb.AppendValues([]int64{0, 2, 3}, nil)
+		lb.Append(true)
+		vb.AppendValues([]int64{4, 5}, nil)
+
+		arr := lb.NewLargeListArray()
+		defer arr.Release()
+
+		if got, want := arr.Len(), 3; got != want {
+			t.Fatalf("invalid length: got=%d, want=%d", got, want)
+		}
+		if got, want := arr.Offsets(), []int64{0, 2, 3, 5}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("invalid offsets: got=%v, want=%v", got, want)
+		}
+	})
+}
+
+func TestListFlatten(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	lb := array.NewListBuilder(pool, arrow.PrimitiveTypes.Int32)
+	defer lb.Release()
+
+	// the null element still spans values 2 and 3.
+	lb.ValueBuilder().(*array.Int32Builder).AppendValues([]int32{0, 1, 2, 3, 4, 5, 6, 7}, nil)
+	lb.AppendValues([]int32{0, 2, 4, 5, 8}, []bool{true, false, true, true})
+
+	arr := lb.NewListArray()
+	defer arr.Release()
+
+	for _, tc := range []struct {
+		beg, end int64
+		values   []int32
+		parents  []int32
+	}{
+		{0, 4, []int32{0, 1, 4, 5, 6, 7}, []int32{0, 0, 2, 3, 3, 3}},
+		{1, 4, []int32{4, 5, 6, 7}, []int32{1, 2, 2, 2}},
+		{2, 4, []int32{4, 5, 6, 7}, []int32{0, 1, 1, 1}},
+		{1, 2, []int32{}, []int32{}},
+		{0, 0, []int32{}, []int32{}},
+	} {
+		t.Run("", func(t *testing.T) {
+			l := array.NewSlice(arr, tc.beg, tc.end).(*array.List)
+			defer l.Release()
+
+			flat, err := array.ListFlatten(l, pool)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer flat.Release()
+
+			if got, want := flat.(*array.Int32).Int32Values(), tc.values; !reflect.DeepEqual(got, want) && len(got)+len(want) != 0 {
+				t.Fatalf("invalid flattened values: got=%v, want=%v", got, want)
+			}
+
+			parents := array.ListParentIndices(l)
+			defer parents.Release()
+
+			if got, want := parents.Int32Values(), tc.parents; !reflect.DeepEqual(got, want) && len(got)+len(want) != 0 {
+				t.Fatalf("invalid parent indices: got=%v, want=%v", got, want)
+			}
+		})
+	}
+}
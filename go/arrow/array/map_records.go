@@ -0,0 +1,254 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+)
+
+// MapRecords returns a reader over the records obtained by applying fn to
+// each record of r. Records are processed concurrently by a pool of
+// parallelism workers, but are returned in the order they were read from r.
+// If parallelism is less than 1, runtime.GOMAXPROCS(0) workers are used.
+//
+// fn must not release its argument, which is released once fn returns;
+// fn must retain it if it returns it as is. The record returned by fn is
+// owned by the returned reader.
+//
+// The first error returned by fn or by r, or the cancellation of ctx, stops
+// the processing and is reported by the Err method of the returned reader.
+// fn returning a nil record without an error is reported as an error as well.
+//
+// The schema of the returned reader is the one of the first record returned
+// by fn, or the schema of r if there is none.
+//
+// The returned reader must be Release()'d after use.
+func MapRecords(ctx context.Context, r RecordReader, parallelism int, fn func(Record) (Record, error)) RecordReader {
+	if parallelism < 1 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	mr := &mappedRecords{
+		refCount: 1,
+		cancel:   cancel,
+		schema:   r.Schema(),
+		pending:  make(chan chan mapResult, parallelism),
+	}
+
+	jobs := make(chan mapJob)
+	for i := 0; i < parallelism; i++ {
+		go func() {
+			for job := range jobs {
+				var res mapResult
+				if err := ctx.Err(); err != nil {
+					res.err = err
+				} else {
+					res.rec, res.err = fn(job.rec)
+					if res.rec == nil && res.err == nil {
+						res.err = errMapRecordsNil
+					}
+				}
+				job.rec.Release()
+				job.res <- res
+			}
+		}()
+	}
+
+	r.Retain()
+	go mr.dispatch(ctx, r, jobs)
+
+	return mr
+}
+
+var errMapRecordsNil = errors.New("arrow/array: MapRecords function returned a nil record")
+
+type mapJob struct {
+	rec Record
+	res chan<- mapResult
+}
+
+type mapResult struct {
+	rec Record
+	err error
+}
+
+// mappedRecords is the reader returned by MapRecords.
+type mappedRecords struct {
+	refCount int64
+
+	cancel  context.CancelFunc
+	schema  *arrow.Schema
+	pending chan chan mapResult // results in input order
+
+	peeked  *mapResult // result consumed by Schema, not yet returned by Next
+	typed   bool       // whether schema is the one of the mapped records
+	srcErr  error      // error which stopped the dispatch, set before pending is closed
+	drained bool
+
+	cur Record
+	err error
+}
+
+// dispatch reads the records from r and hands them to the workers, queuing
+// their results in input order.
+func (mr *mappedRecords) dispatch(ctx context.Context, r RecordReader, jobs chan<- mapJob) {
+	defer close(mr.pending)
+	defer close(jobs)
+	defer r.Release()
+
+	for r.Next() {
+		rec := r.Record()
+		rec.Retain()
+
+		res := make(chan mapResult, 1)
+		select {
+		case mr.pending <- res:
+		case <-ctx.Done():
+			rec.Release()
+			mr.srcErr = ctx.Err()
+			return
+		}
+
+		select {
+		case jobs <- mapJob{rec: rec, res: res}:
+		case <-ctx.Done():
+			rec.Release()
+			res <- mapResult{err: ctx.Err()}
+			mr.srcErr = ctx.Err()
+			return
+		}
+	}
+	mr.srcErr = r.Err()
+}
+
+// next returns the next result, in input order, and whether there is one.
+func (mr *mappedRecords) next() (mapResult, bool) {
+	if mr.peeked != nil {
+		res := *mr.peeked
+		mr.peeked = nil
+		return res, true
+	}
+	if mr.drained {
+		return mapResult{}, false
+	}
+	res, ok := <-mr.pending
+	if !ok {
+		mr.drained = true
+		return mapResult{err: mr.srcErr}, false
+	}
+	return <-res, true
+}
+
+// drain stops the processing and releases the records not yet returned.
+func (mr *mappedRecords) drain() {
+	mr.cancel()
+	if mr.peeked != nil && mr.peeked.rec != nil {
+		mr.peeked.rec.Release()
+	}
+	mr.peeked = nil
+	if mr.drained {
+		return
+	}
+	for res := range mr.pending {
+		if res := <-res; res.rec != nil {
+			res.rec.Release()
+		}
+	}
+	mr.drained = true
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (mr *mappedRecords) Retain() {
+	atomic.AddInt64(&mr.refCount, 1)
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the processing is stopped and
+// the memory is freed.
+// Release may be called simultaneously from multiple goroutines.
+func (mr *mappedRecords) Release() {
+	debug.Assert(atomic.LoadInt64(&mr.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&mr.refCount, -1) == 0 {
+		if mr.cur != nil {
+			mr.cur.Release()
+			mr.cur = nil
+		}
+		mr.drain()
+	}
+}
+
+func (mr *mappedRecords) Schema() *arrow.Schema {
+	if !mr.typed && mr.cur == nil && mr.err == nil {
+		res, ok := mr.next()
+		if ok {
+			mr.peeked = &res
+		} else {
+			mr.err = res.err
+		}
+		if res.rec != nil {
+			mr.schema = res.rec.Schema()
+		}
+		mr.typed = true
+	}
+	return mr.schema
+}
+
+func (mr *mappedRecords) Record() Record { return mr.cur }
+func (mr *mappedRecords) Err() error     { return mr.err }
+
+func (mr *mappedRecords) Next() bool {
+	if mr.cur != nil {
+		mr.cur.Release()
+		mr.cur = nil
+	}
+	if mr.err != nil {
+		return false
+	}
+
+	res, ok := mr.next()
+	if res.err != nil {
+		mr.err = res.err
+		if res.rec != nil {
+			res.rec.Release()
+		}
+		mr.drain()
+		return false
+	}
+	if !ok {
+		return false
+	}
+
+	mr.cur = res.rec
+	if !mr.typed {
+		mr.schema = mr.cur.Schema()
+		mr.typed = true
+	}
+	return true
+}
+
+var (
+	_ RecordReader = (*mappedRecords)(nil)
+)
@@ -0,0 +1,201 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func newMapRecordsReader(t *testing.T, mem memory.Allocator, nrecs int) array.RecordReader {
+	t.Helper()
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "x", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	recs := make([]array.Record, nrecs)
+	for i := range recs {
+		b.Field(0).(*array.Int64Builder).AppendValues([]int64{int64(2 * i), int64(2*i + 1)}, nil)
+		recs[i] = b.NewRecord()
+		defer recs[i].Release()
+	}
+
+	r, err := array.NewRecordReader(schema, recs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestMapRecords(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const nrecs = 100
+	src := newMapRecordsReader(t, mem, nrecs)
+	defer src.Release()
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "x2", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	square := func(rec array.Record) (array.Record, error) {
+		vs := rec.Column(0).(*array.Int64).Int64Values()
+		// finish out of order, to exercise the reordering of the results.
+		time.Sleep(time.Duration(vs[0]%7) * time.Millisecond)
+
+		b := array.NewInt64Builder(mem)
+		defer b.Release()
+		for _, v := range vs {
+			b.Append(v * v)
+		}
+		col := b.NewArray()
+		defer col.Release()
+		return array.NewRecord(schema, []array.Interface{col}, rec.NumRows()), nil
+	}
+
+	r := array.MapRecords(context.Background(), src, 8, square)
+	defer r.Release()
+
+	if got, want := r.Schema(), schema; !got.Equal(want) {
+		t.Fatalf("invalid schema: got=%v, want=%v", got, want)
+	}
+
+	n := 0
+	for r.Next() {
+		got := r.Record().Column(0).(*array.Int64).Int64Values()
+		want := []int64{int64(4 * n * n), int64((2*n + 1) * (2*n + 1))}
+		if got[0] != want[0] || got[1] != want[1] {
+			t.Fatalf("invalid record %d: got=%v, want=%v", n, got, want)
+		}
+		n++
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n != nrecs {
+		t.Fatalf("invalid number of records: got=%d, want=%d", n, nrecs)
+	}
+}
+
+func TestMapRecordsError(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	src := newMapRecordsReader(t, mem, 100)
+	defer src.Release()
+
+	errBoom := errors.New("boom")
+	fn := func(rec array.Record) (array.Record, error) {
+		if rec.Column(0).(*array.Int64).Value(0) == 10 {
+			return nil, errBoom
+		}
+		rec.Retain()
+		return rec, nil
+	}
+
+	r := array.MapRecords(context.Background(), src, 4, fn)
+	defer r.Release()
+
+	n := 0
+	for r.Next() {
+		n++
+	}
+	if got, want := r.Err(), errBoom; got != want {
+		t.Fatalf("invalid error: got=%v, want=%v", got, want)
+	}
+	if got, want := n, 5; got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+	if r.Next() {
+		t.Fatalf("reader should be exhausted after an error")
+	}
+}
+
+func TestMapRecordsNil(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	src := newMapRecordsReader(t, mem, 100)
+	defer src.Release()
+
+	fn := func(rec array.Record) (array.Record, error) {
+		if rec.Column(0).(*array.Int64).Value(0) == 10 {
+			return nil, nil
+		}
+		rec.Retain()
+		return rec, nil
+	}
+
+	r := array.MapRecords(context.Background(), src, 4, fn)
+	defer r.Release()
+
+	n := 0
+	for r.Next() {
+		n++
+	}
+	if r.Err() == nil {
+		t.Fatalf("expected an error")
+	}
+	if got, want := n, 5; got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+
+	// the schema of a reader whose first record is nil is the one of its source.
+	first := array.MapRecords(context.Background(), src, 4, func(array.Record) (array.Record, error) {
+		return nil, nil
+	})
+	defer first.Release()
+
+	if got, want := first.Schema(), src.Schema(); !got.Equal(want) {
+		t.Fatalf("invalid schema: got=%v, want=%v", got, want)
+	}
+	if first.Next() || first.Err() == nil {
+		t.Fatalf("expected an error")
+	}
+}
+
+func TestMapRecordsCancel(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	src := newMapRecordsReader(t, mem, 100)
+	defer src.Release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fn := func(rec array.Record) (array.Record, error) {
+		rec.Retain()
+		return rec, nil
+	}
+
+	r := array.MapRecords(ctx, src, 4, fn)
+	defer r.Release()
+
+	if !r.Next() {
+		t.Fatalf("could not read first record: %v", r.Err())
+	}
+	cancel()
+	for r.Next() {
+	}
+	if got, want := r.Err(), context.Canceled; got != want {
+		t.Fatalf("invalid error: got=%v, want=%v", got, want)
+	}
+}
@@ -54,6 +54,12 @@ func NewNullData(data *Data) *Null {
 	return a
 }
 
+// IsNull returns true for all the elements of a Null array.
+func (a *Null) IsNull(i int) bool { return true }
+
+// IsValid returns false for all the elements of a Null array.
+func (a *Null) IsValid(i int) bool { return false }
+
 func (a *Null) String() string {
 	o := new(strings.Builder)
 	o.WriteString("[")
@@ -100,6 +106,15 @@ func (b *NullBuilder) AppendNull() {
 	b.builder.nulls++
 }
 
+// AppendNulls appends n null values to the builder.
+func (b *NullBuilder) AppendNulls(n int) {
+	b.builder.length += n
+	b.builder.nulls += n
+}
+
+// AppendEmptyValue appends a null value, the only value of the null type.
+func (b *NullBuilder) AppendEmptyValue() { b.AppendNull() }
+
 func (*NullBuilder) Reserve(size int) {}
 func (*NullBuilder) Resize(size int)  {}
 
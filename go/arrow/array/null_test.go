@@ -75,3 +75,56 @@ func TestNullArray(t *testing.T) {
 	}
 
 }
+
+func TestNullArraySlice(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	b := array.NewNullBuilder(pool)
+	defer b.Release()
+
+	b.AppendNull()
+	b.AppendNulls(4)
+
+	if got, want := b.Len(), 5; got != want {
+		t.Fatalf("invalid builder length: got=%d, want=%d", got, want)
+	}
+
+	arr := b.NewNullArray()
+	defer arr.Release()
+
+	if got, want := pool.CurrentAlloc(), 0; got != want {
+		t.Fatalf("null array should not allocate memory: got=%d, want=%d", got, want)
+	}
+
+	for i := 0; i < arr.Len(); i++ {
+		if !arr.IsNull(i) || arr.IsValid(i) {
+			t.Fatalf("element %d should be null", i)
+		}
+	}
+
+	sub := array.NewSlice(arr, 1, 4).(*array.Null)
+	defer sub.Release()
+
+	if got, want := sub.Len(), 3; got != want {
+		t.Fatalf("invalid slice length: got=%d, want=%d", got, want)
+	}
+	if got, want := sub.NullN(), 3; got != want {
+		t.Fatalf("invalid slice nulls: got=%d, want=%d", got, want)
+	}
+	if got, want := sub.String(), "[(null) (null) (null)]"; got != want {
+		t.Fatalf("invalid slice: got=%q, want=%q", got, want)
+	}
+
+	same := array.NewNull(3)
+	defer same.Release()
+	other := array.NewNull(4)
+	defer other.Release()
+
+	if !array.ArrayEqual(sub, same) {
+		t.Fatalf("null arrays of equal length should be equal")
+	}
+	if array.ArrayEqual(sub, other) {
+		t.Fatalf("null arrays of different lengths should not be equal")
+	}
+}
@@ -59,6 +59,18 @@ func (a *Int64) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Int64) Range(fn func(i int, v int64, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Int64) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -116,6 +128,18 @@ func (a *Uint64) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Uint64) Range(fn func(i int, v uint64, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Uint64) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -173,6 +197,18 @@ func (a *Float64) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Float64) Range(fn func(i int, v float64, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Float64) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -230,6 +266,18 @@ func (a *Int32) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Int32) Range(fn func(i int, v int32, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Int32) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -287,6 +335,18 @@ func (a *Uint32) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Uint32) Range(fn func(i int, v uint32, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Uint32) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -344,6 +404,18 @@ func (a *Float32) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Float32) Range(fn func(i int, v float32, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Float32) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -401,6 +473,18 @@ func (a *Int16) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Int16) Range(fn func(i int, v int16, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Int16) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -458,6 +542,18 @@ func (a *Uint16) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Uint16) Range(fn func(i int, v uint16, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Uint16) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -515,6 +611,18 @@ func (a *Int8) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Int8) Range(fn func(i int, v int8, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Int8) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -572,6 +680,18 @@ func (a *Uint8) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Uint8) Range(fn func(i int, v uint8, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Uint8) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -629,6 +749,18 @@ func (a *Timestamp) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Timestamp) Range(fn func(i int, v arrow.Timestamp, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Timestamp) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -686,6 +818,18 @@ func (a *Time32) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Time32) Range(fn func(i int, v arrow.Time32, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Time32) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -743,6 +887,18 @@ func (a *Time64) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Time64) Range(fn func(i int, v arrow.Time64, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Time64) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -800,6 +956,18 @@ func (a *Date32) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Date32) Range(fn func(i int, v arrow.Date32, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Date32) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -857,6 +1025,18 @@ func (a *Date64) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Date64) Range(fn func(i int, v arrow.Date64, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Date64) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -914,6 +1094,18 @@ func (a *Duration) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *Duration) Range(fn func(i int, v arrow.Duration, valid bool) bool) {
+	nulls := a.NullN() > 0
+	for i, v := range a.values {
+		if !fn(i, v, !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *Duration) setData(data *Data) {
 	a.array.setData(data)
 	vals := data.buffers[1]
@@ -17,6 +17,7 @@
 package array_test
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
@@ -614,3 +615,154 @@ func TestDate64SliceDataWithNull(t *testing.T) {
 		t.Fatalf("got=%v, want=%v", got, want)
 	}
 }
+
+func TestTimeBuilderUnit(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, tc := range []struct {
+		dtype arrow.DataType
+		err   string
+	}{
+		{dtype: &arrow.Time32Type{Unit: arrow.Second}},
+		{dtype: &arrow.Time32Type{Unit: arrow.Millisecond}},
+		{dtype: &arrow.Time32Type{Unit: arrow.Microsecond}, err: `arrow/array: *array.Time32Builder.NewArray: arrow: invalid time32 unit "us" (must be s or ms)`},
+		{dtype: &arrow.Time32Type{Unit: arrow.Nanosecond}, err: `arrow/array: *array.Time32Builder.NewArray: arrow: invalid time32 unit "ns" (must be s or ms)`},
+		{dtype: &arrow.Time64Type{Unit: arrow.Microsecond}},
+		{dtype: &arrow.Time64Type{Unit: arrow.Nanosecond}},
+		{dtype: &arrow.Time64Type{Unit: arrow.Second}, err: `arrow/array: *array.Time64Builder.NewArray: arrow: invalid time64 unit "s" (must be us or ns)`},
+		{dtype: &arrow.Time64Type{Unit: arrow.Millisecond}, err: `arrow/array: *array.Time64Builder.NewArray: arrow: invalid time64 unit "ms" (must be us or ns)`},
+	} {
+		t.Run(fmt.Sprint(tc.dtype), func(t *testing.T) {
+			b := array.NewBuilder(mem, tc.dtype)
+			defer b.Release()
+
+			b.AppendNull()
+			arr, err := array.NewArrayChecked(b)
+			if tc.err != "" {
+				if err == nil {
+					arr.Release()
+					t.Fatalf("expected an error")
+				}
+				if got, want := err.Error(), tc.err; got != want {
+					t.Fatalf("invalid error. got=%q, want=%q", got, want)
+				}
+				if got, want := b.Len(), 0; got != want {
+					t.Fatalf("invalid builder length: got=%d, want=%d", got, want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer arr.Release()
+
+			if got, want := arr.DataType(), tc.dtype; !arrow.TypeEquals(got, want) {
+				t.Fatalf("invalid data type: got=%v, want=%v", got, want)
+			}
+		})
+	}
+}
+
+func TestInt64Range(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewInt64Builder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]int64{1, 2, 3, 4, 5}, []bool{true, true, false, true, true})
+
+	arr := bldr.NewInt64Array()
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 1, 5).(*array.Int64)
+	defer slice.Release()
+
+	var (
+		vs     []int64
+		valids []bool
+	)
+	slice.Range(func(i int, v int64, valid bool) bool {
+		if valid {
+			vs = append(vs, v)
+		}
+		valids = append(valids, valid)
+		return i < 2
+	})
+
+	if got, want := vs, []int64{2, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid values: got=%v, want=%v", got, want)
+	}
+	if got, want := valids, []bool{true, false, true}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid validity: got=%v, want=%v", got, want)
+	}
+}
+
+func TestFloat64Range(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewFloat64Builder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]float64{1.5, 2.5, 3.5}, nil)
+
+	arr := bldr.NewFloat64Array()
+	defer arr.Release()
+
+	var vs []float64
+	arr.Range(func(i int, v float64, valid bool) bool {
+		if !valid {
+			t.Fatalf("element %d should be valid", i)
+		}
+		vs = append(vs, v)
+		return true
+	})
+
+	if got, want := vs, arr.Float64Values(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid values: got=%v, want=%v", got, want)
+	}
+}
+
+func BenchmarkInt64Range(b *testing.B) {
+	const n = 1 << 16
+
+	mem := memory.NewGoAllocator()
+	bldr := array.NewInt64Builder(mem)
+	defer bldr.Release()
+
+	bldr.Reserve(n)
+	for i := 0; i < n; i++ {
+		if i%10 == 0 {
+			bldr.AppendNull()
+			continue
+		}
+		bldr.Append(int64(i))
+	}
+
+	arr := bldr.NewInt64Array()
+	defer arr.Release()
+
+	b.Run("range", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var sum int64
+			arr.Range(func(_ int, v int64, valid bool) bool {
+				if valid {
+					sum += v
+				}
+				return true
+			})
+		}
+	})
+	b.Run("index", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var sum int64
+			for j := 0; j < arr.Len(); j++ {
+				if arr.IsValid(j) {
+					sum += arr.Value(j)
+				}
+			}
+		}
+	})
+}
@@ -19,6 +19,7 @@
 package array
 
 import (
+	"fmt"
 	"sync/atomic"
 
 	"github.com/apache/arrow/go/arrow"
@@ -66,12 +67,29 @@ func (b *Int64Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Int64Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Int64Builder) AppendEmptyValue() {
+	var v int64
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Int64Builder) UnsafeAppend(v int64) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Int64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -86,7 +104,8 @@ func (b *Int64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Int64Builder) AppendValues(v []int64, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -98,6 +117,24 @@ func (b *Int64Builder) AppendValues(v []int64, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Int64Builder) AppendArraySlice(src *Int64, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Int64Traits.Copy(b.rawData[b.length:], src.Int64Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Int64Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -202,12 +239,29 @@ func (b *Uint64Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Uint64Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Uint64Builder) AppendEmptyValue() {
+	var v uint64
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Uint64Builder) UnsafeAppend(v uint64) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Uint64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -222,7 +276,8 @@ func (b *Uint64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Uint64Builder) AppendValues(v []uint64, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -234,6 +289,24 @@ func (b *Uint64Builder) AppendValues(v []uint64, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Uint64Builder) AppendArraySlice(src *Uint64, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Uint64Traits.Copy(b.rawData[b.length:], src.Uint64Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Uint64Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -338,12 +411,29 @@ func (b *Float64Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Float64Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Float64Builder) AppendEmptyValue() {
+	var v float64
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Float64Builder) UnsafeAppend(v float64) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Float64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -358,7 +448,8 @@ func (b *Float64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Float64Builder) AppendValues(v []float64, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -370,6 +461,24 @@ func (b *Float64Builder) AppendValues(v []float64, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Float64Builder) AppendArraySlice(src *Float64, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Float64Traits.Copy(b.rawData[b.length:], src.Float64Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Float64Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -474,12 +583,29 @@ func (b *Int32Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Int32Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Int32Builder) AppendEmptyValue() {
+	var v int32
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Int32Builder) UnsafeAppend(v int32) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Int32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -494,7 +620,8 @@ func (b *Int32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Int32Builder) AppendValues(v []int32, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -506,6 +633,24 @@ func (b *Int32Builder) AppendValues(v []int32, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Int32Builder) AppendArraySlice(src *Int32, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Int32Traits.Copy(b.rawData[b.length:], src.Int32Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Int32Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -610,12 +755,29 @@ func (b *Uint32Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Uint32Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Uint32Builder) AppendEmptyValue() {
+	var v uint32
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Uint32Builder) UnsafeAppend(v uint32) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Uint32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -630,7 +792,8 @@ func (b *Uint32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Uint32Builder) AppendValues(v []uint32, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -642,6 +805,24 @@ func (b *Uint32Builder) AppendValues(v []uint32, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Uint32Builder) AppendArraySlice(src *Uint32, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Uint32Traits.Copy(b.rawData[b.length:], src.Uint32Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Uint32Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -746,12 +927,29 @@ func (b *Float32Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Float32Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Float32Builder) AppendEmptyValue() {
+	var v float32
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Float32Builder) UnsafeAppend(v float32) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Float32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -766,7 +964,8 @@ func (b *Float32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Float32Builder) AppendValues(v []float32, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -778,6 +977,24 @@ func (b *Float32Builder) AppendValues(v []float32, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Float32Builder) AppendArraySlice(src *Float32, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Float32Traits.Copy(b.rawData[b.length:], src.Float32Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Float32Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -882,12 +1099,29 @@ func (b *Int16Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Int16Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Int16Builder) AppendEmptyValue() {
+	var v int16
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Int16Builder) UnsafeAppend(v int16) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Int16Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -902,7 +1136,8 @@ func (b *Int16Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Int16Builder) AppendValues(v []int16, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -914,6 +1149,24 @@ func (b *Int16Builder) AppendValues(v []int16, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Int16Builder) AppendArraySlice(src *Int16, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Int16Traits.Copy(b.rawData[b.length:], src.Int16Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Int16Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1018,12 +1271,29 @@ func (b *Uint16Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Uint16Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Uint16Builder) AppendEmptyValue() {
+	var v uint16
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Uint16Builder) UnsafeAppend(v uint16) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Uint16Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -1038,7 +1308,8 @@ func (b *Uint16Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Uint16Builder) AppendValues(v []uint16, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -1050,6 +1321,24 @@ func (b *Uint16Builder) AppendValues(v []uint16, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Uint16Builder) AppendArraySlice(src *Uint16, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Uint16Traits.Copy(b.rawData[b.length:], src.Uint16Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Uint16Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1154,12 +1443,29 @@ func (b *Int8Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Int8Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Int8Builder) AppendEmptyValue() {
+	var v int8
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Int8Builder) UnsafeAppend(v int8) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Int8Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -1174,7 +1480,8 @@ func (b *Int8Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Int8Builder) AppendValues(v []int8, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -1186,6 +1493,24 @@ func (b *Int8Builder) AppendValues(v []int8, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Int8Builder) AppendArraySlice(src *Int8, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Int8Traits.Copy(b.rawData[b.length:], src.Int8Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Int8Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1290,12 +1615,29 @@ func (b *Uint8Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Uint8Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Uint8Builder) AppendEmptyValue() {
+	var v uint8
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Uint8Builder) UnsafeAppend(v uint8) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Uint8Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -1310,7 +1652,8 @@ func (b *Uint8Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Uint8Builder) AppendValues(v []uint8, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -1322,6 +1665,24 @@ func (b *Uint8Builder) AppendValues(v []uint8, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Uint8Builder) AppendArraySlice(src *Uint8, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Uint8Traits.Copy(b.rawData[b.length:], src.Uint8Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Uint8Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1427,12 +1788,29 @@ func (b *TimestampBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *TimestampBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *TimestampBuilder) AppendEmptyValue() {
+	var v arrow.Timestamp
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *TimestampBuilder) UnsafeAppend(v arrow.Timestamp) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *TimestampBuilder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -1447,7 +1825,8 @@ func (b *TimestampBuilder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *TimestampBuilder) AppendValues(v []arrow.Timestamp, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -1459,6 +1838,24 @@ func (b *TimestampBuilder) AppendValues(v []arrow.Timestamp, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *TimestampBuilder) AppendArraySlice(src *Timestamp, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.TimestampTraits.Copy(b.rawData[b.length:], src.TimestampValues()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *TimestampBuilder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1532,6 +1929,10 @@ type Time32Builder struct {
 	rawData []arrow.Time32
 }
 
+// NewTime32Builder returns a builder for Time32 arrays of type dtype.
+//
+// A unit of dtype which is not valid for a Time32 is reported when the array
+// is built, as an error returned by NewArrayChecked. See arrow.NewTime32Type.
 func NewTime32Builder(mem memory.Allocator, dtype *arrow.Time32Type) *Time32Builder {
 	return &Time32Builder{builder: builder{refCount: 1, mem: mem}, dtype: dtype}
 }
@@ -1564,12 +1965,29 @@ func (b *Time32Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Time32Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Time32Builder) AppendEmptyValue() {
+	var v arrow.Time32
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Time32Builder) UnsafeAppend(v arrow.Time32) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Time32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -1584,7 +2002,8 @@ func (b *Time32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Time32Builder) AppendValues(v []arrow.Time32, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -1596,6 +2015,24 @@ func (b *Time32Builder) AppendValues(v []arrow.Time32, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Time32Builder) AppendArraySlice(src *Time32, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Time32Traits.Copy(b.rawData[b.length:], src.Time32Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Time32Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1637,12 +2074,22 @@ func (b *Time32Builder) NewArray() Interface {
 // NewTime32Array creates a Time32 array from the memory buffers used by the builder and resets the Time32Builder
 // so it can be used to build a new array.
 func (b *Time32Builder) NewTime32Array() (a *Time32) {
+	if err := checkTimeUnit(b.dtype); err != nil {
+		b.invalid(b, "NewArray", err)
+	}
 	data := b.newData()
 	a = NewTime32Data(data)
 	data.Release()
 	return
 }
 
+func (b *Time32Builder) checkNewArray() error {
+	if err := checkTimeUnit(b.dtype); err != nil {
+		return fmt.Errorf("arrow/array: %T.NewArray: %v", b, err)
+	}
+	return nil
+}
+
 func (b *Time32Builder) newData() (data *Data) {
 	bytesRequired := arrow.Time32Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
@@ -1669,6 +2116,10 @@ type Time64Builder struct {
 	rawData []arrow.Time64
 }
 
+// NewTime64Builder returns a builder for Time64 arrays of type dtype.
+//
+// A unit of dtype which is not valid for a Time64 is reported when the array
+// is built, as an error returned by NewArrayChecked. See arrow.NewTime64Type.
 func NewTime64Builder(mem memory.Allocator, dtype *arrow.Time64Type) *Time64Builder {
 	return &Time64Builder{builder: builder{refCount: 1, mem: mem}, dtype: dtype}
 }
@@ -1701,12 +2152,29 @@ func (b *Time64Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Time64Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Time64Builder) AppendEmptyValue() {
+	var v arrow.Time64
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Time64Builder) UnsafeAppend(v arrow.Time64) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Time64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -1721,7 +2189,8 @@ func (b *Time64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Time64Builder) AppendValues(v []arrow.Time64, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -1733,6 +2202,24 @@ func (b *Time64Builder) AppendValues(v []arrow.Time64, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Time64Builder) AppendArraySlice(src *Time64, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Time64Traits.Copy(b.rawData[b.length:], src.Time64Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Time64Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1774,12 +2261,22 @@ func (b *Time64Builder) NewArray() Interface {
 // NewTime64Array creates a Time64 array from the memory buffers used by the builder and resets the Time64Builder
 // so it can be used to build a new array.
 func (b *Time64Builder) NewTime64Array() (a *Time64) {
+	if err := checkTimeUnit(b.dtype); err != nil {
+		b.invalid(b, "NewArray", err)
+	}
 	data := b.newData()
 	a = NewTime64Data(data)
 	data.Release()
 	return
 }
 
+func (b *Time64Builder) checkNewArray() error {
+	if err := checkTimeUnit(b.dtype); err != nil {
+		return fmt.Errorf("arrow/array: %T.NewArray: %v", b, err)
+	}
+	return nil
+}
+
 func (b *Time64Builder) newData() (data *Data) {
 	bytesRequired := arrow.Time64Traits.BytesRequired(b.length)
 	if bytesRequired > 0 && bytesRequired < b.data.Len() {
@@ -1837,12 +2334,29 @@ func (b *Date32Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Date32Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Date32Builder) AppendEmptyValue() {
+	var v arrow.Date32
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Date32Builder) UnsafeAppend(v arrow.Date32) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Date32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -1857,7 +2371,8 @@ func (b *Date32Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Date32Builder) AppendValues(v []arrow.Date32, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -1869,6 +2384,24 @@ func (b *Date32Builder) AppendValues(v []arrow.Date32, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Date32Builder) AppendArraySlice(src *Date32, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Date32Traits.Copy(b.rawData[b.length:], src.Date32Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Date32Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -1973,12 +2506,29 @@ func (b *Date64Builder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *Date64Builder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *Date64Builder) AppendEmptyValue() {
+	var v arrow.Date64
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *Date64Builder) UnsafeAppend(v arrow.Date64) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *Date64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -1993,7 +2543,8 @@ func (b *Date64Builder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *Date64Builder) AppendValues(v []arrow.Date64, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -2005,6 +2556,24 @@ func (b *Date64Builder) AppendValues(v []arrow.Date64, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *Date64Builder) AppendArraySlice(src *Date64, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.Date64Traits.Copy(b.rawData[b.length:], src.Date64Values()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *Date64Builder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -2110,12 +2679,29 @@ func (b *DurationBuilder) AppendNull() {
 	b.UnsafeAppendBoolToBitmap(false)
 }
 
+func (b *DurationBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+}
+
+func (b *DurationBuilder) AppendEmptyValue() {
+	var v arrow.Duration
+	b.Append(v)
+}
+
+// UnsafeAppend appends v as a valid value without checking the capacity of the builder.
+// Reserve must have been called beforehand with room for v: appending past the
+// reserved capacity is undefined behavior.
 func (b *DurationBuilder) UnsafeAppend(v arrow.Duration) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.rawData[b.length] = v
 	b.length++
 }
 
+// UnsafeAppendBoolToBitmap appends a valid or null slot without checking the capacity
+// of the builder. The value data of the slot is left untouched.
+// Reserve must have been called beforehand with room for the slot: appending past the
+// reserved capacity is undefined behavior.
 func (b *DurationBuilder) UnsafeAppendBoolToBitmap(isValid bool) {
 	if isValid {
 		bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
@@ -2130,7 +2716,8 @@ func (b *DurationBuilder) UnsafeAppendBoolToBitmap(isValid bool) {
 // all values in v are appended and considered valid.
 func (b *DurationBuilder) AppendValues(v []arrow.Duration, valid []bool) {
 	if len(v) != len(valid) && len(valid) != 0 {
-		panic("len(v) != len(valid) && len(valid) != 0")
+		b.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
 	}
 
 	if len(v) == 0 {
@@ -2142,6 +2729,24 @@ func (b *DurationBuilder) AppendValues(v []arrow.Duration, valid []bool) {
 	b.builder.unsafeAppendBoolsToBitmap(valid, len(v))
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The values and the validity bitmap are copied
+// in bulk.
+func (b *DurationBuilder) AppendArraySlice(src *Duration, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	b.Reserve(length)
+	arrow.DurationTraits.Copy(b.rawData[b.length:], src.DurationValues()[start:start+length])
+	b.builder.unsafeAppendBitmap(src.NullBitmapBytes(), src.Offset()+start, length)
+}
+
 func (b *DurationBuilder) init(capacity int) {
 	b.builder.init(capacity)
 
@@ -2031,7 +2031,7 @@ func TestNewTime64Builder(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
 
-	dtype := &arrow.Time64Type{Unit: arrow.Second}
+	dtype := &arrow.Time64Type{Unit: arrow.Microsecond}
 	ab := array.NewTime64Builder(mem, dtype)
 	defer ab.Release()
 
@@ -2124,7 +2124,7 @@ func TestTime64Builder_AppendValues(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
 
-	dtype := &arrow.Time64Type{Unit: arrow.Second}
+	dtype := &arrow.Time64Type{Unit: arrow.Microsecond}
 	ab := array.NewTime64Builder(mem, dtype)
 	defer ab.Release()
 
@@ -2140,7 +2140,7 @@ func TestTime64Builder_Empty(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
 
-	dtype := &arrow.Time64Type{Unit: arrow.Second}
+	dtype := &arrow.Time64Type{Unit: arrow.Microsecond}
 	ab := array.NewTime64Builder(mem, dtype)
 	defer ab.Release()
 
@@ -2173,7 +2173,7 @@ func TestTime64Builder_Resize(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
 
-	dtype := &arrow.Time64Type{Unit: arrow.Second}
+	dtype := &arrow.Time64Type{Unit: arrow.Microsecond}
 	ab := array.NewTime64Builder(mem, dtype)
 	defer ab.Release()
 
@@ -0,0 +1,128 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// IsNull returns a boolean array holding, for each element of arr, whether
+// it is null. The returned array has no nulls.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+func IsNull(arr Interface) *Boolean {
+	return validityMask(arr, false)
+}
+
+// IsValid returns a boolean array holding, for each element of arr, whether
+// it is valid, i.e. not null. The returned array has no nulls.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+func IsValid(arr Interface) *Boolean {
+	return validityMask(arr, true)
+}
+
+// validityMask returns a boolean array whose elements are set where the
+// validity of the elements of arr equals valid.
+func validityMask(arr Interface, valid bool) *Boolean {
+	var (
+		n      = arr.Len()
+		bitmap = arr.NullBitmapBytes()
+		offset = arr.Data().offset
+	)
+
+	buf := memory.NewResizableBuffer(memory.DefaultAllocator)
+	defer buf.Release()
+	buf.Resize(int(bitutil.BytesForBits(int64(n))))
+
+	out := buf.Bytes()
+	switch {
+	case len(bitmap) == 0:
+		// without bitmap, all elements are valid, except for null arrays.
+		allValid := arr.NullN() != n
+		bitutil.SetBitsTo(out, 0, int64(n), allValid == valid)
+	default:
+		for i := 0; i < n; i++ {
+			if bitutil.BitIsSet(bitmap, offset+i) == valid {
+				bitutil.SetBit(out, i)
+			}
+		}
+	}
+
+	return NewBoolean(n, buf, nil, 0)
+}
+
+// IsNaN returns a boolean array holding, for each non-null element of arr,
+// whether it is NaN. Null elements yield null elements.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+//
+// IsNaN supports the numeric primitive arrays, integers never being NaN.
+// IsNaN panics for other arrays.
+func IsNaN(arr Interface) *Boolean {
+	return floatMask(arr, "IsNaN", math.IsNaN, false)
+}
+
+// IsFinite returns a boolean array holding, for each non-null element of arr,
+// whether it is neither infinite nor NaN. Null elements yield null elements.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+//
+// IsFinite supports the numeric primitive arrays, integers always being
+// finite. IsFinite panics for other arrays.
+func IsFinite(arr Interface) *Boolean {
+	return floatMask(arr, "IsFinite", func(v float64) bool {
+		return !math.IsInf(v, 0) && !math.IsNaN(v)
+	}, true)
+}
+
+// floatMask applies pred to each non-null floating-point element of arr.
+func floatMask(arr Interface, op string, pred func(float64) bool, ints bool) *Boolean {
+	var get func(i int) float64
+	switch arr := arr.(type) {
+	case *Float16:
+		get = func(i int) float64 { return float64(arr.Value(i).Float32()) }
+	case *Float32:
+		get = func(i int) float64 { return float64(arr.Value(i)) }
+	case *Float64:
+		get = arr.Value
+	case *Int8, *Int16, *Int32, *Int64, *Uint8, *Uint16, *Uint32, *Uint64:
+		// integer elements yield ints.
+	default:
+		panic(fmt.Errorf("arrow/array: %s: unsupported data type %v", op, arr.DataType()))
+	}
+
+	bldr := NewBooleanBuilder(memory.DefaultAllocator)
+	defer bldr.Release()
+
+	bldr.Reserve(arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		switch {
+		case arr.IsNull(i):
+			bldr.UnsafeAppendBoolToBitmap(false)
+		case get == nil:
+			bldr.UnsafeAppend(ints)
+		default:
+			bldr.UnsafeAppend(pred(get(i)))
+		}
+	}
+	return bldr.NewBooleanArray()
+}
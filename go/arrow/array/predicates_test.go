@@ -0,0 +1,164 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestIsNullIsValid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewInt32Builder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, []bool{true, false, true, true, false, false, true, true, true, false})
+
+	arr := bldr.NewInt32Array()
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 3, 10)
+	defer slice.Release()
+
+	bldr.AppendValues([]int32{1, 2}, nil)
+	dense := bldr.NewInt32Array()
+	defer dense.Release()
+
+	nulls := array.NewNull(3)
+	defer nulls.Release()
+
+	for _, tc := range []struct {
+		name    string
+		arr     array.Interface
+		isNull  string
+		isValid string
+	}{
+		{
+			name:    "nulls",
+			arr:     arr,
+			isNull:  "[false true false false true true false false false true]",
+			isValid: "[true false true true false false true true true false]",
+		},
+		{
+			name:    "slice",
+			arr:     slice,
+			isNull:  "[false true true false false false true]",
+			isValid: "[true false false true true true false]",
+		},
+		{
+			name:    "no-nulls",
+			arr:     dense,
+			isNull:  "[false false]",
+			isValid: "[true true]",
+		},
+		{
+			name:    "null-type",
+			arr:     nulls,
+			isNull:  "[true true true]",
+			isValid: "[false false false]",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			isNull := array.IsNull(tc.arr)
+			defer isNull.Release()
+			isValid := array.IsValid(tc.arr)
+			defer isValid.Release()
+
+			if got, want := isNull.String(), tc.isNull; got != want {
+				t.Fatalf("invalid IsNull:\ngot= %s\nwant=%s", got, want)
+			}
+			if got, want := isValid.String(), tc.isValid; got != want {
+				t.Fatalf("invalid IsValid:\ngot= %s\nwant=%s", got, want)
+			}
+			if isNull.NullN() != 0 || isValid.NullN() != 0 {
+				t.Fatalf("validity masks should not hold nulls")
+			}
+		})
+	}
+}
+
+func TestIsNaNIsFinite(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	fb := array.NewFloat64Builder(mem)
+	defer fb.Release()
+	fb.AppendValues(
+		[]float64{1, math.NaN(), math.Inf(1), math.Inf(-1), 0, math.NaN()},
+		[]bool{true, true, true, true, false, true},
+	)
+
+	floats := fb.NewFloat64Array()
+	defer floats.Release()
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int64{1, 2}, []bool{true, false})
+
+	ints := ib.NewInt64Array()
+	defer ints.Release()
+
+	for _, tc := range []struct {
+		name     string
+		arr      array.Interface
+		isNaN    string
+		isFinite string
+	}{
+		{
+			name:     "float64",
+			arr:      floats,
+			isNaN:    "[false true false false (null) true]",
+			isFinite: "[true false false false (null) false]",
+		},
+		{
+			name:     "int64",
+			arr:      ints,
+			isNaN:    "[false (null)]",
+			isFinite: "[true (null)]",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			isNaN := array.IsNaN(tc.arr)
+			defer isNaN.Release()
+			isFinite := array.IsFinite(tc.arr)
+			defer isFinite.Release()
+
+			if got, want := isNaN.String(), tc.isNaN; got != want {
+				t.Fatalf("invalid IsNaN:\ngot= %s\nwant=%s", got, want)
+			}
+			if got, want := isFinite.String(), tc.isFinite; got != want {
+				t.Fatalf("invalid IsFinite:\ngot= %s\nwant=%s", got, want)
+			}
+		})
+	}
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	strs := sb.NewStringArray()
+	defer strs.Release()
+
+	defer func() {
+		if e := recover(); e == nil {
+			t.Fatalf("expected a panic")
+		}
+	}()
+	array.IsNaN(strs)
+}
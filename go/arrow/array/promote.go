@@ -0,0 +1,72 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Promote returns arr converted to the data type to, which must be the
+// promoted type of arr's data type as returned by arrow.PromoteTypes.
+// If arr already has the data type to, Promote returns arr retained.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+//
+// Promote returns an error if to is not a promotion of arr's data type, or
+// if an element of arr cannot be represented in to, such as a uint64
+// element above math.MaxInt64 promoted to int64.
+func Promote(arr Interface, to arrow.DataType) (Interface, error) {
+	dtype, err := arrow.PromoteTypes(arr.DataType(), to)
+	switch {
+	case err != nil:
+		return nil, err
+	case !arrow.TypeEquals(dtype, to):
+		return nil, fmt.Errorf("arrow/array: cannot promote %v to %v", arr.DataType(), to)
+	case arrow.TypeEquals(arr.DataType(), to):
+		arr.Retain()
+		return arr, nil
+	}
+
+	bldr := NewBuilder(memory.DefaultAllocator, to)
+	defer bldr.Release()
+
+	bldr.Reserve(arr.Len())
+	if arr.DataType().ID() == arrow.NULL {
+		for i := 0; i < arr.Len(); i++ {
+			bldr.AppendNull()
+		}
+		return bldr.NewArray(), nil
+	}
+
+	get, ok := goValueFunc(arr)
+	if !ok {
+		return nil, fmt.Errorf("arrow/array: promotion not supported for %v arrays", arr.DataType())
+	}
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+		if err := appendGoValue(bldr, get(i)); err != nil {
+			return nil, fmt.Errorf("arrow/array: element %d: %v", i, err)
+		}
+	}
+	return bldr.NewArray(), nil
+}
@@ -0,0 +1,95 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestPromote(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int32{-1, 0, 3}, []bool{true, false, true})
+
+	ints := ib.NewInt32Array()
+	defer ints.Release()
+
+	ub := array.NewUint64Builder(mem)
+	defer ub.Release()
+	ub.AppendValues([]uint64{1, math.MaxUint64}, nil)
+
+	uints := ub.NewUint64Array()
+	defer uints.Release()
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.Append("a")
+
+	strs := sb.NewStringArray()
+	defer strs.Release()
+
+	nulls := array.NewNull(2)
+	defer nulls.Release()
+
+	for _, tc := range []struct {
+		name string
+		arr  array.Interface
+		to   arrow.DataType
+		want string
+		err  bool
+	}{
+		{name: "int32-int64", arr: ints, to: arrow.PrimitiveTypes.Int64, want: "[-1 (null) 3]"},
+		{name: "int32-float64", arr: ints, to: arrow.PrimitiveTypes.Float64, want: "[-1 (null) 3]"},
+		{name: "int32-int32", arr: ints, to: arrow.PrimitiveTypes.Int32, want: "[-1 (null) 3]"},
+		{name: "null-string", arr: nulls, to: arrow.BinaryTypes.String, want: `[(null) (null)]`},
+		{name: "uint64-float64", arr: uints, to: arrow.PrimitiveTypes.Float64, want: "[1 1.8446744073709552e+19]"},
+		{name: "uint64-int64", arr: uints, to: arrow.PrimitiveTypes.Int64, err: true},
+		{name: "int32-int16", arr: ints, to: arrow.PrimitiveTypes.Int16, err: true},
+		{name: "string-int64", arr: strs, to: arrow.PrimitiveTypes.Int64, err: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := array.Promote(tc.arr, tc.to)
+			if tc.err {
+				if err == nil {
+					out.Release()
+					t.Fatalf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer out.Release()
+
+			if got, want := out.DataType(), tc.to; !arrow.TypeEquals(got, want) {
+				t.Fatalf("invalid data type: got=%v, want=%v", got, want)
+			}
+			if got, want := fmt.Sprintf("%v", out), tc.want; got != want {
+				t.Fatalf("invalid result: got=%s, want=%s", got, want)
+			}
+		})
+	}
+}
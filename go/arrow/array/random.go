@@ -0,0 +1,238 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/decimal256"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// RandomOption configures RandomRecord.
+type RandomOption func(*randomConfig)
+
+type randomConfig struct {
+	nullProb float64
+	maxLen   int
+}
+
+// WithRandomNullProbability configures the probability for an element of a
+// nullable field, or of a list, to be null. The default is 0.1.
+//
+// WithRandomNullProbability panics if p is not in the [0, 1] range.
+func WithRandomNullProbability(p float64) RandomOption {
+	if !(p >= 0 && p <= 1) {
+		panic(fmt.Errorf("arrow/array: invalid null probability %v", p))
+	}
+	return func(cfg *randomConfig) {
+		cfg.nullProb = p
+	}
+}
+
+// WithRandomMaxLength configures the maximum number of elements of the
+// values of list fields, and of bytes of the values of string and binary
+// fields. The default is 8.
+//
+// WithRandomMaxLength panics if n is negative.
+func WithRandomMaxLength(n int) RandomOption {
+	if n < 0 {
+		panic(fmt.Errorf("arrow/array: invalid maximum length %d", n))
+	}
+	return func(cfg *randomConfig) {
+		cfg.maxLen = n
+	}
+}
+
+// RandomRecord returns a record of nrows rows holding pseudo-random values
+// of the types of the schema fields, recursing into list and struct fields.
+// Records generated with the same schema, number of rows, seed and options
+// hold the same values.
+//
+// Only the nullable fields, and the elements of lists, hold null values.
+// Temporal values are generated within a century after the epoch, and
+// decimal values within the precision of their data type.
+//
+// RandomRecord is meant to generate test and benchmark data. It panics if
+// the schema holds a data type it does not support, such as dictionary or
+// run-end encoded types.
+//
+// The returned record must be Release()'d after use.
+func RandomRecord(schema *arrow.Schema, nrows int, seed int64, pool memory.Allocator, opts ...RandomOption) Record {
+	cfg := randomConfig{nullProb: 0.1, maxLen: 8}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	b := NewRecordBuilder(pool, schema)
+	defer b.Release()
+
+	g := &randomGen{cfg: cfg, rng: rand.New(rand.NewSource(seed))}
+	for i, field := range schema.Fields() {
+		fb := b.Field(i)
+		fb.Reserve(nrows)
+		for j := 0; j < nrows; j++ {
+			g.append(fb, field.Nullable)
+		}
+	}
+	return b.NewRecord()
+}
+
+// randomGen appends pseudo-random values to builders.
+type randomGen struct {
+	cfg randomConfig
+	rng *rand.Rand
+}
+
+const (
+	randomDays = 100 * 365 // range of temporal values, in days.
+	dayNanos   = 86400 * 1e9
+)
+
+// append appends a pseudo-random value to b, or a null value if nullable.
+func (g *randomGen) append(b Builder, nullable bool) {
+	if nullable && g.cfg.nullProb > 0 && g.rng.Float64() < g.cfg.nullProb {
+		b.AppendNull()
+		return
+	}
+
+	r := g.rng
+	switch b := b.(type) {
+	case *NullBuilder:
+		b.AppendNull()
+	case *BooleanBuilder:
+		b.Append(r.Intn(2) == 1)
+	case *Int8Builder:
+		b.Append(int8(r.Uint64()))
+	case *Int16Builder:
+		b.Append(int16(r.Uint64()))
+	case *Int32Builder:
+		b.Append(int32(r.Uint64()))
+	case *Int64Builder:
+		b.Append(int64(r.Uint64()))
+	case *Uint8Builder:
+		b.Append(uint8(r.Uint64()))
+	case *Uint16Builder:
+		b.Append(uint16(r.Uint64()))
+	case *Uint32Builder:
+		b.Append(uint32(r.Uint64()))
+	case *Uint64Builder:
+		b.Append(r.Uint64())
+	case *Float16Builder:
+		b.Append(float16.New(float32(g.float())))
+	case *Float32Builder:
+		b.Append(float32(g.float()))
+	case *Float64Builder:
+		b.Append(g.float())
+	case *Date32Builder:
+		b.Append(arrow.Date32(r.Int63n(randomDays)))
+	case *Date64Builder:
+		b.Append(arrow.Date64(r.Int63n(randomDays) * 86400 * 1000))
+	case *Time32Builder:
+		b.Append(arrow.Time32(r.Int63n(dayNanos / unitNanoseconds(b.dtype.Unit))))
+	case *Time64Builder:
+		b.Append(arrow.Time64(r.Int63n(dayNanos / unitNanoseconds(b.dtype.Unit))))
+	case *TimestampBuilder:
+		b.Append(arrow.Timestamp(r.Int63n(randomDays * (dayNanos / unitNanoseconds(b.dtype.Unit)))))
+	case *DurationBuilder:
+		b.Append(arrow.Duration(r.Int63n(dayNanos/unitNanoseconds(b.dtype.Unit)) - dayNanos/unitNanoseconds(b.dtype.Unit)/2))
+	case *MonthIntervalBuilder:
+		b.Append(arrow.MonthInterval(r.Intn(2400) - 1200))
+	case *DayTimeIntervalBuilder:
+		b.Append(arrow.DayTimeInterval{Days: int32(r.Intn(randomDays)), Milliseconds: int32(r.Intn(86400 * 1000))})
+	case *Decimal128Builder:
+		v, err := decimal128.FromBigInt(g.decimal(b.dtype.Precision))
+		if err != nil {
+			panic(err)
+		}
+		b.Append(v)
+	case *Decimal256Builder:
+		v, err := decimal256.FromBigInt(g.decimal(b.dtype.Precision))
+		if err != nil {
+			panic(err)
+		}
+		b.Append(v)
+	case *StringBuilder:
+		b.Append(g.string())
+	case *LargeStringBuilder:
+		b.Append(g.string())
+	case *BinaryBuilder:
+		b.Append(g.bytes(r.Intn(g.cfg.maxLen + 1)))
+	case *FixedSizeBinaryBuilder:
+		b.Append(g.bytes(b.dtype.ByteWidth))
+	case *ListBuilder:
+		b.Append(true)
+		g.appendN(b.ValueBuilder(), r.Intn(g.cfg.maxLen+1))
+	case *LargeListBuilder:
+		b.Append(true)
+		g.appendN(b.ValueBuilder(), r.Intn(g.cfg.maxLen+1))
+	case *FixedSizeListBuilder:
+		b.Append(true)
+		g.appendN(b.ValueBuilder(), int(b.n))
+	case *StructBuilder:
+		b.Append(true)
+		for i, field := range b.dtype.(*arrow.StructType).Fields() {
+			g.append(b.FieldBuilder(i), field.Nullable)
+		}
+	default:
+		panic(fmt.Errorf("arrow/array: unsupported random builder type %T", b))
+	}
+}
+
+// appendN appends n pseudo-random, possibly null, values to b.
+func (g *randomGen) appendN(b Builder, n int) {
+	for i := 0; i < n; i++ {
+		g.append(b, true)
+	}
+}
+
+// decimal returns a pseudo-random integer of at most precision digits.
+func (g *randomGen) decimal(precision int32) *big.Int {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	v := new(big.Int).Rand(g.rng, max)
+	if g.rng.Intn(2) == 1 {
+		v.Neg(v)
+	}
+	return v
+}
+
+// float returns a pseudo-random floating-point value in [-1000, 1000).
+func (g *randomGen) float() float64 {
+	return math.Round((g.rng.Float64()*2000-1000)*1000) / 1000
+}
+
+// bytes returns n pseudo-random bytes.
+func (g *randomGen) bytes(n int) []byte {
+	v := make([]byte, n)
+	g.rng.Read(v)
+	return v
+}
+
+// string returns a pseudo-random string of lowercase ASCII letters.
+func (g *randomGen) string() string {
+	v := make([]byte, g.rng.Intn(g.cfg.maxLen+1))
+	for i := range v {
+		v[i] = 'a' + byte(g.rng.Intn(26))
+	}
+	return string(v)
+}
@@ -0,0 +1,122 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestRandomRecord(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "bool", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+		{Name: "i8", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+		{Name: "u64", Type: arrow.PrimitiveTypes.Uint64},
+		{Name: "f16", Type: arrow.FixedWidthTypes.Float16, Nullable: true},
+		{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		{Name: "date32", Type: arrow.PrimitiveTypes.Date32, Nullable: true},
+		{Name: "time64", Type: arrow.FixedWidthTypes.Time64ns, Nullable: true},
+		{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
+		{Name: "dur", Type: arrow.FixedWidthTypes.Duration_s, Nullable: true},
+		{Name: "ival", Type: arrow.FixedWidthTypes.DayTimeInterval, Nullable: true},
+		{Name: "dec128", Type: &arrow.Decimal128Type{Precision: 5, Scale: 2}, Nullable: true},
+		{Name: "dec256", Type: &arrow.Decimal256Type{Precision: 40, Scale: 10}, Nullable: true},
+		{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "bin", Type: arrow.BinaryTypes.LargeBinary, Nullable: true},
+		{Name: "fsb", Type: &arrow.FixedSizeBinaryType{ByteWidth: 3}, Nullable: true},
+		{Name: "list", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+		{Name: "fsl", Type: arrow.FixedSizeListOf(2, arrow.BinaryTypes.String), Nullable: true},
+		{Name: "struct", Type: arrow.StructOf(
+			arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int64},
+			arrow.Field{Name: "b", Type: arrow.LargeListOf(arrow.PrimitiveTypes.Float32), Nullable: true},
+		), Nullable: true},
+	}, nil)
+
+	const nrows = 200
+
+	rec1 := array.RandomRecord(schema, nrows, 42, mem)
+	defer rec1.Release()
+	rec2 := array.RandomRecord(schema, nrows, 42, mem)
+	defer rec2.Release()
+	rec3 := array.RandomRecord(schema, nrows, 43, mem)
+	defer rec3.Release()
+
+	if !array.RecordEqual(rec1, rec2) {
+		t.Fatalf("records with the same seed should be equal")
+	}
+	if array.RecordEqual(rec1, rec3) {
+		t.Fatalf("records with different seeds should differ")
+	}
+
+	if got, want := rec1.NumRows(), int64(nrows); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+	for i, col := range rec1.Columns() {
+		field := schema.Field(i)
+		if err := col.ValidateFull(); err != nil {
+			t.Fatalf("column %q: invalid array: %v", field.Name, err)
+		}
+		switch {
+		case field.Nullable && col.NullN() == 0:
+			t.Fatalf("column %q: expected null values", field.Name)
+		case !field.Nullable && col.NullN() != 0:
+			t.Fatalf("column %q: unexpected null values", field.Name)
+		}
+	}
+
+	rec4 := array.RandomRecord(schema, nrows, 42, mem, array.WithRandomNullProbability(0))
+	defer rec4.Release()
+	for i, col := range rec4.Columns() {
+		if col.NullN() != 0 {
+			t.Fatalf("column %q: unexpected null values", schema.Field(i).Name)
+		}
+	}
+
+	// decimal values are within the precision of their data type.
+	for _, tc := range []struct {
+		name string
+		prec int64
+	}{
+		{"dec128", 5},
+		{"dec256", 40},
+	} {
+		max := new(big.Int).Exp(big.NewInt(10), big.NewInt(tc.prec), nil)
+		col := rec1.Column(schema.FieldIndices(tc.name)[0])
+		for i := 0; i < col.Len(); i++ {
+			if col.IsNull(i) {
+				continue
+			}
+			var v *big.Int
+			switch col := col.(type) {
+			case *array.Decimal128:
+				v = col.Value(i).BigInt()
+			case *array.Decimal256:
+				v = col.Value(i).BigInt()
+			}
+			if new(big.Int).Abs(v).Cmp(max) >= 0 {
+				t.Fatalf("column %q: value %v out of precision %d", tc.name, v, tc.prec)
+			}
+		}
+	}
+}
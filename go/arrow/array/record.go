@@ -27,14 +27,30 @@ import (
 )
 
 // RecordReader reads a stream of records.
+//
+// Records are iterated with Next, and retrieved with Record:
+//
+//  for rr.Next() {
+//      rec := rr.Record()
+//      // use rec, calling rec.Retain to keep it past the next call to Next.
+//  }
+//  if err := rr.Err(); err != nil {
+//      // handle the error which ended the iteration.
+//  }
 type RecordReader interface {
 	Retain()
 	Release()
 
 	Schema() *arrow.Schema
 
+	// Next advances the reader to the next record, and reports whether
+	// there is one. The previous record is released by Next.
 	Next() bool
+	// Record returns the current record, valid until the next call to Next.
 	Record() Record
+	// Err returns the error which ended the iteration, if any.
+	// Err returns nil if the iteration ended with the end of the stream.
+	Err() error
 }
 
 // simpleRecords is a simple iterator over a collection of records.
@@ -94,6 +110,7 @@ func (rs *simpleRecords) Release() {
 
 func (rs *simpleRecords) Schema() *arrow.Schema { return rs.schema }
 func (rs *simpleRecords) Record() Record        { return rs.cur }
+func (rs *simpleRecords) Err() error            { return nil }
 func (rs *simpleRecords) Next() bool {
 	if len(rs.recs) == 0 {
 		return false
@@ -128,6 +145,54 @@ type Record interface {
 	// NewSlice panics if the slice is outside the valid range of the record array.
 	// NewSlice panics if j < i.
 	NewSlice(i, j int64) Record
+
+	// Select returns a new record holding the columns of the record at
+	// the provided indices, in that order.
+	// The returned record shares the columns of the record, its schema holds
+	// the selected fields and the metadata of the record schema.
+	// The returned record must be Release()'d after use.
+	//
+	// Select panics if an index is outside the valid range of the record columns.
+	Select(indices []int) Record
+
+	// SelectByName is like Select but selects the columns by name.
+	// When several columns share the same name, the first one is selected.
+	//
+	// SelectByName returns an error if a name does not match any column.
+	SelectByName(names []string) (Record, error)
+
+	// AddColumn returns a new record with the provided column inserted at
+	// index i, shifting the columns at index i and above.
+	// The returned record shares the other columns of the record.
+	// The returned record must be Release()'d after use.
+	//
+	// AddColumn returns an error if i is not in the [0, NumCols()] range,
+	// or if the column does not match the field type or the number of rows
+	// of the record.
+	AddColumn(i int, field arrow.Field, col Interface) (Record, error)
+
+	// SetColumn is like AddColumn but replaces the column at index i.
+	//
+	// SetColumn returns an error if i is not in the [0, NumCols()) range,
+	// or if the column does not match the field type or the number of rows
+	// of the record.
+	SetColumn(i int, field arrow.Field, col Interface) (Record, error)
+
+	// RemoveColumn returns a new record without the column at index i.
+	// The returned record shares the other columns of the record.
+	// The returned record must be Release()'d after use.
+	//
+	// RemoveColumn returns an error if i is not in the [0, NumCols()) range.
+	RemoveColumn(i int) (Record, error)
+
+	// TotalBytes returns the number of bytes held by the buffers of the
+	// columns of the record, as reported by TotalBytes.
+	// Buffers shared by several columns are only counted once.
+	TotalBytes() int64
+
+	// ColumnStatistics returns the statistics of the columns of the record,
+	// in schema order, as computed by ComputeStatistics.
+	ColumnStatistics() ([]Statistics, error)
 }
 
 // simpleRecord is a basic, non-lazy in-memory record batch.
@@ -224,6 +289,29 @@ func (rec *simpleRecord) Columns() []Interface    { return rec.arrs }
 func (rec *simpleRecord) Column(i int) Interface  { return rec.arrs[i] }
 func (rec *simpleRecord) ColumnName(i int) string { return rec.schema.Field(i).Name }
 
+func (rec *simpleRecord) TotalBytes() int64 {
+	var (
+		n    int64
+		seen = make(map[*memory.Buffer]struct{})
+	)
+	for _, arr := range rec.arrs {
+		n += dataTotalBytes(arr.Data(), seen)
+	}
+	return n
+}
+
+func (rec *simpleRecord) ColumnStatistics() ([]Statistics, error) {
+	stats := make([]Statistics, len(rec.arrs))
+	for i, arr := range rec.arrs {
+		st, err := ComputeStatistics(arr)
+		if err != nil {
+			return nil, fmt.Errorf("arrow/array: column %q: %v", rec.ColumnName(i), err)
+		}
+		stats[i] = st
+	}
+	return stats, nil
+}
+
 // NewSlice constructs a zero-copy slice of the record with the indicated
 // indices i and j, corresponding to array[i:j].
 // The returned record must be Release()'d after use.
@@ -243,6 +331,113 @@ func (rec *simpleRecord) NewSlice(i, j int64) Record {
 	return NewRecord(rec.schema, arrs, j-i)
 }
 
+// Select returns a new record holding the columns of the record at
+// the provided indices, in that order.
+// The returned record must be Release()'d after use.
+//
+// Select panics if an index is outside the valid range of the record columns.
+func (rec *simpleRecord) Select(indices []int) Record {
+	var (
+		fields = make([]arrow.Field, len(indices))
+		arrs   = make([]Interface, len(indices))
+	)
+	for i, idx := range indices {
+		if idx < 0 || idx >= len(rec.arrs) {
+			panic(fmt.Errorf("arrow/array: column index %d out of range [0, %d)", idx, len(rec.arrs)))
+		}
+		fields[i] = rec.schema.Field(idx)
+		arrs[i] = rec.arrs[idx]
+	}
+
+	meta := rec.schema.Metadata()
+	return NewRecord(arrow.NewSchema(fields, &meta), arrs, rec.rows)
+}
+
+// SelectByName returns a new record holding the columns of the record with
+// the provided names, in that order.
+// The returned record must be Release()'d after use.
+//
+// SelectByName returns an error if a name does not match any column.
+func (rec *simpleRecord) SelectByName(names []string) (Record, error) {
+	indices := make([]int, len(names))
+	for i, name := range names {
+		idx := rec.schema.FieldIndex(name)
+		if idx < 0 {
+			return nil, fmt.Errorf("arrow/array: no column named %q", name)
+		}
+		indices[i] = idx
+	}
+	return rec.Select(indices), nil
+}
+
+// AddColumn returns a new record with the provided column inserted at
+// index i, shifting the columns at index i and above.
+// The returned record must be Release()'d after use.
+func (rec *simpleRecord) AddColumn(i int, field arrow.Field, col Interface) (Record, error) {
+	if err := rec.checkColumn(field, col); err != nil {
+		return nil, err
+	}
+	schema, err := rec.schema.AddField(i, field)
+	if err != nil {
+		return nil, err
+	}
+
+	arrs := make([]Interface, 0, len(rec.arrs)+1)
+	arrs = append(arrs, rec.arrs[:i]...)
+	arrs = append(arrs, col)
+	arrs = append(arrs, rec.arrs[i:]...)
+	return NewRecord(schema, arrs, rec.rows), nil
+}
+
+// SetColumn returns a new record with the column at index i replaced by
+// the provided column.
+// The returned record must be Release()'d after use.
+func (rec *simpleRecord) SetColumn(i int, field arrow.Field, col Interface) (Record, error) {
+	if err := rec.checkColumn(field, col); err != nil {
+		return nil, err
+	}
+	schema, err := rec.schema.SetField(i, field)
+	if err != nil {
+		return nil, err
+	}
+
+	arrs := make([]Interface, len(rec.arrs))
+	copy(arrs, rec.arrs)
+	arrs[i] = col
+	return NewRecord(schema, arrs, rec.rows), nil
+}
+
+// RemoveColumn returns a new record without the column at index i.
+// The returned record must be Release()'d after use.
+func (rec *simpleRecord) RemoveColumn(i int) (Record, error) {
+	schema, err := rec.schema.RemoveField(i)
+	if err != nil {
+		return nil, err
+	}
+
+	arrs := make([]Interface, 0, len(rec.arrs)-1)
+	arrs = append(arrs, rec.arrs[:i]...)
+	arrs = append(arrs, rec.arrs[i+1:]...)
+	return NewRecord(schema, arrs, rec.rows), nil
+}
+
+// checkColumn checks that col can be added to the record as the provided field.
+func (rec *simpleRecord) checkColumn(field arrow.Field, col Interface) error {
+	if field.Type != nil && !arrow.TypeEquals(field.Type, col.DataType()) {
+		return fmt.Errorf("arrow/array: column %q type mismatch: got=%v, want=%v",
+			field.Name,
+			col.DataType(), field.Type,
+		)
+	}
+	if int64(col.Len()) != rec.rows {
+		return fmt.Errorf("arrow/array: mismatch number of rows in column %q: got=%d, want=%d",
+			field.Name,
+			col.Len(), rec.rows,
+		)
+	}
+	return nil
+}
+
 func (rec *simpleRecord) String() string {
 	o := new(strings.Builder)
 	fmt.Fprintf(o, "record:\n  %v\n", rec.schema)
@@ -263,8 +458,15 @@ type RecordBuilder struct {
 	fields   []Builder
 }
 
+// NewDefaultRecordBuilder returns a builder, as with NewRecordBuilder, using
+// memory.DefaultAllocator at the time of the call.
+func NewDefaultRecordBuilder(schema *arrow.Schema) *RecordBuilder {
+	return NewRecordBuilder(memory.DefaultAllocator, schema)
+}
+
 // NewRecordBuilder returns a builder, using the provided memory allocator and a schema.
-func NewRecordBuilder(mem memory.Allocator, schema *arrow.Schema) *RecordBuilder {
+// The field builders are configured with opts, as with NewBuilder.
+func NewRecordBuilder(mem memory.Allocator, schema *arrow.Schema, opts ...BuilderOption) *RecordBuilder {
 	b := &RecordBuilder{
 		refCount: 1,
 		mem:      mem,
@@ -273,7 +475,7 @@ func NewRecordBuilder(mem memory.Allocator, schema *arrow.Schema) *RecordBuilder
 	}
 
 	for i, f := range schema.Fields() {
-		b.fields[i] = newBuilder(b.mem, f.Type)
+		b.fields[i] = NewBuilder(b.mem, f.Type, opts...)
 	}
 
 	return b
@@ -286,14 +488,14 @@ func (b *RecordBuilder) Retain() {
 }
 
 // Release decreases the reference count by 1.
+// When the reference count goes to zero, the field builders are released.
 func (b *RecordBuilder) Release() {
 	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
 
-	for _, f := range b.fields {
-		f.Release()
-	}
-
 	if atomic.AddInt64(&b.refCount, -1) == 0 {
+		for _, f := range b.fields {
+			f.Release()
+		}
 		b.fields = nil
 	}
 }
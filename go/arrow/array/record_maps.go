@@ -0,0 +1,328 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/float16"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// RecordFromMaps creates a record from row-oriented values, such as the ones
+// decoded from JSON objects. Each map holds the values of one row, keyed by
+// field name.
+//
+// Missing keys and nil values are appended as nulls, and keys not matching
+// any field are ignored. Go values are coerced to the field types: any Go
+// integer or integral floating-point value can be appended to integer (and
+// temporal) fields, any Go number to floating-point fields, and strings or
+// byte slices to string and binary fields.
+// Struct fields are populated from map[string]interface{} values and list
+// fields from []interface{} values, recursively.
+//
+// The returned record must be Release()'d after use.
+func RecordFromMaps(schema *arrow.Schema, rows []map[string]interface{}, mem memory.Allocator) (Record, error) {
+	b := NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Reserve(len(rows))
+	for i, row := range rows {
+		for j, field := range schema.Fields() {
+			if err := appendGoValue(b.Field(j), row[field.Name]); err != nil {
+				return nil, fmt.Errorf("arrow/array: row %d, column %q: %v", i, field.Name, err)
+			}
+		}
+	}
+
+	return b.NewRecord(), nil
+}
+
+// appendGoValue appends the Go value v to the builder b, coercing it to the
+// builder data type.
+func appendGoValue(b Builder, v interface{}) error {
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+
+	var err error
+	switch b := b.(type) {
+	case *BooleanBuilder:
+		v, ok := v.(bool)
+		if !ok {
+			return errCoerce(v, arrow.FixedWidthTypes.Boolean)
+		}
+		b.Append(v)
+	case *Int8Builder:
+		var i int64
+		if i, err = coerceInt(v, math.MinInt8, math.MaxInt8, arrow.PrimitiveTypes.Int8); err == nil {
+			b.Append(int8(i))
+		}
+	case *Int16Builder:
+		var i int64
+		if i, err = coerceInt(v, math.MinInt16, math.MaxInt16, arrow.PrimitiveTypes.Int16); err == nil {
+			b.Append(int16(i))
+		}
+	case *Int32Builder:
+		var i int64
+		if i, err = coerceInt(v, math.MinInt32, math.MaxInt32, arrow.PrimitiveTypes.Int32); err == nil {
+			b.Append(int32(i))
+		}
+	case *Int64Builder:
+		var i int64
+		if i, err = coerceInt(v, math.MinInt64, math.MaxInt64, arrow.PrimitiveTypes.Int64); err == nil {
+			b.Append(i)
+		}
+	case *Uint8Builder:
+		var u uint64
+		if u, err = coerceUint(v, math.MaxUint8, arrow.PrimitiveTypes.Uint8); err == nil {
+			b.Append(uint8(u))
+		}
+	case *Uint16Builder:
+		var u uint64
+		if u, err = coerceUint(v, math.MaxUint16, arrow.PrimitiveTypes.Uint16); err == nil {
+			b.Append(uint16(u))
+		}
+	case *Uint32Builder:
+		var u uint64
+		if u, err = coerceUint(v, math.MaxUint32, arrow.PrimitiveTypes.Uint32); err == nil {
+			b.Append(uint32(u))
+		}
+	case *Uint64Builder:
+		var u uint64
+		if u, err = coerceUint(v, math.MaxUint64, arrow.PrimitiveTypes.Uint64); err == nil {
+			b.Append(u)
+		}
+	case *Float16Builder:
+		var f float64
+		if f, err = coerceFloat(v, arrow.FixedWidthTypes.Float16); err == nil {
+			b.Append(float16.New(float32(f)))
+		}
+	case *Float32Builder:
+		var f float64
+		if f, err = coerceFloat(v, arrow.PrimitiveTypes.Float32); err == nil {
+			b.Append(float32(f))
+		}
+	case *Float64Builder:
+		var f float64
+		if f, err = coerceFloat(v, arrow.PrimitiveTypes.Float64); err == nil {
+			b.Append(f)
+		}
+	case *Date32Builder:
+		var i int64
+		if i, err = coerceInt(v, math.MinInt32, math.MaxInt32, arrow.FixedWidthTypes.Date32); err == nil {
+			b.Append(arrow.Date32(i))
+		}
+	case *Date64Builder:
+		var i int64
+		if i, err = coerceInt(v, math.MinInt64, math.MaxInt64, arrow.FixedWidthTypes.Date64); err == nil {
+			b.Append(arrow.Date64(i))
+		}
+	case *Time32Builder:
+		var i int64
+		if i, err = coerceInt(v, math.MinInt32, math.MaxInt32, b.dtype); err == nil {
+			b.Append(arrow.Time32(i))
+		}
+	case *Time64Builder:
+		var i int64
+		if i, err = coerceInt(v, math.MinInt64, math.MaxInt64, b.dtype); err == nil {
+			b.Append(arrow.Time64(i))
+		}
+	case *TimestampBuilder:
+		var i int64
+		if i, err = coerceInt(v, math.MinInt64, math.MaxInt64, b.dtype); err == nil {
+			b.Append(arrow.Timestamp(i))
+		}
+	case *DurationBuilder:
+		var i int64
+		if i, err = coerceInt(v, math.MinInt64, math.MaxInt64, b.dtype); err == nil {
+			b.Append(arrow.Duration(i))
+		}
+	case *StringBuilder:
+		switch v := v.(type) {
+		case string:
+			b.Append(v)
+		case []byte:
+			b.Append(string(v))
+		default:
+			return errCoerce(v, arrow.BinaryTypes.String)
+		}
+	case *BinaryBuilder:
+		switch v := v.(type) {
+		case []byte:
+			b.Append(v)
+		case string:
+			b.AppendString(v)
+		default:
+			return errCoerce(v, b.dtype)
+		}
+	case *FixedSizeBinaryBuilder:
+		var raw []byte
+		switch v := v.(type) {
+		case []byte:
+			raw = v
+		case string:
+			raw = []byte(v)
+		default:
+			return errCoerce(v, b.dtype)
+		}
+		if len(raw) != b.dtype.ByteWidth {
+			return fmt.Errorf("invalid length %d for %v value", len(raw), b.dtype)
+		}
+		b.Append(raw)
+	case *ListBuilder:
+		vs, ok := v.([]interface{})
+		if !ok {
+			return errCoerce(v, arrow.ListOf(b.etype))
+		}
+		b.Append(true)
+		for i, v := range vs {
+			if err := appendGoValue(b.values, v); err != nil {
+				return fmt.Errorf("element %d: %v", i, err)
+			}
+		}
+	case *FixedSizeListBuilder:
+		dtype := arrow.FixedSizeListOf(b.n, b.etype)
+		vs, ok := v.([]interface{})
+		if !ok {
+			return errCoerce(v, dtype)
+		}
+		if len(vs) != int(b.n) {
+			return fmt.Errorf("invalid length %d for %v value", len(vs), dtype)
+		}
+		b.Append(true)
+		for i, v := range vs {
+			if err := appendGoValue(b.values, v); err != nil {
+				return fmt.Errorf("element %d: %v", i, err)
+			}
+		}
+	case *StructBuilder:
+		kvs, ok := v.(map[string]interface{})
+		if !ok {
+			return errCoerce(v, b.dtype)
+		}
+		return b.appendStruct(kvs)
+	case *DictionaryBuilder:
+		return b.AppendValue(v)
+	default:
+		return fmt.Errorf("unsupported builder type %T", b)
+	}
+	return err
+}
+
+func errCoerce(v interface{}, dtype arrow.DataType) error {
+	return fmt.Errorf("cannot convert value %v (type %T) to %v", v, v, dtype)
+}
+
+func coerceInt(v interface{}, min, max int64, dtype arrow.DataType) (int64, error) {
+	var i int64
+	switch v := v.(type) {
+	case int:
+		i = int64(v)
+	case int8:
+		i = int64(v)
+	case int16:
+		i = int64(v)
+	case int32:
+		i = int64(v)
+	case int64:
+		i = v
+	case uint:
+		if uint64(v) > math.MaxInt64 {
+			return 0, errCoerce(v, dtype)
+		}
+		i = int64(v)
+	case uint8:
+		i = int64(v)
+	case uint16:
+		i = int64(v)
+	case uint32:
+		i = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, errCoerce(v, dtype)
+		}
+		i = int64(v)
+	case float32:
+		return coerceInt(float64(v), min, max, dtype)
+	case float64:
+		if v != math.Trunc(v) || v < math.MinInt64 || v >= math.MaxInt64 {
+			return 0, errCoerce(v, dtype)
+		}
+		i = int64(v)
+	default:
+		return 0, errCoerce(v, dtype)
+	}
+	if i < min || i > max {
+		return 0, errCoerce(v, dtype)
+	}
+	return i, nil
+}
+
+func coerceUint(v interface{}, max uint64, dtype arrow.DataType) (uint64, error) {
+	var u uint64
+	switch v := v.(type) {
+	case uint:
+		u = uint64(v)
+	case uint8:
+		u = uint64(v)
+	case uint16:
+		u = uint64(v)
+	case uint32:
+		u = uint64(v)
+	case uint64:
+		u = v
+	case float32:
+		return coerceUint(float64(v), max, dtype)
+	case float64:
+		if v != math.Trunc(v) || v < 0 || v >= math.MaxUint64 {
+			return 0, errCoerce(v, dtype)
+		}
+		u = uint64(v)
+	default:
+		i, err := coerceInt(v, 0, math.MaxInt64, dtype)
+		if err != nil {
+			return 0, err
+		}
+		u = uint64(i)
+	}
+	if u > max {
+		return 0, errCoerce(v, dtype)
+	}
+	return u, nil
+}
+
+func coerceFloat(v interface{}, dtype arrow.DataType) (float64, error) {
+	switch v := v.(type) {
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		i, err := coerceInt(v, math.MinInt64, math.MaxInt64, dtype)
+		if err != nil {
+			u, err := coerceUint(v, math.MaxUint64, dtype)
+			return float64(u), err
+		}
+		return float64(i), nil
+	default:
+		return 0, errCoerce(v, dtype)
+	}
+}
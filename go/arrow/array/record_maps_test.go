@@ -0,0 +1,136 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestRecordFromMaps(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "id", Type: arrow.PrimitiveTypes.Int32},
+			{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "score", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "tags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+			{Name: "pos", Type: arrow.StructOf(
+				arrow.Field{Name: "x", Type: arrow.PrimitiveTypes.Int64},
+				arrow.Field{Name: "y", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			), Nullable: true},
+		},
+		nil,
+	)
+
+	rows := []map[string]interface{}{
+		{
+			"id": 1, "name": "alice", "score": 1.5,
+			"tags": []interface{}{"a", "b"},
+			"pos":  map[string]interface{}{"x": 1, "y": 2},
+		},
+		{
+			// values as decoded by encoding/json, with missing keys.
+			"id": float64(2), "score": float64(3),
+			"pos": map[string]interface{}{"x": float64(10)},
+		},
+		{
+			"id": int64(3), "name": nil, "score": nil,
+			"tags": []interface{}{},
+		},
+	}
+
+	rec, err := array.RecordFromMaps(schema, rows, mem)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer rec.Release()
+
+	if got, want := rec.NumRows(), int64(3); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+
+	for i, want := range []string{
+		`[1 2 3]`,
+		`["alice" (null) (null)]`,
+		`[1.5 3 (null)]`,
+		`[["a" "b"] (null) []]`,
+		`[{1 2} {10 (null)} (null)]`,
+	} {
+		if got := array.NewStringer(rec.Column(i)).String(); got != want {
+			t.Fatalf("invalid column %q:\ngot= %s\nwant=%s", rec.ColumnName(i), got, want)
+		}
+	}
+}
+
+func TestRecordFromMapsInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i8", Type: arrow.PrimitiveTypes.Int8},
+			{Name: "pos", Type: arrow.StructOf(
+				arrow.Field{Name: "x", Type: arrow.PrimitiveTypes.Uint32},
+			)},
+		},
+		nil,
+	)
+
+	for _, tc := range []struct {
+		name string
+		rows []map[string]interface{}
+		err  string
+	}{
+		{
+			name: "overflow",
+			rows: []map[string]interface{}{{"i8": 1}, {"i8": 300}},
+			err:  `arrow/array: row 1, column "i8": cannot convert value 300 (type int) to int8`,
+		},
+		{
+			name: "fractional",
+			rows: []map[string]interface{}{{"i8": 1.5}},
+			err:  `arrow/array: row 0, column "i8": cannot convert value 1.5 (type float64) to int8`,
+		},
+		{
+			name: "string",
+			rows: []map[string]interface{}{{"i8": "1"}},
+			err:  `arrow/array: row 0, column "i8": cannot convert value 1 (type string) to int8`,
+		},
+		{
+			name: "nested",
+			rows: []map[string]interface{}{{"pos": map[string]interface{}{"x": -1}}},
+			err:  `arrow/array: row 0, column "pos": field "x": cannot convert value -1 (type int) to uint32`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rec, err := array.RecordFromMaps(schema, tc.rows, mem)
+			if err == nil {
+				rec.Release()
+				t.Fatalf("expected an error")
+			}
+			if got, want := err.Error(), tc.err; got != want {
+				t.Fatalf("invalid error:\ngot= %s\nwant=%s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// PartitionRecord splits rec into one record per distinct element of the
+// column at index col, holding the rows of rec with that key, in order.
+// Null keys form their own partition.
+//
+// PartitionRecord returns the distinct keys in first-seen order, and the
+// partitions in the same order. The returned array and records are allocated
+// with memory.DefaultAllocator and must be released after use.
+//
+// The key column must be supported by HashToGroups, and the other columns
+// by Take. See HashToGroups for the options.
+func PartitionRecord(rec Record, col int, opts ...HashOption) (keys Interface, partitions []Record, err error) {
+	ids, firsts, err := hashGroups(rec.Column(col), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([][]int, len(firsts))
+	for i, id := range ids {
+		rows[id] = append(rows[id], i)
+	}
+
+	keys, err = Take(rec.Column(col), firsts, memory.DefaultAllocator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	partitions, err = takeRecords(rec, rows)
+	if err != nil {
+		keys.Release()
+		return nil, nil, err
+	}
+	return keys, partitions, nil
+}
+
+// HashPartitionRecord splits rec into n records, by hashing the elements of
+// the column at index col: the partition h%n holds the rows of rec whose key
+// hashes to h, in order. Null keys are in the first partition.
+//
+// Keys are hashed with the function given by WithHasher, from the bytes it
+// describes, or with 64-bit FNV-1a by default. The partitions are thus the
+// same across runs and processes, and match the partitioning of any system
+// using the same hash function.
+// The returned records are allocated with memory.DefaultAllocator and must
+// be released after use.
+//
+// The key column must be supported by HashToGroups, and the other columns
+// by Take.
+func HashPartitionRecord(rec Record, col, n int, opts ...HashOption) ([]Record, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("arrow/array: invalid number of partitions %d", n)
+	}
+
+	cfg := newHashConfig(opts)
+	if cfg.hasher == nil {
+		cfg.hasher = fnvHash
+	}
+
+	arr := rec.Column(col)
+	value, ok := goValueFunc(arr)
+	if !ok {
+		return nil, fmt.Errorf("arrow/array: hashing not supported for %v arrays", arr.DataType())
+	}
+
+	var (
+		rows = make([][]int, n)
+		buf  []byte
+	)
+	for i := 0; i < arr.Len(); i++ {
+		p := 0
+		if arr.IsValid(i) {
+			buf = appendHashBytes(buf[:0], value(i))
+			p = int(cfg.hasher(buf) % uint64(n))
+		}
+		rows[p] = append(rows[p], i)
+	}
+	return takeRecords(rec, rows)
+}
+
+// takeRecords returns one new record per element of rows, holding the rows
+// of rec at its indices.
+func takeRecords(rec Record, rows [][]int) (recs []Record, err error) {
+	recs = make([]Record, 0, len(rows))
+	defer func() {
+		if err != nil {
+			for _, r := range recs {
+				r.Release()
+			}
+		}
+	}()
+
+	for _, indices := range rows {
+		r, err := takeRecord(rec, indices)
+		if err != nil {
+			return nil, err
+		}
+		recs = append(recs, r)
+	}
+	return recs, nil
+}
+
+// takeRecord returns a new record holding the rows of rec at the provided
+// indices.
+func takeRecord(rec Record, indices []int) (Record, error) {
+	cols := make([]Interface, 0, rec.NumCols())
+	defer func() {
+		for _, c := range cols {
+			c.Release()
+		}
+	}()
+
+	for _, c := range rec.Columns() {
+		out, err := Take(c, indices, memory.DefaultAllocator)
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, out)
+	}
+	return NewRecord(rec.Schema(), cols, int64(len(indices))), nil
+}
@@ -0,0 +1,238 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestPartitionRecord(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "key", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "v", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.StringBuilder).AppendValues(
+		[]string{"eu", "us", "", "eu", "us", "", "apac"},
+		[]bool{true, true, false, true, true, false, true},
+	)
+	b.Field(1).(*array.Float64Builder).AppendValues(
+		[]float64{1, 2, 3, 4, 5, 6, 7},
+		[]bool{true, true, true, false, true, true, true},
+	)
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	keys, parts, err := array.PartitionRecord(rec, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer keys.Release()
+	defer func() {
+		for _, p := range parts {
+			p.Release()
+		}
+	}()
+
+	if got, want := fmt.Sprintf("%v", keys), `["eu" "us" (null) "apac"]`; got != want {
+		t.Fatalf("invalid keys: got=%s, want=%s", got, want)
+	}
+
+	want := []struct {
+		keys string
+		vs   string
+	}{
+		{`["eu" "eu"]`, "[1 (null)]"},
+		{`["us" "us"]`, "[2 5]"},
+		{`[(null) (null)]`, "[3 6]"},
+		{`["apac"]`, "[7]"},
+	}
+	if got, want := len(parts), len(want); got != want {
+		t.Fatalf("invalid number of partitions: got=%d, want=%d", got, want)
+	}
+	for i, p := range parts {
+		if !p.Schema().Equal(schema) {
+			t.Fatalf("partition %d: invalid schema: got=%v, want=%v", i, p.Schema(), schema)
+		}
+		if got, want := fmt.Sprintf("%v", p.Column(0)), want[i].keys; got != want {
+			t.Fatalf("partition %d: invalid keys: got=%s, want=%s", i, got, want)
+		}
+		if got, want := fmt.Sprintf("%v", p.Column(1)), want[i].vs; got != want {
+			t.Fatalf("partition %d: invalid values: got=%s, want=%s", i, got, want)
+		}
+	}
+}
+
+func TestPartitionRecordDictionary(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues([]string{"x", "y", "x", "z", "y"}, nil)
+	strs := sb.NewArray()
+	defer strs.Release()
+
+	dict, err := array.DictionaryEncode(strs, arrow.PrimitiveTypes.Int32, mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dict.Release()
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int64{1, 2, 3, 4, 5}, nil)
+	vs := ib.NewArray()
+	defer vs.Release()
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "key", Type: dict.DataType()},
+			{Name: "v", Type: arrow.PrimitiveTypes.Int64},
+		},
+		nil,
+	)
+	rec := array.NewRecord(schema, []array.Interface{dict, vs}, -1)
+	defer rec.Release()
+
+	keys, parts, err := array.PartitionRecord(rec, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer keys.Release()
+	defer func() {
+		for _, p := range parts {
+			p.Release()
+		}
+	}()
+
+	if got, want := fmt.Sprintf("%v", keys.(*array.Dictionary).Indices()), `[0 1 2]`; got != want {
+		t.Fatalf("invalid keys: got=%s, want=%s", got, want)
+	}
+
+	want := []string{"[1 3]", "[2 5]", "[4]"}
+	if got, want := len(parts), len(want); got != want {
+		t.Fatalf("invalid number of partitions: got=%d, want=%d", got, want)
+	}
+	for i, p := range parts {
+		if got, want := fmt.Sprintf("%v", p.Column(1)), want[i]; got != want {
+			t.Fatalf("partition %d: invalid values: got=%s, want=%s", i, got, want)
+		}
+	}
+}
+
+func TestHashPartitionRecord(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "key", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			{Name: "row", Type: arrow.PrimitiveTypes.Int64},
+		},
+		nil,
+	)
+
+	keys := []int64{1, 2, 3, 0, 1, 4, 2, 5, 6, 7}
+	valid := []bool{true, true, true, false, true, true, true, true, true, true}
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int64Builder).AppendValues(keys, valid)
+	for i := range keys {
+		b.Field(1).(*array.Int64Builder).Append(int64(i))
+	}
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	fnv64a := func(b []byte) uint64 {
+		h := fnv.New64a()
+		h.Write(b)
+		return h.Sum64()
+	}
+	seeded := func(seed uint64) func([]byte) uint64 {
+		return func(b []byte) uint64 {
+			h := fnv.New64a()
+			var s [8]byte
+			binary.LittleEndian.PutUint64(s[:], seed)
+			h.Write(s[:])
+			h.Write(b)
+			return h.Sum64()
+		}
+	}
+
+	const n = 3
+	for _, tc := range []struct {
+		name   string
+		opts   []array.HashOption
+		hasher func([]byte) uint64
+	}{
+		{"default", nil, fnv64a},
+		{"seeded", []array.HashOption{array.WithHasher(seeded(42))}, seeded(42)},
+		{"constant", []array.HashOption{array.WithHasher(func([]byte) uint64 { return 2 })}, func([]byte) uint64 { return 2 }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// each row is in the partition of the hash of its key.
+			want := make([][]int64, n)
+			for i, k := range keys {
+				p := 0
+				if valid[i] {
+					var buf [8]byte
+					binary.LittleEndian.PutUint64(buf[:], uint64(k))
+					p = int(tc.hasher(buf[:]) % n)
+				}
+				want[p] = append(want[p], int64(i))
+			}
+
+			for run := 0; run < 2; run++ {
+				parts, err := array.HashPartitionRecord(rec, 0, n, tc.opts...)
+				if err != nil {
+					t.Fatalf("could not partition record: %+v", err)
+				}
+				if got, want := len(parts), n; got != want {
+					t.Fatalf("invalid number of partitions: got=%d, want=%d", got, want)
+				}
+				for i, p := range parts {
+					if got, want := fmt.Sprintf("%v", p.Column(1)), fmt.Sprintf("%v", want[i]); got != want {
+						t.Fatalf("run %d: partition %d: invalid rows: got=%s, want=%s", run, i, got, want)
+					}
+					p.Release()
+				}
+			}
+		})
+	}
+
+	if _, err := array.HashPartitionRecord(rec, 0, 0); err == nil {
+		t.Fatalf("expected an error for zero partitions")
+	}
+}
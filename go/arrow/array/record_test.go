@@ -92,8 +92,8 @@ func TestRecord(t *testing.T) {
 		{i: 0, j: 0, err: nil},
 		{i: 1, j: 1, err: nil},
 		{i: 10, j: 10, err: nil},
-		{i: 1, j: 0, err: fmt.Errorf("arrow/array: index out of range")},
-		{i: 1, j: 11, err: fmt.Errorf("arrow/array: index out of range")},
+		{i: 1, j: 0, err: fmt.Errorf("arrow/array: slice bounds [1:0] out of range for length 10")},
+		{i: 1, j: 11, err: fmt.Errorf("arrow/array: slice bounds [1:11] out of range for length 10")},
 	} {
 		t.Run(fmt.Sprintf("slice-%02d-%02d", tc.i, tc.j), func(t *testing.T) {
 			if tc.err != nil {
@@ -305,6 +305,12 @@ func TestRecordReader(t *testing.T) {
 	if n != len(recs) {
 		t.Fatalf("invalid number of iterations. got=%d, want=%d", n, len(recs))
 	}
+	if err := itr.Err(); err != nil {
+		t.Fatalf("invalid error after draining reader: %v", err)
+	}
+	if itr.Next() {
+		t.Fatalf("drained reader should not yield more records")
+	}
 
 	for _, tc := range []struct {
 		name   string
@@ -388,3 +394,237 @@ func TestRecordBuilder(t *testing.T) {
 		t.Fatalf("invalid column name: got=%q, want=%q", got, want)
 	}
 }
+
+func TestRecordBuilderRetain(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64}}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+
+	// releasing a retained builder must keep its field builders alive.
+	b.Retain()
+	b.Release()
+
+	b.Field(0).(*array.Int64Builder).Append(4)
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	if got, want := rec.Column(0).(*array.Int64).Int64Values(), []int64{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid values: got=%v, want=%v", got, want)
+	}
+}
+
+func TestRecordSelect(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	meta := arrow.NewMetadata([]string{"k"}, []string{"v"})
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "f1", Type: arrow.PrimitiveTypes.Int32},
+			{Name: "f2", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "f3", Type: arrow.BinaryTypes.String},
+		},
+		&meta,
+	)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3, 4}, nil)
+	b.Field(1).(*array.Float64Builder).AppendValues([]float64{1, 2, 3, 4}, []bool{true, false, true, true})
+	b.Field(2).(*array.StringBuilder).AppendValues([]string{"a", "b", "c", "d"}, nil)
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	sub := rec.Select([]int{2, 0})
+	defer sub.Release()
+
+	want := arrow.NewSchema([]arrow.Field{schema.Field(2), schema.Field(0)}, &meta)
+	if got := sub.Schema(); !got.EqualWithMetadata(want) {
+		t.Fatalf("invalid schema:\ngot= %v\nwant=%v", got, want)
+	}
+	if got, want := sub.NumRows(), int64(4); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+	if got, want := sub.Column(0), rec.Column(2); got != want {
+		t.Fatalf("selected columns should be shared")
+	}
+
+	slice := rec.NewSlice(1, 3)
+	defer slice.Release()
+
+	proj, err := slice.SelectByName([]string{"f2", "f3"})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer proj.Release()
+
+	if got, want := proj.NumCols(), int64(2); got != want {
+		t.Fatalf("invalid number of columns: got=%d, want=%d", got, want)
+	}
+	if got, want := proj.NumRows(), int64(2); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+	if got, want := fmt.Sprintf("%v", proj.Column(0)), "[(null) 3]"; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+	if got, want := fmt.Sprintf("%v", proj.Column(1)), `["b" "c"]`; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+
+	_, err = rec.SelectByName([]string{"f1", "missing"})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if got, want := err.Error(), `arrow/array: no column named "missing"`; got != want {
+		t.Fatalf("invalid error: got=%q, want=%q", got, want)
+	}
+
+	func() {
+		defer func() {
+			if e := recover(); e == nil {
+				t.Fatalf("expected a panic")
+			}
+		}()
+		rec.Select([]int{3})
+	}()
+}
+
+func TestRecordColumns(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	meta := arrow.NewMetadata([]string{"k"}, []string{"v"})
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "f1", Type: arrow.PrimitiveTypes.Int32},
+			{Name: "f2", Type: arrow.BinaryTypes.String},
+		},
+		&meta,
+	)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"a", "b", "c"}, nil)
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	fb := array.NewFloat64Builder(mem)
+	defer fb.Release()
+
+	fb.AppendValues([]float64{1.5, 2.5, 3.5}, nil)
+	col := fb.NewFloat64Array()
+	defer col.Release()
+
+	fb.AppendValues([]float64{1, 2}, nil)
+	short := fb.NewFloat64Array()
+	defer short.Release()
+
+	field := arrow.Field{Name: "f3", Type: arrow.PrimitiveTypes.Float64}
+
+	added, err := rec.AddColumn(1, field, col)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer added.Release()
+
+	want := arrow.NewSchema([]arrow.Field{schema.Field(0), field, schema.Field(1)}, &meta)
+	if got := added.Schema(); !got.EqualWithMetadata(want) {
+		t.Fatalf("invalid schema:\ngot= %v\nwant=%v", got, want)
+	}
+	if got, want := added.NumRows(), int64(3); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+	for i, want := range []array.Interface{rec.Column(0), col, rec.Column(1)} {
+		if got := added.Column(i); got != want {
+			t.Fatalf("column %d should be shared", i)
+		}
+	}
+
+	set, err := added.SetColumn(0, field, col)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer set.Release()
+
+	if got, want := set.ColumnName(0), "f3"; got != want {
+		t.Fatalf("invalid column name: got=%q, want=%q", got, want)
+	}
+	if got, want := set.Column(0), array.Interface(col); got != want {
+		t.Fatalf("column 0 should be replaced")
+	}
+
+	removed, err := added.RemoveColumn(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer removed.Release()
+
+	want = arrow.NewSchema([]arrow.Field{field, schema.Field(1)}, &meta)
+	if got := removed.Schema(); !got.EqualWithMetadata(want) {
+		t.Fatalf("invalid schema:\ngot= %v\nwant=%v", got, want)
+	}
+	if got, want := removed.NumCols(), int64(2); got != want {
+		t.Fatalf("invalid number of columns: got=%d, want=%d", got, want)
+	}
+
+	for _, tc := range []struct {
+		name string
+		fn   func() (array.Record, error)
+		want string
+	}{
+		{
+			name: "add-length",
+			fn:   func() (array.Record, error) { return rec.AddColumn(0, field, short) },
+			want: `arrow/array: mismatch number of rows in column "f3": got=2, want=3`,
+		},
+		{
+			name: "set-length",
+			fn:   func() (array.Record, error) { return rec.SetColumn(0, field, short) },
+			want: `arrow/array: mismatch number of rows in column "f3": got=2, want=3`,
+		},
+		{
+			name: "add-type",
+			fn: func() (array.Record, error) {
+				return rec.AddColumn(0, arrow.Field{Name: "f3", Type: arrow.PrimitiveTypes.Int64}, col)
+			},
+			want: `arrow/array: column "f3" type mismatch: got=float64, want=int64`,
+		},
+		{
+			name: "add-index",
+			fn:   func() (array.Record, error) { return rec.AddColumn(3, field, col) },
+			want: "arrow: invalid field index 3 to add to schema with 2 fields",
+		},
+		{
+			name: "set-index",
+			fn:   func() (array.Record, error) { return rec.SetColumn(2, field, col) },
+			want: "arrow: invalid field index 2 to set in schema with 2 fields",
+		},
+		{
+			name: "remove-index",
+			fn:   func() (array.Record, error) { return rec.RemoveColumn(-1) },
+			want: "arrow: invalid field index -1 to remove from schema with 2 fields",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.fn()
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if got, want := err.Error(), tc.want; got != want {
+				t.Fatalf("invalid error: got=%q, want=%q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// RecordFromValues creates a record from rows, a slice of Go structs, of
+// pointers to Go structs, or of maps keyed by strings, using reflection.
+// It is meant for test fixtures and quick ingestion, not for hot paths.
+//
+// Schema fields are matched with the struct fields tagged with
+// `arrow:"name"`, or else with the exported struct fields of the same name,
+// ignoring case. Map rows are matched by key.
+// Go values are converted as with RecordFromMaps, following their kinds:
+// nested structs and maps populate struct fields, slices and arrays
+// populate list fields, and nil pointers, interfaces, maps and slices, as
+// well as missing struct fields and map keys, are appended as nulls.
+//
+// RecordFromValues returns an error for Go values that cannot be converted
+// to the type of their field.
+// The returned record must be Release()'d after use.
+func RecordFromValues(schema *arrow.Schema, rows interface{}, mem memory.Allocator) (Record, error) {
+	rv := reflect.ValueOf(rows)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, fmt.Errorf("arrow/array: rows must be a slice, got %T", rows)
+	}
+
+	b := NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	// rows are not converted as struct values: schemas, unlike struct
+	// types, may hold several fields with the same name.
+	b.Reserve(rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		var (
+			row interface{}
+			err error
+		)
+		if v := indirect(rv.Index(i)); v.IsValid() {
+			row, err = goStructFor(v, schema.Fields())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("arrow/array: row %d: %v", i, err)
+		}
+		kvs, _ := row.(map[string]interface{}) // a nil row appends nulls to all fields.
+		for j, field := range schema.Fields() {
+			if err := appendGoValue(b.Field(j), kvs[field.Name]); err != nil {
+				return nil, fmt.Errorf("arrow/array: row %d, column %q: %v", i, field.Name, err)
+			}
+		}
+	}
+
+	return b.NewRecord(), nil
+}
+
+// goValueFor converts v to the Go value appended by appendGoValue to a
+// builder of type dtype.
+func goValueFor(v reflect.Value, dtype arrow.DataType) (interface{}, error) {
+	v = indirect(v)
+	if !v.IsValid() {
+		return nil, nil
+	}
+
+	switch dtype := dtype.(type) {
+	case *arrow.StructType:
+		return goStructFor(v, dtype.Fields())
+	case *arrow.ListType:
+		return goListFor(v, dtype.Elem())
+	case *arrow.LargeListType:
+		return goListFor(v, dtype.Elem())
+	case *arrow.FixedSizeListType:
+		return goListFor(v, dtype.Elem())
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if v.IsNil() {
+				return nil, nil
+			}
+			return v.Bytes(), nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported Go type %v for %v value", v.Type(), dtype)
+}
+
+// indirect dereferences the pointers and interfaces v, returning the zero
+// Value if one of them is nil.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// goStructFor converts v to the Go value appended by appendGoValue to a
+// struct builder with the provided fields.
+func goStructFor(v reflect.Value, fields []arrow.Field) (interface{}, error) {
+	kvs := make(map[string]interface{}, len(fields))
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported Go type %v for struct value", v.Type())
+		}
+		if v.IsNil() {
+			return nil, nil
+		}
+		for _, field := range fields {
+			fv := v.MapIndex(reflect.ValueOf(field.Name).Convert(v.Type().Key()))
+			if !fv.IsValid() {
+				continue
+			}
+			gv, err := goValueFor(fv, field.Type)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %v", field.Name, err)
+			}
+			kvs[field.Name] = gv
+		}
+	case reflect.Struct:
+		for _, field := range fields {
+			fv, ok := structFieldByName(v, field.Name)
+			if !ok {
+				continue
+			}
+			gv, err := goValueFor(fv, field.Type)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %v", field.Name, err)
+			}
+			kvs[field.Name] = gv
+		}
+	default:
+		return nil, fmt.Errorf("unsupported Go type %v for struct value", v.Type())
+	}
+	return kvs, nil
+}
+
+func goListFor(v reflect.Value, elem arrow.DataType) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, nil
+		}
+	case reflect.Array:
+	default:
+		return nil, fmt.Errorf("unsupported Go type %v for %v value", v.Type(), arrow.ListOf(elem))
+	}
+
+	vs := make([]interface{}, v.Len())
+	for i := range vs {
+		gv, err := goValueFor(v.Index(i), elem)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %v", i, err)
+		}
+		vs[i] = gv
+	}
+	return vs, nil
+}
+
+// structFieldByName returns the field of the struct value v matching name:
+// the field tagged with `arrow:"name"`, or else the exported field named
+// name, ignoring case.
+func structFieldByName(v reflect.Value, name string) (reflect.Value, bool) {
+	var (
+		t     = v.Type()
+		match = -1
+	)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" { // unexported.
+			continue
+		}
+		if tag, ok := f.Tag.Lookup("arrow"); ok {
+			if tag == name {
+				return v.Field(i), true
+			}
+			continue
+		}
+		if match < 0 && strings.EqualFold(f.Name, name) {
+			match = i
+		}
+	}
+	if match < 0 {
+		return reflect.Value{}, false
+	}
+	return v.Field(match), true
+}
@@ -0,0 +1,200 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+type recordValuesLevel int8
+
+type recordValuesPoint struct {
+	X, Y float64
+}
+
+type recordValuesRow struct {
+	ID     int64             `arrow:"id"`
+	Name   string            // matched ignoring case.
+	Level  recordValuesLevel // named types are converted by kind.
+	Score  *float32          // nil pointers are nulls.
+	Tags   []string
+	Origin *recordValuesPoint `arrow:"origin"`
+	hidden int
+}
+
+var recordValuesSchema = arrow.NewSchema([]arrow.Field{
+	{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+	{Name: "name", Type: arrow.BinaryTypes.String},
+	{Name: "level", Type: arrow.PrimitiveTypes.Int8},
+	{Name: "score", Type: arrow.PrimitiveTypes.Float32, Nullable: true},
+	{Name: "tags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+	{Name: "origin", Type: arrow.StructOf(
+		arrow.Field{Name: "x", Type: arrow.PrimitiveTypes.Float64},
+		arrow.Field{Name: "y", Type: arrow.PrimitiveTypes.Float64},
+	), Nullable: true},
+}, nil)
+
+// readRecordValues reads back the rows of a record built from recordValuesRow values.
+func readRecordValues(rec array.Record) []recordValuesRow {
+	var (
+		ids    = rec.Column(0).(*array.Int64)
+		names  = rec.Column(1).(*array.String)
+		levels = rec.Column(2).(*array.Int8)
+		scores = rec.Column(3).(*array.Float32)
+		tags   = rec.Column(4).(*array.List)
+		origin = rec.Column(5).(*array.Struct)
+		xs     = origin.Field(0).(*array.Float64)
+		ys     = origin.Field(1).(*array.Float64)
+	)
+
+	rows := make([]recordValuesRow, rec.NumRows())
+	for i := range rows {
+		row := &rows[i]
+		row.ID = ids.Value(i)
+		row.Name = names.Value(i)
+		row.Level = recordValuesLevel(levels.Value(i))
+		if scores.IsValid(i) {
+			v := scores.Value(i)
+			row.Score = &v
+		}
+		if tags.IsValid(i) {
+			vs := tags.ValueSlice(i).(*array.String)
+			row.Tags = make([]string, vs.Len())
+			for j := range row.Tags {
+				row.Tags[j] = vs.Value(j)
+			}
+			vs.Release()
+		}
+		if origin.IsValid(i) {
+			row.Origin = &recordValuesPoint{X: xs.Value(i), Y: ys.Value(i)}
+		}
+	}
+	return rows
+}
+
+func TestRecordFromValues(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	score := float32(1.5)
+	want := []recordValuesRow{
+		{ID: 1, Name: "a", Level: 3, Score: &score, Tags: []string{"x", "y"}, Origin: &recordValuesPoint{1, 2}},
+		{ID: 2, Name: "b", Level: -1, Tags: []string{}},
+		{ID: 3, Name: "", Level: 0, Score: &score, Origin: &recordValuesPoint{-1, 0}},
+	}
+
+	rec, err := array.RecordFromValues(recordValuesSchema, want, mem)
+	if err != nil {
+		t.Fatalf("could not create record: %+v", err)
+	}
+	defer rec.Release()
+
+	if got, want := rec.NumRows(), int64(len(want)); got != want {
+		t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+	}
+	if got := readRecordValues(rec); !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid round-trip:\ngot= %+v\nwant=%+v", got, want)
+	}
+
+	// pointers to structs and maps are supported as well.
+	ptrs := []*recordValuesRow{&want[0], &want[1], &want[2]}
+	prec, err := array.RecordFromValues(recordValuesSchema, ptrs, mem)
+	if err != nil {
+		t.Fatalf("could not create record from pointers: %+v", err)
+	}
+	defer prec.Release()
+	if !array.RecordEqual(rec, prec) {
+		t.Fatalf("records from values and pointers differ")
+	}
+
+	maps := []map[string]interface{}{
+		{"id": 1, "name": "a", "level": int8(3), "score": score, "tags": []string{"x", "y"}, "origin": map[string]float64{"x": 1, "y": 2}},
+		{"id": 2, "name": "b", "level": -1, "tags": []string{}},
+		{"id": 3, "name": "", "level": 0, "score": &score, "origin": recordValuesPoint{-1, 0}},
+	}
+	mrec, err := array.RecordFromValues(recordValuesSchema, maps, mem)
+	if err != nil {
+		t.Fatalf("could not create record from maps: %+v", err)
+	}
+	defer mrec.Release()
+	if !array.RecordEqual(rec, mrec) {
+		t.Fatalf("records from structs and maps differ:\ngot= %v\nwant=%v", array.NewRecordStringer(mrec), array.NewRecordStringer(rec))
+	}
+}
+
+func TestRecordFromValuesDuplicateNames(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	// all the fields with the same name are populated from the same values.
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "f", Type: arrow.PrimitiveTypes.Int8},
+		{Name: "f", Type: arrow.PrimitiveTypes.Int64},
+	}, nil)
+
+	rows := []map[string]interface{}{{"f": 1}, {"f": 2}}
+	rec, err := array.RecordFromValues(schema, rows, mem)
+	if err != nil {
+		t.Fatalf("could not create record: %+v", err)
+	}
+	defer rec.Release()
+
+	for i, col := range rec.Columns() {
+		if got, want := fmt.Sprintf("%v", col), "[1 2]"; got != want {
+			t.Fatalf("invalid column %d: got=%s, want=%s", i, got, want)
+		}
+	}
+}
+
+func TestRecordFromValuesInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "f", Type: arrow.PrimitiveTypes.Int8},
+	}, nil)
+
+	for _, tc := range []struct {
+		name string
+		rows interface{}
+		err  string
+	}{
+		{"not-a-slice", 42, "rows must be a slice"},
+		{"not-a-struct", []int{1}, "unsupported Go type int"},
+		{"chan", []struct{ F chan int }{{make(chan int)}}, "unsupported Go type chan int"},
+		{"overflow", []struct{ F int }{{1000}}, "cannot convert value 1000"},
+		{"list", []struct{ F []int8 }{{[]int8{1}}}, "unsupported Go type []int8"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rec, err := array.RecordFromValues(schema, tc.rows, mem)
+			if err == nil {
+				rec.Release()
+				t.Fatalf("expected an error")
+			}
+			if !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("invalid error: got=%q, want=%q", err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// ReplaceWithMask returns a copy of values where each element whose mask
+// element is true is replaced with the next element of replacements.
+// Elements whose mask element is false or null are kept.
+//
+// mask must have the same length as values, and replacements must have the
+// same data type as values and hold exactly one element per true element
+// of mask. Null replacements yield null elements.
+//
+// ReplaceWithMask supports boolean, numeric, temporal and string arrays.
+func ReplaceWithMask(values Interface, mask *Boolean, replacements Interface, pool memory.Allocator) (Interface, error) {
+	switch {
+	case mask.Len() != values.Len():
+		return nil, fmt.Errorf("arrow/array: replace mask length mismatch (got=%d, want=%d)", mask.Len(), values.Len())
+	case !arrow.TypeEquals(replacements.DataType(), values.DataType()):
+		return nil, fmt.Errorf("arrow/array: replacements type mismatch (got=%v, want=%v)", replacements.DataType(), values.DataType())
+	}
+
+	n := 0
+	for i := 0; i < mask.Len(); i++ {
+		if mask.IsValid(i) && mask.Value(i) {
+			n++
+		}
+	}
+	if n != replacements.Len() {
+		return nil, fmt.Errorf("arrow/array: replacements length mismatch (got=%d, want=%d)", replacements.Len(), n)
+	}
+
+	get, ok := goValueFunc(values)
+	if !ok {
+		return nil, fmt.Errorf("arrow/array: replace not supported for %v arrays", values.DataType())
+	}
+	repl, _ := goValueFunc(replacements) // same data type as values.
+
+	bldr := NewBuilder(pool, values.DataType())
+	defer bldr.Release()
+
+	bldr.Reserve(values.Len())
+	j := 0
+	for i := 0; i < values.Len(); i++ {
+		var v interface{}
+		switch {
+		case mask.IsValid(i) && mask.Value(i):
+			if replacements.IsValid(j) {
+				v = repl(j)
+			}
+			j++
+		case values.IsValid(i):
+			v = get(i)
+		}
+		if err := appendGoValue(bldr, v); err != nil {
+			return nil, err
+		}
+	}
+	return bldr.NewArray(), nil
+}
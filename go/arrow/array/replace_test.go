@@ -0,0 +1,194 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestReplaceWithMask(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	mb := array.NewBooleanBuilder(mem)
+	defer mb.Release()
+	mb.AppendValues([]bool{true, false, true, false, true, false}, []bool{true, true, true, false, true, true})
+	mask := mb.NewBooleanArray()
+	defer mask.Release()
+
+	for _, tc := range []struct {
+		name         string
+		values       func() array.Interface
+		replacements func() array.Interface
+		want         string
+	}{
+		{
+			name: "int64",
+			values: func() array.Interface {
+				b := array.NewInt64Builder(mem)
+				defer b.Release()
+				b.AppendValues([]int64{1, 2, 3, 4, 5, 0}, []bool{true, true, true, true, true, false})
+				return b.NewArray()
+			},
+			replacements: func() array.Interface {
+				b := array.NewInt64Builder(mem)
+				defer b.Release()
+				b.AppendValues([]int64{10, 0, 50}, []bool{true, false, true})
+				return b.NewArray()
+			},
+			want: "[10 2 (null) 4 50 (null)]",
+		},
+		{
+			name: "string",
+			values: func() array.Interface {
+				b := array.NewStringBuilder(mem)
+				defer b.Release()
+				b.AppendValues([]string{"a", "b", "c", "d", "e", "f"}, nil)
+				return b.NewArray()
+			},
+			replacements: func() array.Interface {
+				b := array.NewStringBuilder(mem)
+				defer b.Release()
+				b.AppendValues([]string{"x", "y", "z"}, nil)
+				return b.NewArray()
+			},
+			want: `["x" "b" "y" "d" "z" "f"]`,
+		},
+		{
+			name: "bool",
+			values: func() array.Interface {
+				b := array.NewBooleanBuilder(mem)
+				defer b.Release()
+				b.AppendValues([]bool{false, false, false, false, false, false}, nil)
+				return b.NewArray()
+			},
+			replacements: func() array.Interface {
+				b := array.NewBooleanBuilder(mem)
+				defer b.Release()
+				b.AppendValues([]bool{true, false, true}, []bool{true, true, false})
+				return b.NewArray()
+			},
+			want: "[true false false false (null) false]",
+		},
+		{
+			name: "timestamp",
+			values: func() array.Interface {
+				b := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Second})
+				defer b.Release()
+				b.AppendValues([]arrow.Timestamp{1, 2, 3, 4, 5, 6}, nil)
+				return b.NewArray()
+			},
+			replacements: func() array.Interface {
+				b := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Second})
+				defer b.Release()
+				b.AppendValues([]arrow.Timestamp{10, 30, 50}, nil)
+				return b.NewArray()
+			},
+			want: "[10 2 30 4 50 6]",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			values := tc.values()
+			defer values.Release()
+			repl := tc.replacements()
+			defer repl.Release()
+
+			got, err := array.ReplaceWithMask(values, mask, repl, mem)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer got.Release()
+
+			if got := got.(interface{ String() string }).String(); got != tc.want {
+				t.Fatalf("invalid array: got=%s, want=%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReplaceWithMaskErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int64{1, 2, 3}, nil)
+	values := ib.NewArray()
+	defer values.Release()
+	ib.AppendValues([]int64{10}, nil)
+	repl := ib.NewArray()
+	defer repl.Release()
+
+	mb := array.NewBooleanBuilder(mem)
+	defer mb.Release()
+	mb.AppendValues([]bool{true, true, false}, nil)
+	mask := mb.NewBooleanArray()
+	defer mask.Release()
+	mb.AppendValues([]bool{true}, nil)
+	short := mb.NewBooleanArray()
+	defer short.Release()
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues([]string{"x", "y"}, nil)
+	strs := sb.NewArray()
+	defer strs.Release()
+
+	for _, tc := range []struct {
+		name string
+		mask *array.Boolean
+		repl array.Interface
+		err  string
+	}{
+		{"mask-length", short, repl, "arrow/array: replace mask length mismatch (got=1, want=3)"},
+		{"replacements-length", mask, repl, "arrow/array: replacements length mismatch (got=1, want=2)"},
+		{"replacements-type", mask, strs, "arrow/array: replacements type mismatch (got=utf8, want=int64)"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := array.ReplaceWithMask(values, tc.mask, tc.repl, mem)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if got, want := err.Error(), tc.err; got != want {
+				t.Fatalf("invalid error: got=%q, want=%q", got, want)
+			}
+		})
+	}
+
+	t.Run("unsupported", func(t *testing.T) {
+		bb := array.NewBinaryBuilder(mem, arrow.BinaryTypes.Binary)
+		defer bb.Release()
+		bb.AppendValues([][]byte{[]byte("a"), []byte("b"), []byte("c")}, nil)
+		bins := bb.NewArray()
+		defer bins.Release()
+		bb.AppendValues([][]byte{[]byte("x"), []byte("y")}, nil)
+		brepl := bb.NewArray()
+		defer brepl.Release()
+
+		_, err := array.ReplaceWithMask(bins, mask, brepl, mem)
+		if err == nil {
+			t.Fatalf("expected an error")
+		}
+		if got, want := err.Error(), "arrow/array: replace not supported for binary arrays"; got != want {
+			t.Fatalf("invalid error: got=%q, want=%q", got, want)
+		}
+	})
+}
@@ -0,0 +1,445 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// RunEndEncoded represents an immutable sequence of values, stored as runs
+// of consecutive equal values.
+//
+// RunEndEncoded holds two child arrays: the run ends, holding the logical
+// index at which each run ends (exclusive), and the values, holding the
+// value of each run. RunEndEncoded has no validity bitmap: null elements
+// are stored as null runs of the values.
+type RunEndEncoded struct {
+	array
+	ends   Interface
+	values Interface
+
+	runEnd func(i int) int // returns the i-th run end.
+	nruns  int
+}
+
+// NewRunEndEncodedData returns a new RunEndEncoded array value, from data.
+func NewRunEndEncodedData(data *Data) *RunEndEncoded {
+	a := &RunEndEncoded{}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+// NewRunEndEncoded returns a new RunEndEncoded array of length logical
+// elements, starting at logical offset offset, from the provided run ends
+// and values.
+//
+// NewRunEndEncoded panics if runEnds is not of type int16, int32 or int64,
+// if runEnds and values do not have the same length, or if the run ends do
+// not cover offset+length logical elements.
+func NewRunEndEncoded(runEnds, values Interface, length, offset int) *RunEndEncoded {
+	if !arrow.ValidRunEndsType(runEnds.DataType()) {
+		panic(fmt.Errorf("arrow/array: invalid run ends data type %v", runEnds.DataType()))
+	}
+	if runEnds.Len() != values.Len() {
+		panic(fmt.Errorf("arrow/array: run ends and values length mismatch (%d != %d)", runEnds.Len(), values.Len()))
+	}
+
+	data := NewData(
+		arrow.RunEndEncodedOf(runEnds.DataType(), values.DataType()),
+		length,
+		[]*memory.Buffer{nil},
+		[]*Data{runEnds.Data(), values.Data()},
+		0, offset,
+	)
+	defer data.Release()
+
+	a := NewRunEndEncodedData(data)
+	if n := a.nruns; length > 0 && (n == 0 || a.runEnd(n-1) < offset+length) {
+		a.Release()
+		panic(fmt.Errorf("arrow/array: run ends do not cover %d logical elements", offset+length))
+	}
+	return a
+}
+
+// RunEnds returns the array of run ends.
+func (a *RunEndEncoded) RunEnds() Interface { return a.ends }
+
+// Values returns the array of the values of the runs.
+func (a *RunEndEncoded) Values() Interface { return a.values }
+
+// LogicalLength returns the number of logical (decoded) elements of the array.
+// It is the same as Len.
+func (a *RunEndEncoded) LogicalLength() int { return a.Len() }
+
+// PhysicalLength returns the number of runs spanned by the logical elements
+// of the array.
+func (a *RunEndEncoded) PhysicalLength() int {
+	if a.Len() == 0 {
+		return 0
+	}
+	return a.PhysicalIndex(a.Len()-1) - a.PhysicalIndex(0) + 1
+}
+
+// PhysicalIndex returns the index, in Values and RunEnds, of the run holding
+// the i-th logical element of the array. PhysicalIndex performs a binary
+// search over the run ends.
+//
+// PhysicalIndex panics if i is out of range.
+func (a *RunEndEncoded) PhysicalIndex(i int) int {
+	if i < 0 || i >= a.Len() {
+		panic("arrow/array: index out of range")
+	}
+	i += a.data.offset
+	return sort.Search(a.nruns, func(j int) bool { return a.runEnd(j) > i })
+}
+
+func (a *RunEndEncoded) String() string {
+	o := new(strings.Builder)
+	newStringerConfig(nil).writeArray(o, a)
+	return o.String()
+}
+
+func (a *RunEndEncoded) setData(data *Data) {
+	a.array.setData(data)
+	a.ends = MakeFromData(data.childData[0])
+	a.values = MakeFromData(data.childData[1])
+	a.nruns = a.ends.Len()
+
+	switch ends := a.ends.(type) {
+	case *Int16:
+		a.runEnd = func(i int) int { return int(ends.Value(i)) }
+	case *Int32:
+		a.runEnd = func(i int) int { return int(ends.Value(i)) }
+	case *Int64:
+		a.runEnd = func(i int) int { return int(ends.Value(i)) }
+	default:
+		panic(fmt.Errorf("arrow/array: invalid run ends data type %v", a.ends.DataType()))
+	}
+}
+
+// arrayEqualRunEndEncoded reports whether the logical elements of left and
+// right are equal, comparing them run by run with eq, which compares the
+// value at index i of lv with the value at index j of rv.
+func arrayEqualRunEndEncoded(left, right *RunEndEncoded, eq func(lv Interface, i int, rv Interface, j int) bool) bool {
+	var (
+		n    = left.Len()
+		loff = left.data.offset
+		roff = right.data.offset
+		li   = left.PhysicalIndex(0)
+		ri   = right.PhysicalIndex(0)
+	)
+	for pos := 0; pos < n; {
+		if !eq(left.values, li, right.values, ri) {
+			return false
+		}
+		lend := left.runEnd(li) - loff
+		rend := right.runEnd(ri) - roff
+		switch {
+		case lend < rend:
+			pos = lend
+			li++
+		case rend < lend:
+			pos = rend
+			ri++
+		default:
+			pos = lend
+			li++
+			ri++
+		}
+	}
+	return true
+}
+
+func (a *RunEndEncoded) Retain() {
+	a.array.Retain()
+	a.ends.Retain()
+	a.values.Retain()
+}
+
+func (a *RunEndEncoded) Release() {
+	a.array.Release()
+	a.ends.Release()
+	a.values.Release()
+}
+
+// RunEndEncodedBuilder builds RunEndEncoded arrays.
+//
+// Runs can be appended explicitly, with Append and ContinueRun, or element
+// by element, with AppendValue and AppendNull, which coalesce consecutive
+// equal elements into a single run.
+type RunEndEncodedBuilder struct {
+	builder
+
+	dtype   *arrow.RunEndEncodedType
+	runEnds Builder // run ends builder, an *Int16Builder, *Int32Builder or *Int64Builder.
+	values  Builder // value builder for the values of the runs.
+	maxEnd  int     // maximum run end representable by the run ends data type.
+
+	last    interface{} // value of the last run, when appended by AppendValue or AppendNull.
+	hasLast bool        // whether last holds the value of the last run.
+}
+
+// NewRunEndEncodedBuilder returns a builder, using the provided memory allocator.
+// The created builder will create run-end encoded arrays of values of type values,
+// with run ends of type runEnds.
+//
+// NewRunEndEncodedBuilder panics if runEnds is not int16, int32 or int64.
+func NewRunEndEncodedBuilder(mem memory.Allocator, runEnds, values arrow.DataType) *RunEndEncodedBuilder {
+	dtype := arrow.RunEndEncodedOf(runEnds, values)
+	b := &RunEndEncodedBuilder{
+		builder: builder{refCount: 1, mem: mem},
+		dtype:   dtype,
+		runEnds: NewBuilder(mem, runEnds),
+		values:  NewBuilder(mem, values),
+	}
+	switch runEnds.ID() {
+	case arrow.INT16:
+		b.maxEnd = math.MaxInt16
+	case arrow.INT32:
+		b.maxEnd = math.MaxInt32
+	default:
+		b.maxEnd = math.MaxInt64
+	}
+	return b
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+func (b *RunEndEncodedBuilder) Release() {
+	debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
+
+	if atomic.AddInt64(&b.refCount, -1) == 0 {
+		if b.runEnds != nil {
+			b.runEnds.Release()
+			b.runEnds = nil
+		}
+		if b.values != nil {
+			b.values.Release()
+			b.values = nil
+		}
+	}
+}
+
+// ValueBuilder returns the builder for the values of the runs.
+func (b *RunEndEncodedBuilder) ValueBuilder() Builder { return b.values }
+
+// SetCollectErrors configures how the builder and its value builder handle
+// invalid calls. See Builder.SetCollectErrors.
+func (b *RunEndEncodedBuilder) SetCollectErrors(v bool) {
+	b.builder.SetCollectErrors(v)
+	b.values.SetCollectErrors(v)
+}
+
+func (b *RunEndEncodedBuilder) setGrowth(g growthPolicy) {
+	b.builder.setGrowth(g)
+	b.runEnds.setGrowth(g)
+	b.values.setGrowth(g)
+}
+
+// Err returns the first error recorded by the builder, or else by its value
+// builder, since they were last reset.
+func (b *RunEndEncodedBuilder) Err() error {
+	if b.err != nil {
+		return b.err
+	}
+	return b.values.Err()
+}
+
+// Append starts a new run of n elements.
+// The value of the run must be appended to the ValueBuilder.
+func (b *RunEndEncodedBuilder) Append(n int) {
+	if !b.addRun("Append", n) {
+		return
+	}
+	b.hasLast = false
+}
+
+// ContinueRun extends the last run by n elements.
+func (b *RunEndEncodedBuilder) ContinueRun(n int) {
+	switch {
+	case b.runEnds.Len() == 0:
+		b.invalid(b, "ContinueRun", "no run to continue")
+	case n < 0:
+		b.invalid(b, "ContinueRun", fmt.Sprintf("invalid run length %d", n))
+	case b.length+n > b.maxEnd:
+		b.invalid(b, "ContinueRun", fmt.Sprintf("run end overflows %v", b.dtype.RunEnds))
+	default:
+		b.length += n
+		switch ends := b.runEnds.(type) {
+		case *Int16Builder:
+			ends.rawData[ends.length-1] = int16(b.length)
+		case *Int32Builder:
+			ends.rawData[ends.length-1] = int32(b.length)
+		case *Int64Builder:
+			ends.rawData[ends.length-1] = int64(b.length)
+		}
+	}
+}
+
+// AppendNull appends a null element, extending the last run if it is a
+// null run appended by AppendNull or AppendValue.
+func (b *RunEndEncodedBuilder) AppendNull() {
+	if b.hasLast && b.last == nil {
+		b.ContinueRun(1)
+		return
+	}
+	if !b.addRun("AppendNull", 1) {
+		return
+	}
+	b.values.AppendNull()
+	b.last, b.hasLast = nil, true
+}
+
+// AppendNulls appends n null elements, as a single null run, extending the
+// last run if it is a null run appended by AppendNull or AppendValue.
+func (b *RunEndEncodedBuilder) AppendNulls(n int) {
+	if n == 0 {
+		return
+	}
+	if b.hasLast && b.last == nil {
+		b.ContinueRun(n)
+		return
+	}
+	if !b.addRun("AppendNulls", n) {
+		return
+	}
+	b.values.AppendNull()
+	b.last, b.hasLast = nil, true
+}
+
+// AppendEmptyValue appends a new run of a single element, holding the empty
+// value of the ValueBuilder.
+func (b *RunEndEncodedBuilder) AppendEmptyValue() {
+	if !b.addRun("AppendEmptyValue", 1) {
+		return
+	}
+	b.values.AppendEmptyValue()
+	b.hasLast = false
+}
+
+// AppendValue appends the Go value v as a single element, converted to the
+// values data type as RecordFromMaps does. A nil v appends a null element.
+//
+// AppendValue extends the last run instead of starting a new one when v is
+// deeply equal to the value of the last run, if that run was appended by
+// AppendValue or AppendNull.
+func (b *RunEndEncodedBuilder) AppendValue(v interface{}) error {
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+	if b.hasLast && b.last != nil && reflect.DeepEqual(b.last, v) {
+		b.ContinueRun(1)
+		return nil
+	}
+	if b.length+1 > b.maxEnd {
+		return fmt.Errorf("arrow/array: run end overflows %v", b.dtype.RunEnds)
+	}
+	if err := appendGoValue(b.values, v); err != nil {
+		return err
+	}
+	b.addRun("AppendValue", 1)
+	b.last, b.hasLast = v, true
+	return nil
+}
+
+// addRun appends a new run of n elements to the run ends and reports
+// whether it succeeded.
+func (b *RunEndEncodedBuilder) addRun(op string, n int) bool {
+	switch {
+	case n <= 0:
+		b.invalid(b, op, fmt.Sprintf("invalid run length %d", n))
+		return false
+	case b.length+n > b.maxEnd:
+		b.invalid(b, op, fmt.Sprintf("run end overflows %v", b.dtype.RunEnds))
+		return false
+	}
+
+	b.length += n
+	switch ends := b.runEnds.(type) {
+	case *Int16Builder:
+		ends.Append(int16(b.length))
+	case *Int32Builder:
+		ends.Append(int32(b.length))
+	case *Int64Builder:
+		ends.Append(int64(b.length))
+	}
+	return true
+}
+
+// Reserve ensures there is enough space for appending n runs.
+func (b *RunEndEncodedBuilder) Reserve(n int) {
+	b.runEnds.Reserve(n)
+	b.values.Reserve(n)
+}
+
+// Resize adjusts the space allocated by b to n runs.
+func (b *RunEndEncodedBuilder) Resize(n int) {
+	b.runEnds.Resize(n)
+	b.values.Resize(n)
+}
+
+func (*RunEndEncodedBuilder) init(capacity int)                  {}
+func (*RunEndEncodedBuilder) resize(newBits int, init func(int)) {}
+
+// NewArray creates a RunEndEncoded array from the memory buffers used by the builder and resets the RunEndEncodedBuilder
+// so it can be used to build a new array.
+func (b *RunEndEncodedBuilder) NewArray() Interface {
+	return b.NewRunEndEncodedArray()
+}
+
+// NewRunEndEncodedArray creates a RunEndEncoded array from the memory buffers used by the builder and resets the RunEndEncodedBuilder
+// so it can be used to build a new array.
+func (b *RunEndEncodedBuilder) NewRunEndEncodedArray() (a *RunEndEncoded) {
+	data := b.newData()
+	a = NewRunEndEncodedData(data)
+	data.Release()
+	return
+}
+
+func (b *RunEndEncodedBuilder) newData() (data *Data) {
+	ends := b.runEnds.NewArray()
+	defer ends.Release()
+	values := b.values.NewArray()
+	defer values.Release()
+
+	data = NewData(
+		b.dtype, b.length,
+		[]*memory.Buffer{nil},
+		[]*Data{ends.Data(), values.Data()},
+		0, 0,
+	)
+	b.reset()
+	b.last, b.hasLast = nil, false
+
+	return
+}
+
+var (
+	_ Interface = (*RunEndEncoded)(nil)
+	_ Builder   = (*RunEndEncodedBuilder)(nil)
+)
@@ -0,0 +1,218 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestRunEndEncoded(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, ends := range []arrow.DataType{
+		arrow.PrimitiveTypes.Int16,
+		arrow.PrimitiveTypes.Int32,
+		arrow.PrimitiveTypes.Int64,
+	} {
+		t.Run(ends.Name(), func(t *testing.T) {
+			var (
+				rng  = rand.New(rand.NewSource(1))
+				reeb = array.NewRunEndEncodedBuilder(mem, ends, arrow.PrimitiveTypes.Int32)
+				plb  = array.NewInt32Builder(mem)
+				runs = 0
+				last = -1
+			)
+			defer reeb.Release()
+			defer plb.Release()
+
+			for i := 0; i < 1000; i++ {
+				v := rng.Intn(4) // small range, to get runs of various lengths.
+				if v != last {
+					runs++
+				}
+				last = v
+				if v == 0 {
+					reeb.AppendNull()
+					plb.AppendNull()
+					continue
+				}
+				if err := reeb.AppendValue(int32(v)); err != nil {
+					t.Fatal(err)
+				}
+				plb.Append(int32(v))
+			}
+
+			ree := reeb.NewRunEndEncodedArray()
+			defer ree.Release()
+			plain := plb.NewInt32Array()
+			defer plain.Release()
+
+			if got, want := ree.LogicalLength(), plain.Len(); got != want {
+				t.Fatalf("invalid logical length: got=%d, want=%d", got, want)
+			}
+			if got, want := ree.PhysicalLength(), runs; got != want {
+				t.Fatalf("invalid physical length: got=%d, want=%d", got, want)
+			}
+			if got, want := ree.RunEnds().Len(), runs; got != want {
+				t.Fatalf("invalid number of run ends: got=%d, want=%d", got, want)
+			}
+
+			vals := ree.Values().(*array.Int32)
+			for i := 0; i < plain.Len(); i++ {
+				j := ree.PhysicalIndex(i)
+				if got, want := vals.IsNull(j), plain.IsNull(i); got != want {
+					t.Fatalf("invalid null at %d: got=%v, want=%v", i, got, want)
+				}
+				if plain.IsValid(i) && vals.Value(j) != plain.Value(i) {
+					t.Fatalf("invalid value at %d: got=%v, want=%v", i, vals.Value(j), plain.Value(i))
+				}
+			}
+
+			for _, s := range []struct{ i, j int64 }{
+				{0, 1000}, {0, 0}, {1, 2}, {17, 513}, {999, 1000},
+			} {
+				rs := array.NewSlice(ree, s.i, s.j).(*array.RunEndEncoded)
+				ps := array.NewSlice(plain, s.i, s.j).(*array.Int32)
+
+				if got, want := rs.Len(), ps.Len(); got != want {
+					t.Fatalf("slice [%d:%d]: invalid length: got=%d, want=%d", s.i, s.j, got, want)
+				}
+				var want int
+				for i := 0; i < ps.Len(); i++ {
+					if i == 0 || ps.IsNull(i) != ps.IsNull(i-1) || (ps.IsValid(i) && ps.Value(i) != ps.Value(i-1)) {
+						want++
+					}
+				}
+				if got := rs.PhysicalLength(); got != want {
+					t.Fatalf("slice [%d:%d]: invalid physical length: got=%d, want=%d", s.i, s.j, got, want)
+				}
+				if got, want := rs.String(), ps.String(); got != want {
+					t.Fatalf("slice [%d:%d]: invalid string:\ngot= %s\nwant=%s", s.i, s.j, got, want)
+				}
+
+				rs.Release()
+				ps.Release()
+			}
+		})
+	}
+}
+
+func TestRunEndEncodedExplicitRuns(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewRunEndEncodedBuilder(mem, arrow.PrimitiveTypes.Int32, arrow.BinaryTypes.String)
+	defer b.Release()
+
+	vb := b.ValueBuilder().(*array.StringBuilder)
+	b.Append(2)
+	vb.Append("a")
+	b.ContinueRun(1)
+	b.Append(1)
+	vb.AppendNull()
+	b.Append(2)
+	vb.Append("a") // explicit runs are not coalesced.
+
+	arr := b.NewRunEndEncodedArray()
+	defer arr.Release()
+
+	if got, want := arr.Len(), 6; got != want {
+		t.Fatalf("invalid length: got=%d, want=%d", got, want)
+	}
+	if got, want := arr.PhysicalLength(), 3; got != want {
+		t.Fatalf("invalid physical length: got=%d, want=%d", got, want)
+	}
+	if got, want := arr.String(), `["a" "a" "a" (null) "a" "a"]`; got != want {
+		t.Fatalf("invalid string: got=%s, want=%s", got, want)
+	}
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.AppendValues([]string{"a", "b"}, nil)
+	values := sb.NewArray()
+	defer values.Release()
+
+	eb := array.NewInt32Builder(mem)
+	defer eb.Release()
+	eb.AppendValues([]int32{3, 6}, nil)
+	ends := eb.NewArray()
+	defer ends.Release()
+
+	other := array.NewRunEndEncoded(ends, values, 5, 1)
+	defer other.Release()
+	if got, want := other.String(), `["a" "a" "b" "b" "b"]`; got != want {
+		t.Fatalf("invalid string: got=%s, want=%s", got, want)
+	}
+
+	lhs := array.NewSlice(arr, 0, 3)
+	defer lhs.Release()
+	rhs := array.NewSlice(other, 0, 2)
+	defer rhs.Release()
+	if array.ArrayEqual(lhs, rhs) {
+		t.Fatalf("arrays of different lengths should not compare equal")
+	}
+	// logically equal arrays, with different runs and offsets.
+	lhs2 := array.NewSlice(arr, 4, 6)
+	defer lhs2.Release()
+	if !array.ArrayEqual(lhs2, rhs) || !array.ArrayApproxEqual(lhs2, rhs) {
+		t.Fatalf("arrays should compare equal:\nlhs=%v\nrhs=%v", lhs2, rhs)
+	}
+	if array.ArrayEqual(arr, other) {
+		t.Fatalf("arrays should not compare equal")
+	}
+
+	defer func() {
+		if e := recover(); e == nil {
+			t.Fatalf("expected a panic for run ends not covering the array")
+		}
+	}()
+	array.NewRunEndEncoded(ends, values, 6, 1).Release()
+}
+
+func TestRunEndEncodedBuilderErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewRunEndEncodedBuilder(mem, arrow.PrimitiveTypes.Int16, arrow.PrimitiveTypes.Int8)
+	defer b.Release()
+	b.SetCollectErrors(true)
+
+	b.ContinueRun(1)
+	if b.Err() == nil {
+		t.Fatalf("expected an error continuing a missing run")
+	}
+	b.NewArray().Release()
+
+	b.Append(1<<15 - 1)
+	b.ValueBuilder().AppendNull()
+	b.ContinueRun(1)
+	if b.Err() == nil {
+		t.Fatalf("expected an error overflowing the run ends")
+	}
+	b.NewArray().Release()
+
+	if err := b.AppendValue("a"); err == nil {
+		t.Fatalf("expected an error appending an invalid value")
+	}
+	b.NewArray().Release()
+}
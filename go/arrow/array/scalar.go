@@ -0,0 +1,67 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package array
+
+import (
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Scalar is a single, possibly null, value of an Arrow data type.
+// A Scalar is backed by an array of length 1.
+type Scalar struct {
+	arr Interface
+}
+
+// NewScalar returns a scalar holding the i-th element of arr.
+// The scalar shares the memory of arr, which is retained until the scalar
+// is released.
+//
+// NewScalar panics if i is out of range.
+func NewScalar(arr Interface, i int) *Scalar {
+	return &Scalar{arr: NewSlice(arr, int64(i), int64(i+1))}
+}
+
+// NewScalarValue returns a scalar of type dtype holding the Go value v, or a
+// null scalar if v is nil. v is coerced to dtype as with RecordFromMaps.
+func NewScalarValue(dtype arrow.DataType, v interface{}, mem memory.Allocator) (*Scalar, error) {
+	b := NewBuilder(mem, dtype)
+	defer b.Release()
+
+	if err := appendGoValue(b, v); err != nil {
+		return nil, err
+	}
+	return &Scalar{arr: b.NewArray()}, nil
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (s *Scalar) Retain() { s.arr.Retain() }
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+// Release may be called simultaneously from multiple goroutines.
+func (s *Scalar) Release() { s.arr.Release() }
+
+// DataType returns the type metadata for this scalar.
+func (s *Scalar) DataType() arrow.DataType { return s.arr.DataType() }
+
+// IsValid returns whether the scalar is not null.
+func (s *Scalar) IsValid() bool { return s.arr.IsValid(0) }
+
+// Array returns the array of length 1 holding the value of the scalar.
+// The array is owned by the scalar: it must be retained to outlive it.
+func (s *Scalar) Array() Interface { return s.arr }
@@ -0,0 +1,75 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestScalar(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewInt64Builder(mem)
+	defer b.Release()
+
+	b.AppendValues([]int64{1, 2, 3}, []bool{true, false, true})
+	arr := b.NewArray()
+
+	s := array.NewScalar(arr, 2)
+	arr.Release()
+	defer s.Release()
+
+	if got, want := s.DataType(), arrow.PrimitiveTypes.Int64; !arrow.TypeEquals(got, want) {
+		t.Fatalf("invalid data type: got=%v, want=%v", got, want)
+	}
+	if !s.IsValid() {
+		t.Fatalf("expected a valid scalar")
+	}
+	if got, want := fmt.Sprintf("%v", s.Array()), "[3]"; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	v, err := array.NewScalarValue(arrow.PrimitiveTypes.Int64, nil, mem)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer v.Release()
+
+	if v.IsValid() {
+		t.Fatalf("expected a null scalar")
+	}
+
+	v, err = array.NewScalarValue(arrow.PrimitiveTypes.Int8, 42, mem)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer v.Release()
+
+	if got, want := fmt.Sprintf("%v", v.Array()), "[42]"; got != want {
+		t.Fatalf("got=%v, want=%v", got, want)
+	}
+
+	if _, err := array.NewScalarValue(arrow.PrimitiveTypes.Int8, 1000, mem); err == nil {
+		t.Fatalf("expected an error")
+	}
+}
@@ -0,0 +1,132 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"math"
+)
+
+// Statistics holds summary statistics of the elements of an array.
+type Statistics struct {
+	NullCount     int // number of null elements.
+	DistinctCount int // number of distinct non-null elements.
+
+	// Min and Max are the smallest and the largest non-null elements, as
+	// Go values of the element type (float32 for Float16 arrays, integers
+	// for temporal arrays), or nil if all the elements are null. Strings
+	// are ordered by their bytes, and false is less than true.
+	// NaN elements are ignored, unless all the non-null elements are NaN.
+	Min, Max interface{}
+}
+
+// ComputeStatistics returns the statistics of the elements of arr.
+// Distinct elements are counted exactly, NaN elements being considered equal
+// to each other.
+//
+// ComputeStatistics supports boolean, numeric, temporal and string arrays.
+func ComputeStatistics(arr Interface) (Statistics, error) {
+	_, firsts, err := hashGroups(arr, nil)
+	if err != nil {
+		return Statistics{}, err
+	}
+
+	st := Statistics{
+		NullCount:     arr.NullN(),
+		DistinctCount: len(firsts),
+	}
+	if st.NullCount > 0 {
+		st.DistinctCount-- // the group of the null elements.
+	}
+
+	st.Min, st.Max, err = minMax(arr)
+	if err != nil {
+		return Statistics{}, err
+	}
+	return st, nil
+}
+
+// minMax returns the smallest and the largest non-null elements of arr, or
+// nil if all of them are null. NaN elements are ignored, unless all the
+// non-null elements are NaN.
+func minMax(arr Interface) (min, max interface{}, err error) {
+	value, ok := goValueFunc(arr)
+	if !ok {
+		return nil, nil, fmt.Errorf("arrow/array: statistics not supported for %v arrays", arr.DataType())
+	}
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			continue
+		}
+		v := value(i)
+		switch {
+		case min == nil || isNaNValue(min) && !isNaNValue(v):
+			min, max = v, v
+		case isNaNValue(v):
+			// NaN never compares, and is only kept if there is nothing else.
+		case lessValue(v, min):
+			min = v
+		case lessValue(max, v):
+			max = v
+		}
+	}
+	return min, max, nil
+}
+
+func isNaNValue(v interface{}) bool {
+	switch v := v.(type) {
+	case float32:
+		return math.IsNaN(float64(v))
+	case float64:
+		return math.IsNaN(v)
+	}
+	return false
+}
+
+// lessValue reports whether a is less than b, which must be Go values of the
+// same type, as returned by goValueFunc.
+func lessValue(a, b interface{}) bool {
+	switch a := a.(type) {
+	case bool:
+		return !a && b.(bool)
+	case int8:
+		return a < b.(int8)
+	case int16:
+		return a < b.(int16)
+	case int32:
+		return a < b.(int32)
+	case int64:
+		return a < b.(int64)
+	case uint8:
+		return a < b.(uint8)
+	case uint16:
+		return a < b.(uint16)
+	case uint32:
+		return a < b.(uint32)
+	case uint64:
+		return a < b.(uint64)
+	case float32:
+		return a < b.(float32)
+	case float64:
+		return a < b.(float64)
+	case string:
+		return a < b.(string)
+	default:
+		panic(fmt.Errorf("arrow/array: invalid value type %T", a))
+	}
+}
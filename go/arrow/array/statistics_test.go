@@ -0,0 +1,133 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestComputeStatistics(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, tc := range []struct {
+		name string
+		arr  func() array.Interface
+		want array.Statistics
+	}{
+		{
+			name: "int32",
+			arr: func() array.Interface {
+				b := array.NewInt32Builder(mem)
+				defer b.Release()
+				b.AppendValues([]int32{3, 0, -7, 3, 12, 0}, []bool{true, false, true, true, true, false})
+				return b.NewArray()
+			},
+			want: array.Statistics{NullCount: 2, DistinctCount: 3, Min: int32(-7), Max: int32(12)},
+		},
+		{
+			name: "float64",
+			arr: func() array.Interface {
+				b := array.NewFloat64Builder(mem)
+				defer b.Release()
+				b.AppendValues([]float64{math.NaN(), 2.5, -1, math.NaN(), 0}, []bool{true, true, true, true, false})
+				return b.NewArray()
+			},
+			want: array.Statistics{NullCount: 1, DistinctCount: 3, Min: -1.0, Max: 2.5},
+		},
+		{
+			name: "string",
+			arr: func() array.Interface {
+				b := array.NewStringBuilder(mem)
+				defer b.Release()
+				b.AppendValues([]string{"b", "B", "ab", "b"}, nil)
+				return b.NewArray()
+			},
+			want: array.Statistics{NullCount: 0, DistinctCount: 3, Min: "B", Max: "b"},
+		},
+		{
+			name: "all-null",
+			arr: func() array.Interface {
+				b := array.NewUint8Builder(mem)
+				defer b.Release()
+				b.AppendNulls(3)
+				return b.NewArray()
+			},
+			want: array.Statistics{NullCount: 3, DistinctCount: 0},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			arr := tc.arr()
+			defer arr.Release()
+
+			got, err := array.ComputeStatistics(arr)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("invalid statistics:\ngot= %+v\nwant=%+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRecordColumnStatistics(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "i64", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		{Name: "str", Type: arrow.BinaryTypes.String},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int64Builder).AppendValues([]int64{5, 0, 1}, []bool{true, false, true})
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"x", "y", "x"}, nil)
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	got, err := rec.ColumnStatistics()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []array.Statistics{
+		{NullCount: 1, DistinctCount: 2, Min: int64(1), Max: int64(5)},
+		{NullCount: 0, DistinctCount: 2, Min: "x", Max: "y"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid statistics:\ngot= %+v\nwant=%+v", got, want)
+	}
+
+	lb := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int32)
+	defer lb.Release()
+	lb.AppendNull()
+	list := lb.NewArray()
+	defer list.Release()
+
+	rec2 := array.NewRecord(arrow.NewSchema([]arrow.Field{{Name: "list", Type: list.DataType()}}, nil), []array.Interface{list}, 1)
+	defer rec2.Release()
+	if _, err := rec2.ColumnStatistics(); err == nil {
+		t.Fatalf("expected an error for unsupported column types")
+	}
+}
@@ -70,6 +70,21 @@ func (a *String) String() string {
 	return o.String()
 }
 
+// Range calls fn sequentially for each element of the array, with its index,
+// value and validity, until fn returns false.
+// The value passed for a null element is unspecified.
+func (a *String) Range(fn func(i int, v string, valid bool) bool) {
+	var (
+		nulls   = a.NullN() > 0
+		offsets = a.offsets[a.array.data.offset:]
+	)
+	for i := 0; i < a.array.data.length; i++ {
+		if !fn(i, a.values[offsets[i]:offsets[i+1]], !nulls || a.IsValid(i)) {
+			return
+		}
+	}
+}
+
 func (a *String) setData(data *Data) {
 	if len(data.buffers) != 3 {
 		panic("arrow/array: len(data.buffers) != 3")
@@ -134,6 +149,15 @@ func (b *StringBuilder) Cap() int { return b.builder.Cap() }
 // NullN returns the number of null values in the array builder.
 func (b *StringBuilder) NullN() int { return b.builder.NullN() }
 
+// SetCollectErrors configures how the builder handles invalid calls.
+// See Builder.SetCollectErrors.
+func (b *StringBuilder) SetCollectErrors(v bool) { b.builder.SetCollectErrors(v) }
+
+// Err returns the first error recorded by the builder since it was last reset.
+func (b *StringBuilder) Err() error { return b.builder.Err() }
+
+func (b *StringBuilder) collectErrors() bool { return b.builder.collectErrors() }
+
 func (b *StringBuilder) Append(v string) {
 	b.builder.Append([]byte(v))
 }
@@ -142,13 +166,49 @@ func (b *StringBuilder) AppendNull() {
 	b.builder.AppendNull()
 }
 
+func (b *StringBuilder) AppendNulls(n int) {
+	b.builder.AppendNulls(n)
+}
+
+// AppendEmptyValue appends an empty string.
+func (b *StringBuilder) AppendEmptyValue() {
+	b.builder.AppendEmptyValue()
+}
+
 // AppendValues will append the values in the v slice. The valid slice determines which values
 // in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
 // all values in v are appended and considered valid.
+// The offsets and the data buffer are sized for all the values up front.
 func (b *StringBuilder) AppendValues(v []string, valid []bool) {
+	if len(v) != len(valid) && len(valid) != 0 {
+		b.builder.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
+	}
 	b.builder.AppendStringValues(v, valid)
 }
 
+// AppendArraySlice appends the length values of src starting at index start,
+// along with their validity. The value data and the validity bitmap are
+// copied in bulk, and the value offsets are rebased.
+func (b *StringBuilder) AppendArraySlice(src *String, start, length int) {
+	if !validArraySlice(src, start, length) {
+		b.builder.invalid(b, "AppendArraySlice", "slice out of range of src")
+		return
+	}
+
+	if length == 0 {
+		return
+	}
+
+	beg := src.Offset() + start
+	offsets := src.offsets[beg : beg+length+1]
+	b.builder.Reserve(length)
+	b.builder.ReserveData(int(offsets[length] - offsets[0]))
+	b.builder.unsafeAppendOffsets(offsets)
+	b.builder.values.unsafeAppendString(src.values[offsets[0]:offsets[length]])
+	b.builder.builder.unsafeAppendBitmap(src.NullBitmapBytes(), beg, length)
+}
+
 func (b *StringBuilder) Value(i int) string {
 	return string(b.builder.Value(i))
 }
@@ -161,12 +221,32 @@ func (b *StringBuilder) resize(newBits int, init func(int)) {
 	b.builder.resize(newBits, init)
 }
 
+func (b *StringBuilder) setGrowth(g growthPolicy) {
+	b.builder.setGrowth(g)
+}
+
 // Reserve ensures there is enough space for appending n elements
 // by checking the capacity and calling Resize if necessary.
+// Reserve only sizes the offsets and the validity bitmap: use ReserveData
+// to size the data buffer holding the bytes of the strings.
 func (b *StringBuilder) Reserve(n int) {
 	b.builder.Reserve(n)
 }
 
+// ReserveData ensures there is enough space for appending n bytes of
+// string data, by checking the capacity and resizing the data buffer if
+// necessary. See BinaryBuilder.ReserveData.
+func (b *StringBuilder) ReserveData(n int) {
+	b.builder.ReserveData(n)
+}
+
+// DataLen returns the number of bytes of string data in the builder.
+func (b *StringBuilder) DataLen() int { return b.builder.DataLen() }
+
+// DataCap returns the total number of bytes of string data that can be
+// stored without allocating additional memory.
+func (b *StringBuilder) DataCap() int { return b.builder.DataCap() }
+
 // Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
 // additional memory will be allocated. If n is smaller, the allocated memory may reduced.
 func (b *StringBuilder) Resize(n int) {
@@ -188,7 +268,216 @@ func (b *StringBuilder) NewStringArray() (a *String) {
 	return
 }
 
+// A type which represents an immutable sequence of variable-length UTF-8 strings,
+// using 64-bit offsets.
+type LargeString struct {
+	array
+	offsets []int64
+	values  string
+}
+
+// NewLargeStringData constructs a new LargeString array from data.
+func NewLargeStringData(data *Data) *LargeString {
+	a := &LargeString{}
+	a.refCount = 1
+	a.setData(data)
+	return a
+}
+
+// Value returns the slice at index i. This value should not be mutated.
+func (a *LargeString) Value(i int) string {
+	i = i + a.array.data.offset
+	return a.values[a.offsets[i]:a.offsets[i+1]]
+}
+func (a *LargeString) ValueOffset(i int) int64 { return a.offsets[a.array.data.offset+i] }
+
+// Offsets returns the value offsets of the array, including the array offset.
+func (a *LargeString) Offsets() []int64 {
+	beg := a.array.data.offset
+	end := beg + a.array.data.length + 1
+	return a.offsets[beg:end]
+}
+
+func (a *LargeString) String() string {
+	o := new(strings.Builder)
+	o.WriteString("[")
+	for i := 0; i < a.Len(); i++ {
+		if i > 0 {
+			o.WriteString(" ")
+		}
+		switch {
+		case a.IsNull(i):
+			o.WriteString("(null)")
+		default:
+			fmt.Fprintf(o, "%q", a.Value(i))
+		}
+	}
+	o.WriteString("]")
+	return o.String()
+}
+
+func (a *LargeString) setData(data *Data) {
+	if len(data.buffers) != 3 {
+		panic("arrow/array: len(data.buffers) != 3")
+	}
+
+	a.array.setData(data)
+
+	if vdata := data.buffers[2]; vdata != nil {
+		b := vdata.Bytes()
+		a.values = *(*string)(unsafe.Pointer(&b))
+	}
+
+	if offsets := data.buffers[1]; offsets != nil {
+		a.offsets = arrow.Int64Traits.CastFromBytes(offsets.Bytes())
+	}
+}
+
+func arrayEqualLargeString(left, right *LargeString) bool {
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) {
+			continue
+		}
+		if left.Value(i) != right.Value(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// A LargeStringBuilder is used to build a LargeString array using the Append methods.
+type LargeStringBuilder struct {
+	builder *BinaryBuilder
+}
+
+func NewLargeStringBuilder(mem memory.Allocator) *LargeStringBuilder {
+	b := &LargeStringBuilder{
+		builder: NewBinaryBuilder(mem, arrow.BinaryTypes.LargeString),
+	}
+	return b
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+// Release may be called simultaneously from multiple goroutines.
+func (b *LargeStringBuilder) Release() {
+	b.builder.Release()
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (b *LargeStringBuilder) Retain() {
+	b.builder.Retain()
+}
+
+// Len returns the number of elements in the array builder.
+func (b *LargeStringBuilder) Len() int { return b.builder.Len() }
+
+// Cap returns the total number of elements that can be stored without allocating additional memory.
+func (b *LargeStringBuilder) Cap() int { return b.builder.Cap() }
+
+// NullN returns the number of null values in the array builder.
+func (b *LargeStringBuilder) NullN() int { return b.builder.NullN() }
+
+// SetCollectErrors configures how the builder handles invalid calls.
+// See Builder.SetCollectErrors.
+func (b *LargeStringBuilder) SetCollectErrors(v bool) { b.builder.SetCollectErrors(v) }
+
+// Err returns the first error recorded by the builder since it was last reset.
+func (b *LargeStringBuilder) Err() error { return b.builder.Err() }
+
+func (b *LargeStringBuilder) collectErrors() bool { return b.builder.collectErrors() }
+
+func (b *LargeStringBuilder) Append(v string) {
+	b.builder.Append([]byte(v))
+}
+
+func (b *LargeStringBuilder) AppendNull() {
+	b.builder.AppendNull()
+}
+
+func (b *LargeStringBuilder) AppendNulls(n int) {
+	b.builder.AppendNulls(n)
+}
+
+// AppendEmptyValue appends an empty string.
+func (b *LargeStringBuilder) AppendEmptyValue() {
+	b.builder.AppendEmptyValue()
+}
+
+// AppendValues will append the values in the v slice. The valid slice determines which values
+// in v are valid (not null). The valid slice must either be empty or be equal in length to v. If empty,
+// all values in v are appended and considered valid.
+func (b *LargeStringBuilder) AppendValues(v []string, valid []bool) {
+	if len(v) != len(valid) && len(valid) != 0 {
+		b.builder.invalid(b, "AppendValues", "len(v) != len(valid) && len(valid) != 0")
+		return
+	}
+	b.builder.AppendStringValues(v, valid)
+}
+
+func (b *LargeStringBuilder) Value(i int) string {
+	return string(b.builder.Value(i))
+}
+
+func (b *LargeStringBuilder) init(capacity int) {
+	b.builder.init(capacity)
+}
+
+func (b *LargeStringBuilder) resize(newBits int, init func(int)) {
+	b.builder.resize(newBits, init)
+}
+
+func (b *LargeStringBuilder) setGrowth(g growthPolicy) {
+	b.builder.setGrowth(g)
+}
+
+// Reserve ensures there is enough space for appending n elements
+// by checking the capacity and calling Resize if necessary.
+// Reserve only sizes the offsets and the validity bitmap: use ReserveData
+// to size the data buffer holding the bytes of the strings.
+func (b *LargeStringBuilder) Reserve(n int) {
+	b.builder.Reserve(n)
+}
+
+// ReserveData ensures there is enough space for appending n bytes of
+// string data, by checking the capacity and resizing the data buffer if
+// necessary. See BinaryBuilder.ReserveData.
+func (b *LargeStringBuilder) ReserveData(n int) {
+	b.builder.ReserveData(n)
+}
+
+// DataLen returns the number of bytes of string data in the builder.
+func (b *LargeStringBuilder) DataLen() int { return b.builder.DataLen() }
+
+// DataCap returns the total number of bytes of string data that can be
+// stored without allocating additional memory.
+func (b *LargeStringBuilder) DataCap() int { return b.builder.DataCap() }
+
+// Resize adjusts the space allocated by b to n elements. If n is greater than b.Cap(),
+// additional memory will be allocated. If n is smaller, the allocated memory may reduced.
+func (b *LargeStringBuilder) Resize(n int) {
+	b.builder.Resize(n)
+}
+
+// NewArray creates a LargeString array from the memory buffers used by the builder and resets the LargeStringBuilder
+// so it can be used to build a new array.
+func (b *LargeStringBuilder) NewArray() Interface {
+	return b.NewLargeStringArray()
+}
+
+// NewLargeStringArray creates a LargeString array from the memory buffers used by the builder and resets the LargeStringBuilder
+// so it can be used to build a new array.
+func (b *LargeStringBuilder) NewLargeStringArray() (a *LargeString) {
+	data := b.builder.newData()
+	a = NewLargeStringData(data)
+	data.Release()
+	return
+}
+
 var (
 	_ Interface = (*String)(nil)
 	_ Builder   = (*StringBuilder)(nil)
+	_ Interface = (*LargeString)(nil)
+	_ Builder   = (*LargeStringBuilder)(nil)
 )
@@ -0,0 +1,146 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// StringLength returns the length in bytes of each element of arr.
+// Null elements have a null length.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+func StringLength(arr *String) *Int32 {
+	bldr := NewInt32Builder(memory.DefaultAllocator)
+	defer bldr.Release()
+
+	bldr.Reserve(arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			bldr.UnsafeAppendBoolToBitmap(false)
+			continue
+		}
+		bldr.UnsafeAppend(int32(len(arr.Value(i))))
+	}
+	return bldr.NewInt32Array()
+}
+
+// StringCodepointLength returns the number of Unicode code points of each
+// element of arr. Null elements have a null length.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+//
+// StringCodepointLength returns an error if an element of arr is not valid
+// UTF-8.
+func StringCodepointLength(arr *String) (*Int32, error) {
+	bldr := NewInt32Builder(memory.DefaultAllocator)
+	defer bldr.Release()
+
+	bldr.Reserve(arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			bldr.UnsafeAppendBoolToBitmap(false)
+			continue
+		}
+		v := arr.Value(i)
+		if !utf8.ValidString(v) {
+			return nil, fmt.Errorf("arrow/array: invalid UTF-8 value at index %d", i)
+		}
+		bldr.UnsafeAppend(int32(utf8.RuneCountInString(v)))
+	}
+	return bldr.NewInt32Array(), nil
+}
+
+// Upper returns a copy of arr with all Unicode letters mapped to their
+// upper case. Null elements stay null, and invalid UTF-8 sequences are
+// replaced with the Unicode replacement character.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+func Upper(arr *String) *String {
+	return mapStrings(arr, strings.ToUpper)
+}
+
+// Lower returns a copy of arr with all Unicode letters mapped to their
+// lower case. Null elements stay null, and invalid UTF-8 sequences are
+// replaced with the Unicode replacement character.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+func Lower(arr *String) *String {
+	return mapStrings(arr, strings.ToLower)
+}
+
+// Substring returns the substrings of the elements of arr holding length
+// code points from the code point at index start. A negative start counts
+// from the end of the element: -1 is its last code point.
+// Bounds outside of an element are clamped to it, yielding a shorter or an
+// empty substring, as does a negative length. Null elements stay null.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// released after use.
+func Substring(arr *String, start, length int) *String {
+	return mapStrings(arr, func(v string) string {
+		n := utf8.RuneCountInString(v)
+		beg := start
+		if beg < 0 {
+			beg += n
+		}
+		beg = clampInt(beg, 0, n)
+		end := beg + clampInt(length, 0, n-beg)
+		return v[runeOffset(v, beg):runeOffset(v, end)]
+	})
+}
+
+// runeOffset returns the byte offset of the i-th code point of v, or len(v)
+// if v has i code points.
+func runeOffset(v string, i int) int {
+	for off := range v {
+		if i == 0 {
+			return off
+		}
+		i--
+	}
+	return len(v)
+}
+
+func clampInt(v, lo, hi int) int {
+	switch {
+	case v < lo:
+		return lo
+	case v > hi:
+		return hi
+	}
+	return v
+}
+
+// mapStrings returns the array of the elements of arr mapped with fn.
+func mapStrings(arr *String, fn func(string) string) *String {
+	bldr := NewStringBuilder(memory.DefaultAllocator)
+	defer bldr.Release()
+
+	bldr.Reserve(arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+		bldr.Append(fn(arr.Value(i)))
+	}
+	return bldr.NewStringArray()
+}
@@ -0,0 +1,119 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func newStrings(mem memory.Allocator, vs []string, valids []bool) *array.String {
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+	b.AppendValues(vs, valids)
+	return b.NewStringArray()
+}
+
+func TestStringLength(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	arr := newStrings(mem, []string{"abc", "", "héllo", "日本語", "x"}, []bool{true, true, true, true, false})
+	defer arr.Release()
+
+	lens := array.StringLength(arr)
+	defer lens.Release()
+	if got, want := lens.String(), "[3 0 6 9 (null)]"; got != want {
+		t.Fatalf("invalid lengths: got=%s, want=%s", got, want)
+	}
+
+	cps, err := array.StringCodepointLength(arr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer cps.Release()
+	if got, want := cps.String(), "[3 0 5 3 (null)]"; got != want {
+		t.Fatalf("invalid code point lengths: got=%s, want=%s", got, want)
+	}
+
+	invalid := newStrings(mem, []string{"ok", "b\xffd"}, nil)
+	defer invalid.Release()
+	_, err = array.StringCodepointLength(invalid)
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if got, want := err.Error(), "arrow/array: invalid UTF-8 value at index 1"; got != want {
+		t.Fatalf("invalid error: got=%q, want=%q", got, want)
+	}
+}
+
+func TestUpperLower(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	arr := newStrings(mem, []string{"Hello", "", "straße", "ÉTÉ", "x"}, []bool{true, true, true, true, false})
+	defer arr.Release()
+
+	upper := array.Upper(arr)
+	defer upper.Release()
+	if got, want := upper.String(), `["HELLO" "" "STRAßE" "ÉTÉ" (null)]`; got != want {
+		t.Fatalf("invalid upper: got=%s, want=%s", got, want)
+	}
+
+	lower := array.Lower(arr)
+	defer lower.Release()
+	if got, want := lower.String(), `["hello" "" "straße" "été" (null)]`; got != want {
+		t.Fatalf("invalid lower: got=%s, want=%s", got, want)
+	}
+}
+
+func TestSubstring(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	arr := newStrings(mem, []string{"héllo", "日本語", "", "abc"}, []bool{true, true, true, false})
+	defer arr.Release()
+
+	for _, tc := range []struct {
+		start, length int
+		want          []string
+	}{
+		{0, 2, []string{"hé", "日本", "", ""}},
+		{1, 3, []string{"éll", "本語", "", ""}},
+		{-2, 1, []string{"l", "本", "", ""}},
+		{-10, 2, []string{"hé", "日本", "", ""}},
+		{3, 10, []string{"lo", "", "", ""}},
+		{10, 2, []string{"", "", "", ""}},
+		{1, -1, []string{"", "", "", ""}},
+	} {
+		sub := array.Substring(arr, tc.start, tc.length)
+		got := make([]string, sub.Len())
+		for i := range got {
+			got[i] = sub.Value(i)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("substring(%d, %d): got=%q, want=%q", tc.start, tc.length, got, tc.want)
+		}
+		if !sub.IsNull(3) || sub.NullN() != 1 {
+			t.Errorf("substring(%d, %d): null element should stay null", tc.start, tc.length)
+		}
+		sub.Release()
+	}
+}
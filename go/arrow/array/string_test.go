@@ -17,6 +17,8 @@
 package array_test
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/apache/arrow/go/arrow"
@@ -158,3 +160,260 @@ func TestStringBuilder_Empty(t *testing.T) {
 	assert.Equal(t, want, stringValues(a))
 	a.Release()
 }
+
+func TestLargeStringArray(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		want    = []string{"hello", "世界", "", "bye"}
+		valids  = []bool{true, true, false, true}
+		offsets = []int64{0, 5, 11, 11, 14}
+	)
+
+	sb := array.NewLargeStringBuilder(mem)
+	defer sb.Release()
+
+	sb.AppendValues(want[:2], nil)
+	sb.AppendNull()
+	sb.Append(want[3])
+
+	if got, want := sb.Len(), len(want); got != want {
+		t.Fatalf("invalid len: got=%d, want=%d", got, want)
+	}
+	if got, want := sb.NullN(), 1; got != want {
+		t.Fatalf("invalid nulls: got=%d, want=%d", got, want)
+	}
+	if got, want := sb.Value(1), "世界"; got != want {
+		t.Fatalf("invalid builder value: got=%q, want=%q", got, want)
+	}
+
+	arr := sb.NewArray().(*array.LargeString)
+	defer arr.Release()
+
+	if got, want := arr.DataType(), arrow.BinaryTypes.LargeString; got != want {
+		t.Fatalf("invalid type: got=%v, want=%v", got, want)
+	}
+	for i := range want {
+		if got, want := arr.IsValid(i), valids[i]; got != want {
+			t.Fatalf("invalid validity %d: got=%v, want=%v", i, got, want)
+		}
+		if got, want := arr.Value(i), want[i]; got != want {
+			t.Fatalf("invalid value %d: got=%q, want=%q", i, got, want)
+		}
+	}
+	if got, want := arr.Offsets(), offsets; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid offsets: got=%v, want=%v", got, want)
+	}
+	if got, want := arr.String(), `["hello" "世界" (null) "bye"]`; got != want {
+		t.Fatalf("invalid stringer: got=%q, want=%q", got, want)
+	}
+
+	sub := array.NewSlice(arr, 1, 4).(*array.LargeString)
+	defer sub.Release()
+
+	if got, want := sub.String(), `["世界" (null) "bye"]`; got != want {
+		t.Fatalf("invalid slice: got=%q, want=%q", got, want)
+	}
+	if got, want := sub.NullN(), 1; got != want {
+		t.Fatalf("invalid slice nulls: got=%d, want=%d", got, want)
+	}
+	if got, want := sub.Offsets(), offsets[1:]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid slice offsets: got=%v, want=%v", got, want)
+	}
+	if got, want := sub.ValueOffset(2), int64(11); got != want {
+		t.Fatalf("invalid slice value offset: got=%d, want=%d", got, want)
+	}
+}
+
+func TestStringBuilder_ReserveData(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	vs := []string{"hello", "", "arrow", "world"}
+
+	for _, tc := range []struct {
+		name string
+		bldr interface {
+			array.Builder
+			Append(string)
+			ReserveData(int)
+			DataLen() int
+			DataCap() int
+		}
+	}{
+		{"string", array.NewStringBuilder(mem)},
+		{"large-string", array.NewLargeStringBuilder(mem)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b := tc.bldr
+			defer b.Release()
+
+			b.Reserve(len(vs))
+			b.ReserveData(100)
+			if got := b.DataCap(); got < 100 {
+				t.Fatalf("invalid data capacity: got=%d, want>=100", got)
+			}
+			dcap := b.DataCap()
+
+			for _, v := range vs {
+				b.Append(v)
+			}
+			if got, want := b.DataLen(), 15; got != want {
+				t.Fatalf("invalid data length: got=%d, want=%d", got, want)
+			}
+			if got, want := b.DataCap(), dcap; got != want {
+				t.Fatalf("data buffer was reallocated: got=%d, want=%d", got, want)
+			}
+
+			arr := b.NewArray()
+			defer arr.Release()
+			if got, want := array.NewStringer(arr).String(), `["hello" "" "arrow" "world"]`; got != want {
+				t.Fatalf("invalid array: got=%s, want=%s", got, want)
+			}
+		})
+	}
+}
+
+func TestStringBuilder_AppendValuesGrowth(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewStringBuilder(mem)
+	defer b.Release()
+
+	// repeated small bulk appends grow the data buffer geometrically, rather
+	// than reallocating it on every call.
+	grows := 0
+	for i := 0; i < 1000; i++ {
+		dcap := b.DataCap()
+		b.AppendValues([]string{"abc"}, nil)
+		if b.DataCap() != dcap {
+			grows++
+		}
+	}
+	if got, want := b.DataLen(), 3000; got != want {
+		t.Fatalf("invalid data length: got=%d, want=%d", got, want)
+	}
+	if grows > 16 {
+		t.Fatalf("data buffer grew too often: got=%d, want<=16", grows)
+	}
+}
+
+func BenchmarkStringBuilder(b *testing.B) {
+	const n = 1000000
+	vs := make([]string, 16)
+	size := 0
+	for i := range vs {
+		vs[i] = strings.Repeat("x", i)
+		size += i
+	}
+	size *= n / len(vs)
+
+	mem := memory.NewGoAllocator()
+	for _, reserve := range []bool{false, true} {
+		name := "Append"
+		if reserve {
+			name = "ReserveData"
+		}
+		b.Run(name, func(b *testing.B) {
+			bldr := array.NewStringBuilder(mem)
+			defer bldr.Release()
+
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				bldr.Reserve(n)
+				if reserve {
+					bldr.ReserveData(size)
+				}
+				for j := 0; j < n; j++ {
+					bldr.Append(vs[j%len(vs)])
+				}
+				bldr.NewArray().Release()
+			}
+		})
+	}
+}
+
+func TestStringRange(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]string{"a", "bc", "", "def", "g"}, []bool{true, true, false, true, true})
+
+	arr := bldr.NewStringArray()
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 1, 5).(*array.String)
+	defer slice.Release()
+
+	var got []string
+	slice.Range(func(i int, v string, valid bool) bool {
+		if valid {
+			got = append(got, v)
+		}
+		return v != "def"
+	})
+
+	if want := []string{"bc", "def"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+}
+
+func TestStringBuilder_AppendValues(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+
+	bldr.Append("x")
+
+	vs := []string{"", "héllo", "", "世界", "null", "ok"}
+	valid := []bool{true, true, false, true, false, true}
+	bldr.AppendValues(vs, valid)
+
+	if got, want := bldr.DataLen(), 1+len("héllo")+len("世界")+len("null")+len("ok"); got != want {
+		t.Fatalf("invalid data length: got=%d, want=%d", got, want)
+	}
+
+	arr := bldr.NewStringArray()
+	defer arr.Release()
+
+	if got, want := arr.Len(), 7; got != want {
+		t.Fatalf("invalid length: got=%d, want=%d", got, want)
+	}
+	if got, want := arr.NullN(), 2; got != want {
+		t.Fatalf("invalid null count: got=%d, want=%d", got, want)
+	}
+
+	want := append([]string{"x"}, vs...)
+	valid = append([]bool{true}, valid...)
+	offset := 0
+	for i := range want {
+		if got := arr.ValueOffset(i); got != offset {
+			t.Fatalf("invalid offset %d: got=%d, want=%d", i, got, offset)
+		}
+		offset += len(want[i])
+		if got, want := arr.IsValid(i), valid[i]; got != want {
+			t.Fatalf("invalid validity %d: got=%v, want=%v", i, got, want)
+		}
+		if got, want := arr.Value(i), want[i]; got != want {
+			t.Fatalf("invalid value %d: got=%q, want=%q", i, got, want)
+		}
+	}
+	if got := arr.ValueOffset(len(want)); got != offset {
+		t.Fatalf("invalid last offset: got=%d, want=%d", got, offset)
+	}
+
+	bldr.SetCollectErrors(true)
+	bldr.AppendValues([]string{"a", "b"}, []bool{true})
+	if bldr.Err() == nil {
+		t.Fatalf("expected an error")
+	}
+	if got, want := bldr.Len(), 0; got != want {
+		t.Fatalf("invalid length: got=%d, want=%d", got, want)
+	}
+}
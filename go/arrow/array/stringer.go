@@ -0,0 +1,224 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+const defaultNullToken = "(null)"
+
+// StringerOption is a functional option to configure how arrays and records
+// are rendered by NewStringer and NewRecordStringer.
+type StringerOption func(*stringerConfig)
+
+type stringerConfig struct {
+	null string
+}
+
+// WithNullToken configures the token used to render null elements.
+// The default is "(null)".
+func WithNullToken(tok string) StringerOption {
+	return func(cfg *stringerConfig) {
+		cfg.null = tok
+	}
+}
+
+func newStringerConfig(opts []StringerOption) stringerConfig {
+	cfg := stringerConfig{null: defaultNullToken}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+type arrayStringer struct {
+	arr Interface
+	cfg stringerConfig
+}
+
+// NewStringer returns a fmt.Stringer rendering the elements of arr in a
+// human-readable form, such as:
+//  [1 2 3 (null) 5]
+// List-like arrays are rendered with nested brackets and struct arrays
+// render each element as a brace-enclosed list of its field values.
+//
+// The returned value holds a reference to arr: arr must not be released
+// before the returned value has been used.
+func NewStringer(arr Interface, opts ...StringerOption) fmt.Stringer {
+	return arrayStringer{arr: arr, cfg: newStringerConfig(opts)}
+}
+
+func (s arrayStringer) String() string {
+	o := new(strings.Builder)
+	s.cfg.writeArray(o, s.arr)
+	return o.String()
+}
+
+func (cfg stringerConfig) writeArray(o *strings.Builder, arr Interface) {
+	o.WriteString("[")
+	for i := 0; i < arr.Len(); i++ {
+		if i > 0 {
+			o.WriteString(" ")
+		}
+		cfg.writeValue(o, arr, i)
+	}
+	o.WriteString("]")
+}
+
+// writeValue writes the i-th element of arr to o.
+func (cfg stringerConfig) writeValue(o *strings.Builder, arr Interface, i int) {
+	if arr.IsNull(i) {
+		o.WriteString(cfg.null)
+		return
+	}
+
+	switch arr := arr.(type) {
+	case *Boolean:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Int8:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Int16:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Int32:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Int64:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Uint8:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Uint16:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Uint32:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Uint64:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Float16:
+		fmt.Fprintf(o, "%v", arr.Value(i).Float32())
+	case *Float32:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Float64:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Date32:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Date64:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Time32:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Time64:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Timestamp:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Duration:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *MonthInterval:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *DayTimeInterval:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Decimal128:
+		fmt.Fprintf(o, "%v", arr.Value(i))
+	case *Decimal256:
+		o.WriteString(arr.Value(i).ToString(arr.scale))
+	case *String:
+		fmt.Fprintf(o, "%q", arr.Value(i))
+	case *Binary:
+		fmt.Fprintf(o, "%q", arr.ValueString(i))
+	case *LargeString:
+		fmt.Fprintf(o, "%q", arr.Value(i))
+	case *LargeBinary:
+		fmt.Fprintf(o, "%q", arr.ValueString(i))
+	case *FixedSizeBinary:
+		fmt.Fprintf(o, "%q", arr.Value(i))
+	case *List:
+		sub := arr.newListValue(i)
+		cfg.writeArray(o, sub)
+		sub.Release()
+	case *LargeList:
+		sub := arr.newListValue(i)
+		cfg.writeArray(o, sub)
+		sub.Release()
+	case *FixedSizeList:
+		sub := arr.newListValue(i)
+		cfg.writeArray(o, sub)
+		sub.Release()
+	case *Struct:
+		o.WriteString("{")
+		for j, field := range arr.fields {
+			if j > 0 {
+				o.WriteString(" ")
+			}
+			cfg.writeValue(o, field, i)
+		}
+		o.WriteString("}")
+	case *RunEndEncoded:
+		cfg.writeValue(o, arr.values, arr.PhysicalIndex(i))
+	case *Dictionary:
+		cfg.writeValue(o, arr.dict, arr.index(i))
+	default:
+		panic(fmt.Errorf("arrow/array: unsupported data type %v", arr.DataType()))
+	}
+}
+
+type recordStringer struct {
+	rec Record
+	cfg stringerConfig
+}
+
+// NewRecordStringer returns a fmt.Stringer rendering rec as its schema
+// followed by a table holding one column per field of the record and
+// one line per row.
+//
+// The returned value holds a reference to rec: rec must not be released
+// before the returned value has been used.
+func NewRecordStringer(rec Record, opts ...StringerOption) fmt.Stringer {
+	return recordStringer{rec: rec, cfg: newStringerConfig(opts)}
+}
+
+func (s recordStringer) String() string {
+	o := new(strings.Builder)
+	fmt.Fprintf(o, "%v\n", s.rec.Schema())
+	fmt.Fprintf(o, "rows: %d\n", s.rec.NumRows())
+
+	var (
+		w    = tabwriter.NewWriter(o, 0, 4, 1, ' ', 0)
+		cell = new(strings.Builder)
+		cols = s.rec.Columns()
+	)
+	for i := range cols {
+		if i > 0 {
+			w.Write([]byte("\t"))
+		}
+		w.Write([]byte(s.rec.ColumnName(i)))
+	}
+	w.Write([]byte("\n"))
+
+	for row := 0; row < int(s.rec.NumRows()); row++ {
+		for i, col := range cols {
+			if i > 0 {
+				w.Write([]byte("\t"))
+			}
+			cell.Reset()
+			s.cfg.writeValue(cell, col, row)
+			w.Write([]byte(cell.String()))
+		}
+		w.Write([]byte("\n"))
+	}
+	w.Flush()
+
+	return o.String()
+}
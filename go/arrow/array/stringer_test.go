@@ -0,0 +1,148 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestStringer(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+	ib.AppendValues([]int32{1, 2, 3, 0, 5}, []bool{true, true, true, false, true})
+	ints := ib.NewArray()
+	defer ints.Release()
+
+	lb := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int32)
+	defer lb.Release()
+	vb := lb.ValueBuilder().(*array.Int32Builder)
+	lb.Append(true)
+	vb.AppendValues([]int32{1, 2}, nil)
+	lb.AppendNull()
+	lb.Append(true)
+	vb.AppendNull()
+	lb.Append(true)
+	lists := lb.NewArray()
+	defer lists.Release()
+
+	dtype := arrow.StructOf(
+		arrow.Field{Name: "a", Type: arrow.BinaryTypes.String, Nullable: true},
+		arrow.Field{Name: "b", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+	)
+	sb := array.NewStructBuilder(mem, dtype)
+	defer sb.Release()
+	sb.Append(true)
+	sb.FieldBuilder(0).(*array.StringBuilder).Append("x")
+	sb.FieldBuilder(1).(*array.ListBuilder).Append(true)
+	sb.FieldBuilder(1).(*array.ListBuilder).ValueBuilder().(*array.Int32Builder).Append(7)
+	sb.AppendNull()
+	sb.Append(true)
+	sb.FieldBuilder(0).(*array.StringBuilder).AppendNull()
+	sb.FieldBuilder(1).(*array.ListBuilder).Append(true)
+	structs := sb.NewArray()
+	defer structs.Release()
+
+	for _, tc := range []struct {
+		name string
+		arr  array.Interface
+		opts []array.StringerOption
+		want string
+	}{
+		{
+			name: "primitive",
+			arr:  ints,
+			want: "[1 2 3 (null) 5]",
+		},
+		{
+			name: "null-token",
+			arr:  ints,
+			opts: []array.StringerOption{array.WithNullToken("NA")},
+			want: "[1 2 3 NA 5]",
+		},
+		{
+			name: "list",
+			arr:  lists,
+			opts: []array.StringerOption{array.WithNullToken("-")},
+			want: "[[1 2] - [-] []]",
+		},
+		{
+			name: "struct",
+			arr:  structs,
+			want: `[{"x" [7]} (null) {(null) []}]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := array.NewStringer(tc.arr, tc.opts...).String()
+			if got != tc.want {
+				t.Fatalf("invalid string:\ngot= %q\nwant=%q", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("slice", func(t *testing.T) {
+		slice := array.NewSlice(ints, 2, 5)
+		defer slice.Release()
+
+		if got, want := fmt.Sprintf("%v", array.NewStringer(slice)), "[3 (null) 5]"; got != want {
+			t.Fatalf("invalid string:\ngot= %q\nwant=%q", got, want)
+		}
+	})
+}
+
+func TestRecordStringer(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+		},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 20, 300}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"alpha", "", "c"}, []bool{true, false, true})
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	const want = `schema:
+  fields: 2
+    - id: type=int64
+    - name: type=utf8, nullable
+rows: 3
+id  name
+1   "alpha"
+20  null
+300 "c"
+`
+	if got := array.NewRecordStringer(rec, array.WithNullToken("null")).String(); got != want {
+		t.Fatalf("invalid string:\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
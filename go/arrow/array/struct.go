@@ -44,6 +44,31 @@ func NewStructData(data *Data) *Struct {
 func (a *Struct) NumField() int         { return len(a.fields) }
 func (a *Struct) Field(i int) Interface { return a.fields[i] }
 
+// FieldByName returns the child array of the first field named name,
+// and whether such a field exists.
+// The returned array is owned by a: it must not be released by the caller.
+func (a *Struct) FieldByName(name string) (Interface, bool) {
+	for i, f := range a.DataType().(*arrow.StructType).Fields() {
+		if f.Name == name {
+			return a.fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// FieldsByName returns the child arrays of all the fields named name,
+// in field order.
+// The returned arrays are owned by a: they must not be released by the caller.
+func (a *Struct) FieldsByName(name string) []Interface {
+	var o []Interface
+	for i, f := range a.DataType().(*arrow.StructType).Fields() {
+		if f.Name == name {
+			o = append(o, a.fields[i])
+		}
+	}
+	return o
+}
+
 func (a *Struct) String() string {
 	o := new(strings.Builder)
 	o.WriteString("{")
@@ -57,24 +82,72 @@ func (a *Struct) String() string {
 	return o.String()
 }
 
+// setData sets the data of the struct array, slicing the fields to the
+// offset and length of data, so that the j-th element of a field is the
+// j-th element of the struct.
+// Fields shorter than the struct (e.g. when only nulls were appended to the
+// struct builder) are sliced as far as they go.
 func (a *Struct) setData(data *Data) {
 	a.array.setData(data)
 	a.fields = make([]Interface, len(data.childData))
 	for i, child := range data.childData {
-		a.fields[i] = MakeFromData(child)
+		var (
+			beg = imin64(int64(data.offset), int64(child.length))
+			end = imin64(int64(data.offset+data.length), int64(child.length))
+		)
+		if beg == 0 && end == int64(child.length) {
+			a.fields[i] = MakeFromData(child)
+			continue
+		}
+		sub := NewSliceData(child, beg, end)
+		a.fields[i] = MakeFromData(sub)
+		sub.Release()
 	}
 }
 
+// arrayEqualStruct reports whether left and right have equal fields.
+// Field elements under null struct elements are not compared.
 func arrayEqualStruct(left, right *Struct) bool {
 	for i, lf := range left.fields {
 		rf := right.fields[i]
-		if !ArrayEqual(lf, rf) {
+		eq := true
+		validRuns(left, func(beg, end int) bool {
+			eq = ArraySliceEqual(lf, int64(beg), int64(end), rf, int64(beg), int64(end))
+			return eq
+		})
+		if !eq {
 			return false
 		}
 	}
 	return true
 }
 
+// validRuns calls fn with the [beg, end) ranges of consecutive valid
+// elements of arr, in order, until fn returns false.
+func validRuns(arr Interface, fn func(beg, end int) bool) {
+	n := arr.Len()
+	if arr.NullN() == 0 {
+		if n > 0 {
+			fn(0, n)
+		}
+		return
+	}
+	for beg := 0; beg < n; {
+		if arr.IsNull(beg) {
+			beg++
+			continue
+		}
+		end := beg + 1
+		for end < n && arr.IsValid(end) {
+			end++
+		}
+		if !fn(beg, end) {
+			return
+		}
+		beg = end
+	}
+}
+
 func (a *Struct) Retain() {
 	a.array.Retain()
 	for _, f := range a.fields {
@@ -104,7 +177,7 @@ func NewStructBuilder(mem memory.Allocator, dtype *arrow.StructType) *StructBuil
 		fields:  make([]Builder, len(dtype.Fields())),
 	}
 	for i, f := range dtype.Fields() {
-		b.fields[i] = newBuilder(b.mem, f.Type)
+		b.fields[i] = NewBuilder(b.mem, f.Type)
 	}
 	return b
 }
@@ -126,6 +199,36 @@ func (b *StructBuilder) Release() {
 	}
 }
 
+// SetCollectErrors configures how the builder and its field builders handle
+// invalid calls. See Builder.SetCollectErrors.
+func (b *StructBuilder) SetCollectErrors(v bool) {
+	b.builder.SetCollectErrors(v)
+	for _, f := range b.fields {
+		f.SetCollectErrors(v)
+	}
+}
+
+func (b *StructBuilder) setGrowth(g growthPolicy) {
+	b.builder.setGrowth(g)
+	for _, f := range b.fields {
+		f.setGrowth(g)
+	}
+}
+
+// Err returns the first error recorded by the builder, or else by its field
+// builders in field order, since they were last reset.
+func (b *StructBuilder) Err() error {
+	if b.err != nil {
+		return b.err
+	}
+	for _, f := range b.fields {
+		if err := f.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (b *StructBuilder) Append(v bool) {
 	b.Reserve(1)
 	b.unsafeAppendBoolToBitmap(v)
@@ -143,6 +246,64 @@ func (b *StructBuilder) AppendValues(valids []bool) {
 
 func (b *StructBuilder) AppendNull() { b.Append(false) }
 
+// AppendNulls appends n null elements to the builder, along with null values
+// to each field builder.
+func (b *StructBuilder) AppendNulls(n int) {
+	b.Reserve(n)
+	b.unsafeAppendNulls(n)
+	for _, f := range b.fields {
+		f.AppendNulls(n)
+	}
+}
+
+// AppendEmptyValue appends a valid element to the builder, along with an
+// empty value to each field builder.
+func (b *StructBuilder) AppendEmptyValue() {
+	b.Append(true)
+	for _, f := range b.fields {
+		f.AppendEmptyValue()
+	}
+}
+
+// AppendStruct appends a valid element to the builder, dispatching each
+// value of values to the field builder of the same name. Fields missing
+// from values, or with a nil value, are appended as nulls, and keys not
+// matching any field are ignored.
+//
+// Values are coerced to the field types as done by RecordFromMaps.
+// AppendStruct returns an error naming the first field whose value cannot
+// be coerced to the field type. The element is then appended as null, with
+// the following fields appended as nulls, so that the builder holds no
+// partially appended valid element.
+func (b *StructBuilder) AppendStruct(values map[string]interface{}) error {
+	if err := b.appendStruct(values); err != nil {
+		return fmt.Errorf("arrow/array: %v", err)
+	}
+	return nil
+}
+
+func (b *StructBuilder) appendStruct(values map[string]interface{}) error {
+	b.Reserve(1) // before the fields are appended, as it may init them.
+
+	var err error
+	for i, field := range b.dtype.(*arrow.StructType).Fields() {
+		fb := b.fields[i]
+		if err != nil {
+			fb.AppendNull()
+			continue
+		}
+		n := fb.Len()
+		if e := appendGoValue(fb, values[field.Name]); e != nil {
+			err = fmt.Errorf("field %q: %v", field.Name, e)
+			if fb.Len() == n {
+				fb.AppendNull()
+			}
+		}
+	}
+	b.unsafeAppendBoolToBitmap(err == nil)
+	return err
+}
+
 func (b *StructBuilder) unsafeAppend(v bool) {
 	bitutil.SetBit(b.nullBitmap.Bytes(), b.length)
 	b.length++
@@ -182,7 +343,7 @@ func (b *StructBuilder) Resize(n int) {
 	} else {
 		b.builder.resize(n, b.builder.init)
 		for _, f := range b.fields {
-			f.resize(n, f.init)
+			f.Resize(n)
 		}
 	}
 }
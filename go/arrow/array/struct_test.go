@@ -303,3 +303,167 @@ func TestStructArrayStringer(t *testing.T) {
 		t.Fatalf("invalid string representation:\ngot = %q\nwant= %q", got, want)
 	}
 }
+
+func TestStructArrayFieldByName(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	dtype := arrow.StructOf([]arrow.Field{
+		{Name: "f1", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint8)},
+		{Name: "f2", Type: arrow.PrimitiveTypes.Int32},
+	}...)
+
+	sb := array.NewStructBuilder(pool, dtype)
+	defer sb.Release()
+
+	f1b := sb.FieldBuilder(0).(*array.ListBuilder)
+	f1vb := f1b.ValueBuilder().(*array.Uint8Builder)
+	f2b := sb.FieldBuilder(1).(*array.Int32Builder)
+
+	sb.Append(true)
+	f1b.Append(true)
+	f1vb.AppendValues([]byte("joe"), nil)
+	f2b.Append(1)
+
+	sb.Append(true)
+	f1b.AppendNull()
+	f2b.Append(2)
+
+	sb.AppendNull()
+
+	sb.Append(true)
+	f1b.Append(true)
+	f1vb.AppendValues([]byte("mark"), nil)
+	f2b.Append(4)
+
+	arr := sb.NewArray().(*array.Struct)
+	defer arr.Release()
+
+	if got, want := arr.NumField(), 2; got != want {
+		t.Fatalf("invalid number of fields: got=%d, want=%d", got, want)
+	}
+
+	f2, ok := arr.FieldByName("f2")
+	if !ok {
+		t.Fatalf("could not find field f2")
+	}
+	if got, want := f2, arr.Field(1); got != want {
+		t.Fatalf("invalid field: got=%v, want=%v", got, want)
+	}
+	if got, want := f2.(*array.Int32).Int32Values(), []int32{1, 2, 0, 4}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid values: got=%v, want=%v", got, want)
+	}
+
+	if got, want := arr.FieldsByName("f1"), []array.Interface{arr.Field(0)}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid fields: got=%v, want=%v", got, want)
+	}
+
+	if _, ok := arr.FieldByName("f3"); ok {
+		t.Fatalf("field f3 should not exist")
+	}
+	if got := arr.FieldsByName("f3"); len(got) != 0 {
+		t.Fatalf("invalid fields: got=%v, want none", got)
+	}
+}
+
+func TestStructBuilderAppendStruct(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	dtype := arrow.StructOf([]arrow.Field{
+		{Name: "f1", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+		{Name: "f2", Type: arrow.BinaryTypes.String, Nullable: true},
+	}...)
+
+	sb := array.NewStructBuilder(pool, dtype)
+	defer sb.Release()
+
+	for _, row := range []map[string]interface{}{
+		{"f1": 1, "f2": "a"},
+		{"f2": "b", "unknown": 42},
+		{"f1": int32(3), "f2": nil},
+	} {
+		if err := sb.AppendStruct(row); err != nil {
+			t.Fatalf("could not append struct %v: %v", row, err)
+		}
+	}
+
+	got := sb.NewStructArray()
+	defer got.Release()
+
+	f1b := sb.FieldBuilder(0).(*array.Int32Builder)
+	f2b := sb.FieldBuilder(1).(*array.StringBuilder)
+	sb.AppendValues([]bool{true, true, true})
+	f1b.AppendValues([]int32{1, 0, 3}, []bool{true, false, true})
+	f2b.AppendValues([]string{"a", "b", ""}, []bool{true, true, false})
+
+	want := sb.NewStructArray()
+	defer want.Release()
+
+	if !array.ArrayEqual(got, want) {
+		t.Fatalf("invalid array:\ngot= %v\nwant=%v", got, want)
+	}
+
+	err := sb.AppendStruct(map[string]interface{}{"f1": "x", "f2": "c"})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if got, want := err.Error(), `arrow/array: field "f1": cannot convert value x (type string) to int32`; got != want {
+		t.Fatalf("invalid error: got=%q, want=%q", got, want)
+	}
+
+	arr := sb.NewStructArray()
+	defer arr.Release()
+
+	if got, want := arr.Len(), 1; got != want {
+		t.Fatalf("invalid length: got=%d, want=%d", got, want)
+	}
+	if !arr.IsNull(0) {
+		t.Fatalf("element should be null")
+	}
+	for i := 0; i < arr.NumField(); i++ {
+		if got, want := arr.Field(i).Len(), 1; got != want {
+			t.Fatalf("invalid field %d length: got=%d, want=%d", i, got, want)
+		}
+		if !arr.Field(i).IsNull(0) {
+			t.Fatalf("field %d should be null", i)
+		}
+	}
+}
+
+func TestStructBuilderAppendStructNestedError(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	inner := arrow.StructOf(
+		arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+		arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+	)
+	dtype := arrow.StructOf(arrow.Field{Name: "s", Type: inner, Nullable: true})
+
+	sb := array.NewStructBuilder(pool, dtype)
+	defer sb.Release()
+
+	err := sb.AppendStruct(map[string]interface{}{
+		"s": map[string]interface{}{"a": 1, "b": 1000},
+	})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if got, want := err.Error(), `arrow/array: field "s": field "b": cannot convert value 1000 (type int) to int8`; got != want {
+		t.Fatalf("invalid error: got=%q, want=%q", got, want)
+	}
+
+	arr := sb.NewStructArray()
+	defer arr.Release()
+
+	nested := arr.Field(0).(*array.Struct)
+	if !arr.IsNull(0) || !nested.IsNull(0) {
+		t.Fatalf("elements should be null")
+	}
+	for i := 0; i < nested.NumField(); i++ {
+		if got, want := nested.Field(i).Len(), 1; got != want {
+			t.Fatalf("invalid field %d length: got=%d, want=%d", i, got, want)
+		}
+	}
+}
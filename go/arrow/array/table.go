@@ -156,6 +156,25 @@ func (a *Chunked) DataType() arrow.DataType { return a.dtype }
 func (a *Chunked) Chunks() []Interface      { return a.chunks }
 func (a *Chunked) Chunk(i int) Interface    { return a.chunks[i] }
 
+// Records returns one single-column record per chunk of the chunked array,
+// preserving the chunk boundaries. The column of each record is described
+// by the provided field.
+// Each record retains its chunk and must be Release()'d after use.
+//
+// Records panics if the field data type does not match the chunked array one.
+func (a *Chunked) Records(field arrow.Field) []Record {
+	if !arrow.TypeEquals(field.Type, a.dtype) {
+		panic("arrow/array: mismatch data type")
+	}
+
+	schema := arrow.NewSchema([]arrow.Field{field}, nil)
+	recs := make([]Record, len(a.chunks))
+	for i, chunk := range a.chunks {
+		recs[i] = NewRecord(schema, []Interface{chunk}, int64(chunk.Len()))
+	}
+	return recs
+}
+
 // NewSlice constructs a zero-copy slice of the chunked array with the indicated
 // indices i and j, corresponding to array[i:j].
 // The returned chunked array must be Release()'d after use.
@@ -250,11 +269,21 @@ func NewTable(schema *arrow.Schema, cols []Column, rows int64) *simpleTable {
 //
 // NewTableFromRecords panics if the records and schema are inconsistent.
 func NewTableFromRecords(schema *arrow.Schema, recs []Record) *simpleTable {
+	for i, rec := range recs {
+		if got, want := len(rec.Columns()), len(schema.Fields()); got != want {
+			panic(fmt.Errorf("arrow/array: record %d has %d columns, want=%d", i, got, want))
+		}
+	}
+
 	arrs := make([]Interface, len(recs))
 	cols := make([]Column, len(schema.Fields()))
 
 	defer func(cols []Column) {
 		for i := range cols {
+			if cols[i].data == nil {
+				// column not built, NewChunked or NewColumn panicked.
+				continue
+			}
 			cols[i].Release()
 		}
 	}(cols)
@@ -357,6 +386,7 @@ func NewTableReader(tbl Table, chunkSize int64) *TableReader {
 
 func (tr *TableReader) Schema() *arrow.Schema { return tr.tbl.Schema() }
 func (tr *TableReader) Record() Record        { return tr.rec }
+func (tr *TableReader) Err() error            { return nil }
 
 func (tr *TableReader) Next() bool {
 	if tr.cur >= tr.max {
@@ -112,6 +112,62 @@ func TestChunked(t *testing.T) {
 	}
 }
 
+func TestChunkedRecords(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+
+	values := [][]int64{{1, 2, 3}, {4}, {5, 6}}
+	chunks := make([]array.Interface, len(values))
+	for i, vs := range values {
+		ib.AppendValues(vs, nil)
+		chunks[i] = ib.NewArray()
+		defer chunks[i].Release()
+	}
+
+	col := array.NewChunked(arrow.PrimitiveTypes.Int64, chunks)
+	defer col.Release()
+
+	field := arrow.Field{Name: "ints", Type: arrow.PrimitiveTypes.Int64}
+	recs := col.Records(field)
+	defer func() {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}()
+
+	if got, want := len(recs), len(values); got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+
+	for i, rec := range recs {
+		if got, want := rec.NumRows(), int64(len(values[i])); got != want {
+			t.Fatalf("rec[%d]: invalid number of rows: got=%d, want=%d", i, got, want)
+		}
+		if got, want := rec.NumCols(), int64(1); got != want {
+			t.Fatalf("rec[%d]: invalid number of columns: got=%d, want=%d", i, got, want)
+		}
+		if got, want := rec.Schema().Field(0), field; !got.Equal(want) {
+			t.Fatalf("rec[%d]: invalid field: got=%v, want=%v", i, got, want)
+		}
+		if got, want := rec.Column(0).(*array.Int64).Int64Values(), values[i]; !reflect.DeepEqual(got, want) {
+			t.Fatalf("rec[%d]: invalid values: got=%v, want=%v", i, got, want)
+		}
+		if got, want := rec.Column(0), chunks[i]; got != want {
+			t.Fatalf("rec[%d]: chunk was copied", i)
+		}
+	}
+
+	defer func() {
+		if e := recover(); e == nil {
+			t.Fatalf("expected a panic on mismatched data type")
+		}
+	}()
+	col.Records(arrow.Field{Name: "ints", Type: arrow.PrimitiveTypes.Int32})
+}
+
 func TestChunkedEqualDataType(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -624,6 +680,70 @@ func TestTableFromRecords(t *testing.T) {
 	}
 }
 
+func TestTableFromRecordsInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			arrow.Field{Name: "f2-f64", Type: arrow.PrimitiveTypes.Float64},
+		},
+		nil,
+	)
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+
+	ib.AppendValues([]int32{1, 2, 3}, nil)
+	i1 := ib.NewInt32Array()
+	defer i1.Release()
+
+	ib.AppendValues([]int32{4, 5, 6}, nil)
+	i2 := ib.NewInt32Array()
+	defer i2.Release()
+
+	for _, tc := range []struct {
+		name string
+		rec  array.Record
+		err  string
+	}{
+		{
+			name: "ncols",
+			rec: array.NewRecord(
+				arrow.NewSchema(schema.Fields()[:1], nil),
+				[]array.Interface{i1}, -1,
+			),
+			err: "arrow/array: record 0 has 1 columns, want=2",
+		},
+		{
+			name: "dtype",
+			rec: array.NewRecord(
+				arrow.NewSchema([]arrow.Field{schema.Field(0), {Name: "f2-i32", Type: arrow.PrimitiveTypes.Int32}}, nil),
+				[]array.Interface{i1, i2}, -1,
+			),
+			err: "arrow/array: mismatch data type",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer tc.rec.Release()
+
+			defer func() {
+				e := recover()
+				if e == nil {
+					t.Fatalf("expected a panic")
+				}
+				if got, want := fmt.Sprint(e), tc.err; got != want {
+					t.Fatalf("invalid error. got=%q, want=%q", got, want)
+				}
+			}()
+
+			tbl := array.NewTableFromRecords(schema, []array.Record{tc.rec})
+			tbl.Release()
+		})
+	}
+}
+
 func TestTableReader(t *testing.T) {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	defer mem.AssertSize(t, 0)
@@ -0,0 +1,279 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Take returns a new array holding the elements of arr at the provided
+// indices, in that order. Indices may repeat elements of arr.
+// The returned array must be Release()'d after use.
+//
+// Take supports the primitive, binary, list and struct arrays.
+// Take returns an error if an index is outside the [0, arr.Len()) range.
+func Take(arr Interface, indices []int, mem memory.Allocator) (Interface, error) {
+	return TakeCtx(context.Background(), arr, indices, mem)
+}
+
+// TakeCtx is like Take but stops early if ctx is done, checking it every
+// few thousand elements, in which case it returns the error of ctx.
+func TakeCtx(ctx context.Context, arr Interface, indices []int, mem memory.Allocator) (Interface, error) {
+	for i, idx := range indices {
+		if err := checkCtx(ctx, i); err != nil {
+			return nil, err
+		}
+		if idx < 0 || idx >= arr.Len() {
+			return nil, fmt.Errorf("arrow/array: take index %d out of range [0, %d)", idx, arr.Len())
+		}
+	}
+
+	data, err := takeData(ctx, mem, arr.Data(), indices)
+	if err != nil {
+		return nil, err
+	}
+	defer data.Release()
+	return MakeFromData(data), nil
+}
+
+// takeData returns new array data holding the elements of data at the
+// provided indices, relative to the offset of data.
+func takeData(ctx context.Context, mem memory.Allocator, data *Data, indices []int) (*Data, error) {
+	var (
+		n        = len(indices)
+		offset   = data.offset
+		buffers  []*memory.Buffer
+		children []*Data
+	)
+	defer func() {
+		for _, b := range buffers {
+			if b != nil {
+				b.Release()
+			}
+		}
+		for _, c := range children {
+			c.Release()
+		}
+	}()
+
+	if data.dtype.ID() == arrow.NULL {
+		return NewData(data.dtype, n, []*memory.Buffer{nil}, nil, n, 0), nil
+	}
+
+	validity, nulls, err := takeValidity(ctx, mem, data, indices)
+	if err != nil {
+		return nil, err
+	}
+	buffers = append(buffers, validity)
+
+	switch dt := data.dtype.(type) {
+	case *arrow.BooleanType:
+		out := newZeroedBuffer(mem, int(bitutil.BytesForBits(int64(n))))
+		buffers = append(buffers, out)
+		src, dst := bufferBytes(data.buffers[1]), out.Bytes()
+		for i, idx := range indices {
+			if err := checkCtx(ctx, i); err != nil {
+				return nil, err
+			}
+			if bitutil.BitIsSet(src, offset+idx) {
+				bitutil.SetBit(dst, i)
+			}
+		}
+
+	case *arrow.BinaryType, *arrow.StringType, *arrow.LargeBinaryType, *arrow.LargeStringType:
+		w := dt.Layout().Buffers[1].ByteWidth
+		begs, ends := takeRanges(data.buffers[1], w, offset, indices)
+		offsets, size := newOffsetsBuffer(mem, w, begs, ends)
+		values := newZeroedBuffer(mem, int(size))
+		buffers = append(buffers, offsets, values)
+		src, dst := bufferBytes(data.buffers[2]), values.Bytes()
+		for i, beg := range begs {
+			if err := checkCtx(ctx, i); err != nil {
+				return nil, err
+			}
+			dst = dst[copy(dst, src[beg:ends[i]]):]
+		}
+
+	case *arrow.ListType, *arrow.LargeListType:
+		w := dt.Layout().Buffers[1].ByteWidth
+		begs, ends := takeRanges(data.buffers[1], w, offset, indices)
+		offsets, size := newOffsetsBuffer(mem, w, begs, ends)
+		buffers = append(buffers, offsets)
+		rows := make([]int, 0, size)
+		for i, beg := range begs {
+			for j := beg; j < ends[i]; j++ {
+				rows = append(rows, int(j))
+			}
+		}
+		child, err := takeData(ctx, mem, data.childData[0], rows)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+
+	case *arrow.FixedSizeListType:
+		size := int(dt.Len())
+		rows := make([]int, 0, n*size)
+		for _, idx := range indices {
+			for j := 0; j < size; j++ {
+				rows = append(rows, (offset+idx)*size+j)
+			}
+		}
+		child, err := takeData(ctx, mem, data.childData[0], rows)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+
+	case *arrow.StructType:
+		rows := make([]int, n)
+		for i, idx := range indices {
+			rows[i] = offset + idx
+		}
+		for _, c := range data.childData {
+			child, err := takeData(ctx, mem, c, rows)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, child)
+		}
+
+	default:
+		layout := dt.Layout()
+		if len(layout.Buffers) != 2 || layout.Buffers[1].Kind != arrow.KindFixedWidth || layout.NumChildren != 0 {
+			return nil, fmt.Errorf("arrow/array: take not supported for %v arrays", dt)
+		}
+		w := layout.Buffers[1].ByteWidth
+		out := newZeroedBuffer(mem, n*w)
+		buffers = append(buffers, out)
+		src, dst := bufferBytes(data.buffers[1]), out.Bytes()
+		for i, idx := range indices {
+			if err := checkCtx(ctx, i); err != nil {
+				return nil, err
+			}
+			copy(dst[i*w:(i+1)*w], src[(offset+idx)*w:])
+		}
+		if dt.ID() == arrow.DICTIONARY {
+			// the indices are taken, the dictionary is shared.
+			data.childData[0].Retain()
+			children = append(children, data.childData[0])
+		}
+	}
+
+	return NewData(data.dtype, n, buffers, children, nulls, 0), nil
+}
+
+// takeValidity returns the validity bitmap of the elements of data at the
+// provided indices, and their number of nulls.
+// takeValidity returns a nil bitmap if data has no nulls.
+func takeValidity(ctx context.Context, mem memory.Allocator, data *Data, indices []int) (*memory.Buffer, int, error) {
+	if data.buffers[0] == nil || data.buffers[0].Len() == 0 || data.NullN() == 0 {
+		return nil, 0, nil
+	}
+
+	out := newZeroedBuffer(mem, int(bitutil.BytesForBits(int64(len(indices)))))
+	src, dst := data.buffers[0].Bytes(), out.Bytes()
+	nulls := 0
+	for i, idx := range indices {
+		if err := checkCtx(ctx, i); err != nil {
+			out.Release()
+			return nil, 0, err
+		}
+		if bitutil.BitIsSet(src, data.offset+idx) {
+			bitutil.SetBit(dst, i)
+			continue
+		}
+		nulls++
+	}
+	return out, nulls, nil
+}
+
+// ctxCheckInterval is the number of elements processed between two checks
+// of the context by the kernels accepting one.
+const ctxCheckInterval = 8192
+
+// checkCtx returns the error of ctx if it is done, checking it only every
+// ctxCheckInterval elements, i being the index of the current element.
+func checkCtx(ctx context.Context, i int) error {
+	if i%ctxCheckInterval != 0 {
+		return nil
+	}
+	return ctx.Err()
+}
+
+// takeRanges returns the ranges of values addressed by the offsets, of w
+// bytes, of the elements at the provided indices.
+func takeRanges(offsets *memory.Buffer, w, offset int, indices []int) (begs, ends []int64) {
+	begs = make([]int64, len(indices))
+	ends = make([]int64, len(indices))
+	switch w {
+	case arrow.Int32SizeBytes:
+		offs := arrow.Int32Traits.CastFromBytes(bufferBytes(offsets))
+		for i, idx := range indices {
+			begs[i], ends[i] = int64(offs[offset+idx]), int64(offs[offset+idx+1])
+		}
+	default:
+		offs := arrow.Int64Traits.CastFromBytes(bufferBytes(offsets))
+		for i, idx := range indices {
+			begs[i], ends[i] = offs[offset+idx], offs[offset+idx+1]
+		}
+	}
+	return begs, ends
+}
+
+// newOffsetsBuffer returns a buffer of offsets, of w bytes, addressing
+// contiguous values of the lengths of the provided ranges, and the total
+// length of these values.
+func newOffsetsBuffer(mem memory.Allocator, w int, begs, ends []int64) (*memory.Buffer, int64) {
+	buf := newZeroedBuffer(mem, (len(begs)+1)*w)
+	var size int64
+	switch w {
+	case arrow.Int32SizeBytes:
+		offs := arrow.Int32Traits.CastFromBytes(buf.Bytes())
+		for i, beg := range begs {
+			size += ends[i] - beg
+			offs[i+1] = int32(size)
+		}
+	default:
+		offs := arrow.Int64Traits.CastFromBytes(buf.Bytes())
+		for i, beg := range begs {
+			size += ends[i] - beg
+			offs[i+1] = size
+		}
+	}
+	return buf, size
+}
+
+// bufferBytes returns the bytes of b, which may be nil for empty arrays.
+func bufferBytes(b *memory.Buffer) []byte {
+	if b == nil {
+		return nil
+	}
+	return b.Bytes()
+}
+
+// newZeroedBuffer returns a buffer of n zeroed bytes.
+func newZeroedBuffer(mem memory.Allocator, n int) *memory.Buffer {
+	buf := memory.NewResizableBuffer(mem)
+	buf.Resize(n)
+	return buf
+}
@@ -0,0 +1,294 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestTake(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		listType   = arrow.ListOf(arrow.PrimitiveTypes.Int32)
+		fslType    = arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Int32)
+		structType = arrow.StructOf(
+			arrow.Field{Name: "b", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+			arrow.Field{Name: "s", Type: arrow.BinaryTypes.String, Nullable: true},
+		)
+	)
+
+	// build returns an array built by fn on a builder of the data type dt.
+	build := func(dt arrow.DataType, fn func(b array.Builder)) array.Interface {
+		bldr := array.NewBuilder(mem, dt)
+		defer bldr.Release()
+		fn(bldr)
+		return bldr.NewArray()
+	}
+
+	appendList := func(b array.Builder, vs ...int32) {
+		lb := b.(*array.ListBuilder)
+		lb.Append(true)
+		lb.ValueBuilder().(*array.Int32Builder).AppendValues(vs, nil)
+	}
+	appendFSL := func(b array.Builder, vs ...int32) {
+		lb := b.(*array.FixedSizeListBuilder)
+		lb.Append(true)
+		lb.ValueBuilder().(*array.Int32Builder).AppendValues(vs, nil)
+	}
+	appendStruct := func(b array.Builder, v bool, s string) {
+		sb := b.(*array.StructBuilder)
+		sb.Append(true)
+		sb.FieldBuilder(0).(*array.BooleanBuilder).Append(v)
+		sb.FieldBuilder(1).(*array.StringBuilder).Append(s)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		arr     array.Interface
+		indices []int
+		want    array.Interface
+	}{
+		{
+			name: "int64",
+			arr: build(arrow.PrimitiveTypes.Int64, func(b array.Builder) {
+				b.(*array.Int64Builder).AppendValues([]int64{1, 2, 3, 4}, []bool{true, false, true, true})
+			}),
+			indices: []int{3, 1, 3, 0},
+			want: build(arrow.PrimitiveTypes.Int64, func(b array.Builder) {
+				b.(*array.Int64Builder).AppendValues([]int64{4, 0, 4, 1}, []bool{true, false, true, true})
+			}),
+		},
+		{
+			name: "bool",
+			arr: build(arrow.FixedWidthTypes.Boolean, func(b array.Builder) {
+				b.(*array.BooleanBuilder).AppendValues([]bool{true, false, true}, nil)
+			}),
+			indices: []int{1, 2, 2},
+			want: build(arrow.FixedWidthTypes.Boolean, func(b array.Builder) {
+				b.(*array.BooleanBuilder).AppendValues([]bool{false, true, true}, nil)
+			}),
+		},
+		{
+			name: "string",
+			arr: build(arrow.BinaryTypes.String, func(b array.Builder) {
+				b.(*array.StringBuilder).AppendValues([]string{"a", "bc", "", "def"}, []bool{true, true, false, true})
+			}),
+			indices: []int{3, 2, 0, 3},
+			want: build(arrow.BinaryTypes.String, func(b array.Builder) {
+				b.(*array.StringBuilder).AppendValues([]string{"def", "", "a", "def"}, []bool{true, false, true, true})
+			}),
+		},
+		{
+			name: "empty",
+			arr: build(arrow.BinaryTypes.String, func(b array.Builder) {
+				b.(*array.StringBuilder).AppendValues([]string{"a"}, nil)
+			}),
+			want: build(arrow.BinaryTypes.String, func(b array.Builder) {}),
+		},
+		{
+			name: "list",
+			arr: build(listType, func(b array.Builder) {
+				appendList(b, 1, 2)
+				b.AppendNull()
+				appendList(b)
+				appendList(b, 3, 4, 5)
+			}),
+			indices: []int{3, 1, 0, 2},
+			want: build(listType, func(b array.Builder) {
+				appendList(b, 3, 4, 5)
+				b.AppendNull()
+				appendList(b, 1, 2)
+				appendList(b)
+			}),
+		},
+		{
+			name: "fixed-size-list",
+			arr: build(fslType, func(b array.Builder) {
+				appendFSL(b, 1, 2)
+				appendFSL(b, 3, 4)
+				appendFSL(b, 5, 6)
+			}),
+			indices: []int{2, 0},
+			want: build(fslType, func(b array.Builder) {
+				appendFSL(b, 5, 6)
+				appendFSL(b, 1, 2)
+			}),
+		},
+		{
+			name: "struct",
+			arr: build(structType, func(b array.Builder) {
+				appendStruct(b, true, "x")
+				b.AppendNull()
+				appendStruct(b, false, "z")
+			}),
+			indices: []int{2, 1, 0},
+			want: build(structType, func(b array.Builder) {
+				appendStruct(b, false, "z")
+				b.AppendNull()
+				appendStruct(b, true, "x")
+			}),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer tc.arr.Release()
+			defer tc.want.Release()
+
+			got, err := array.Take(tc.arr, tc.indices, mem)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer got.Release()
+
+			if !array.ArrayEqual(got, tc.want) {
+				t.Fatalf("invalid array:\ngot= %v\nwant=%v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTakeSlice(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]string{"a", "bc", "", "def", "g"}, []bool{true, true, false, true, true})
+
+	arr := bldr.NewStringArray()
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 1, 4)
+	defer slice.Release()
+
+	got, err := array.Take(slice, []int{2, 0, 1}, mem)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer got.Release()
+
+	if got, want := got.(*array.String).String(), `["def" "bc" (null)]`; got != want {
+		t.Fatalf("got=%s, want=%s", got, want)
+	}
+
+	_, err = array.Take(slice, []int{3}, mem)
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if got, want := err.Error(), "arrow/array: take index 3 out of range [0, 3)"; got != want {
+		t.Fatalf("invalid error: got=%q, want=%q", got, want)
+	}
+}
+
+// cancelAfter is a context which is canceled after n checks of its Err method.
+type cancelAfter struct {
+	context.Context
+	n int
+}
+
+func (ctx *cancelAfter) Err() error {
+	if ctx.n <= 0 {
+		return context.Canceled
+	}
+	ctx.n--
+	return nil
+}
+
+func TestTakeCtx(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	const n = 100000
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+	indices := make([]int, n)
+	for i := range indices {
+		if i%7 == 0 {
+			bldr.AppendNull()
+		} else {
+			bldr.Append("v")
+		}
+		indices[i] = n - 1 - i
+	}
+	arr := bldr.NewStringArray()
+	defer arr.Release()
+
+	// the indices, the validity and the values are each checked 13 times:
+	// cancel before starting and in the middle of each of these passes.
+	size := mem.CurrentAlloc()
+	for _, checks := range []int{0, 5, 18, 31} {
+		ctx := &cancelAfter{Context: context.Background(), n: checks}
+		got, err := array.TakeCtx(ctx, arr, indices, mem)
+		if err != context.Canceled {
+			t.Fatalf("checks=%d: invalid error: got=%v, want=%v", checks, err, context.Canceled)
+		}
+		if got != nil {
+			t.Fatalf("checks=%d: expected no array", checks)
+		}
+		if got := mem.CurrentAlloc(); got != size {
+			t.Fatalf("checks=%d: leaked %d bytes", checks, got-size)
+		}
+	}
+
+	got, err := array.TakeCtx(context.Background(), arr, indices, mem)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer got.Release()
+	if got, want := got.(*array.String).Value(0), "v"; got != want {
+		t.Fatalf("got=%q, want=%q", got, want)
+	}
+}
+
+func TestTakeDictionary(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]string{"a", "b", "", "a", "c"}, []bool{true, true, false, true, true})
+
+	arr := bldr.NewStringArray()
+	defer arr.Release()
+
+	dict, err := array.DictionaryEncode(arr, arrow.PrimitiveTypes.Int8, mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dict.Release()
+
+	got, err := array.Take(dict, []int{4, 0, 2, 3}, mem)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer got.Release()
+
+	out := got.(*array.Dictionary)
+	if got, want := fmt.Sprintf("%v", out.Indices()), `[2 0 (null) 0]`; got != want {
+		t.Fatalf("invalid indices: got=%s, want=%s", got, want)
+	}
+	if got, want := fmt.Sprintf("%v", out.Dictionary()), `["a" "b" "c"]`; got != want {
+		t.Fatalf("invalid dictionary: got=%s, want=%s", got, want)
+	}
+}
@@ -0,0 +1,131 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// ConvertTimestampUnit returns a new timestamp array holding the values of
+// arr converted to the unit to, with the time zone of arr.
+// Converting to a coarser unit truncates the values toward zero.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// Release()'d after use.
+//
+// ConvertTimestampUnit returns an error if a value overflows when converted
+// to a finer unit.
+func ConvertTimestampUnit(arr *Timestamp, to arrow.TimeUnit) (*Timestamp, error) {
+	dtype := arr.DataType().(*arrow.TimestampType)
+
+	bldr := NewTimestampBuilder(memory.DefaultAllocator, &arrow.TimestampType{Unit: to, TimeZone: dtype.TimeZone})
+	defer bldr.Release()
+
+	bldr.Reserve(arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			bldr.UnsafeAppendBoolToBitmap(false)
+			continue
+		}
+		v, ok := convertTimeUnit(int64(arr.Value(i)), dtype.Unit, to)
+		if !ok {
+			return nil, fmt.Errorf("arrow/array: timestamp %d at index %d overflows when converted from %v to %v", arr.Value(i), i, dtype.Unit, to)
+		}
+		bldr.UnsafeAppend(arrow.Timestamp(v))
+	}
+	return bldr.NewTimestampArray(), nil
+}
+
+// AddDuration returns a new timestamp array holding the sums of the
+// elements of ts and d, element-wise. Elements where either ts or d is null
+// are null.
+//
+// When the units of ts and d differ, the values are converted to the finer
+// of both units, which is the unit of the returned array.
+// The returned array is allocated with memory.DefaultAllocator and must be
+// Release()'d after use.
+//
+// AddDuration returns an error if ts and d have different lengths, or if a
+// value overflows.
+func AddDuration(ts *Timestamp, d *Duration) (*Timestamp, error) {
+	if ts.Len() != d.Len() {
+		return nil, fmt.Errorf("arrow/array: timestamp and duration arrays length mismatch (%d != %d)", ts.Len(), d.Len())
+	}
+
+	var (
+		ttype = ts.DataType().(*arrow.TimestampType)
+		dunit = d.DataType().(*arrow.DurationType).Unit
+		unit  = ttype.Unit
+	)
+	if dunit < unit { // finer units have lower values.
+		unit = dunit
+	}
+
+	bldr := NewTimestampBuilder(memory.DefaultAllocator, &arrow.TimestampType{Unit: unit, TimeZone: ttype.TimeZone})
+	defer bldr.Release()
+
+	bldr.Reserve(ts.Len())
+	for i := 0; i < ts.Len(); i++ {
+		if ts.IsNull(i) || d.IsNull(i) {
+			bldr.UnsafeAppendBoolToBitmap(false)
+			continue
+		}
+		t, ok1 := convertTimeUnit(int64(ts.Value(i)), ttype.Unit, unit)
+		v, ok2 := convertTimeUnit(int64(d.Value(i)), dunit, unit)
+		sum := t + v
+		if !ok1 || !ok2 || (v > 0 && sum < t) || (v < 0 && sum > t) {
+			return nil, fmt.Errorf("arrow/array: timestamp %d plus duration %d at index %d overflows", ts.Value(i), d.Value(i), i)
+		}
+		bldr.UnsafeAppend(arrow.Timestamp(sum))
+	}
+	return bldr.NewTimestampArray(), nil
+}
+
+// unitNanoseconds returns the number of nanoseconds in one unit.
+func unitNanoseconds(u arrow.TimeUnit) int64 {
+	switch u {
+	case arrow.Second:
+		return 1e9
+	case arrow.Millisecond:
+		return 1e6
+	case arrow.Microsecond:
+		return 1e3
+	default:
+		return 1
+	}
+}
+
+// convertTimeUnit converts the value v, in unit from, to the unit to,
+// truncating it toward zero when to is coarser, and reports whether the
+// converted value did not overflow.
+func convertTimeUnit(v int64, from, to arrow.TimeUnit) (int64, bool) {
+	f, t := unitNanoseconds(from), unitNanoseconds(to)
+	switch {
+	case f == t:
+		return v, true
+	case f < t:
+		return v / (t / f), true
+	}
+	m := f / t
+	if v > math.MaxInt64/m || v < math.MinInt64/m {
+		return 0, false
+	}
+	return v * m, true
+}
@@ -0,0 +1,182 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestConvertTimestampUnit(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	newTimestamps := func(unit arrow.TimeUnit, vs []arrow.Timestamp, valid []bool) *array.Timestamp {
+		b := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: unit, TimeZone: "UTC"})
+		defer b.Release()
+		b.AppendValues(vs, valid)
+		return b.NewTimestampArray()
+	}
+
+	for _, tc := range []struct {
+		name     string
+		from, to arrow.TimeUnit
+		values   []arrow.Timestamp
+		valid    []bool
+		want     []arrow.Timestamp
+		err      string
+	}{
+		{
+			name:   "ms-to-ns",
+			from:   arrow.Millisecond,
+			to:     arrow.Nanosecond,
+			values: []arrow.Timestamp{1, -2, 0, 1600000000000},
+			valid:  []bool{true, true, false, true},
+			want:   []arrow.Timestamp{1000000, -2000000, 0, 1600000000000000000},
+		},
+		{
+			name:   "ms-to-ns-overflow",
+			from:   arrow.Millisecond,
+			to:     arrow.Nanosecond,
+			values: []arrow.Timestamp{1, math.MaxInt64 / 1000},
+			err:    "timestamp 9223372036854775 at index 1 overflows when converted from ms to ns",
+		},
+		{
+			name:   "ns-to-s",
+			from:   arrow.Nanosecond,
+			to:     arrow.Second,
+			values: []arrow.Timestamp{1999999999, -1999999999, 0, 42},
+			valid:  []bool{true, true, false, true},
+			want:   []arrow.Timestamp{1, -1, 0, 0},
+		},
+		{
+			name:   "s-to-s",
+			from:   arrow.Second,
+			to:     arrow.Second,
+			values: []arrow.Timestamp{1, 2},
+			want:   []arrow.Timestamp{1, 2},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			arr := newTimestamps(tc.from, tc.values, tc.valid)
+			defer arr.Release()
+
+			got, err := array.ConvertTimestampUnit(arr, tc.to)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer got.Release()
+
+			want := newTimestamps(tc.to, tc.want, tc.valid)
+			defer want.Release()
+
+			if !array.ArrayEqual(got, want) {
+				t.Fatalf("invalid timestamps:\ngot= %v (%v)\nwant=%v (%v)", got, got.DataType(), want, want.DataType())
+			}
+		})
+	}
+}
+
+func TestAddDuration(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	tb := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Second})
+	defer tb.Release()
+	tb.AppendValues([]arrow.Timestamp{10, 20, 30, 0}, []bool{true, true, false, true})
+	ts := tb.NewTimestampArray()
+	defer ts.Release()
+
+	for _, tc := range []struct {
+		name string
+		dt   *arrow.DurationType
+		ds   []arrow.Duration
+		unit arrow.TimeUnit
+		want []arrow.Timestamp
+		err  string
+	}{
+		{
+			name: "same-unit",
+			dt:   &arrow.DurationType{Unit: arrow.Second},
+			ds:   []arrow.Duration{1, -2, 3, 0},
+			unit: arrow.Second,
+			want: []arrow.Timestamp{11, 18, 0, 0},
+		},
+		{
+			name: "finer-duration",
+			dt:   &arrow.DurationType{Unit: arrow.Millisecond},
+			ds:   []arrow.Duration{1, -2, 3, 0},
+			unit: arrow.Millisecond,
+			want: []arrow.Timestamp{10001, 19998, 0, 0},
+		},
+		{
+			name: "coarser-duration",
+			dt:   &arrow.DurationType{Unit: arrow.Second},
+			ds:   []arrow.Duration{math.MaxInt64, 0, 0, 0},
+			err:  "timestamp 10 plus duration 9223372036854775807 at index 0 overflows",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			db := array.NewDurationBuilder(mem, tc.dt)
+			defer db.Release()
+			// the last duration is null.
+			db.AppendValues(tc.ds, []bool{true, true, true, false})
+			ds := db.NewDurationArray()
+			defer ds.Release()
+
+			got, err := array.AddDuration(ts, ds)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer got.Release()
+
+			if got, want := got.DataType().(*arrow.TimestampType).Unit, tc.unit; got != want {
+				t.Fatalf("invalid unit: got=%v, want=%v", got, want)
+			}
+			if got, want := got.NullN(), 2; got != want {
+				t.Fatalf("invalid number of nulls: got=%d, want=%d", got, want)
+			}
+			vs := make([]arrow.Timestamp, got.Len())
+			for i := range vs {
+				if got.IsValid(i) {
+					vs[i] = got.Value(i)
+				}
+			}
+			if !reflect.DeepEqual(vs, tc.want) {
+				t.Fatalf("invalid timestamps: got=%v, want=%v", vs, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import "github.com/apache/arrow/go/arrow/memory"
+
+// TotalBytes returns the number of bytes held by the buffers of arr,
+// including its validity bitmap and the buffers of its children, e.g. the
+// fields of a struct or the values of a list.
+//
+// TotalBytes counts the lengths of the buffers, not their capacities.
+// The buffers of a sliced array are counted in full, as they are shared with
+// the array it was sliced from.
+// When dedupeShared is true, a buffer referenced several times, e.g. by
+// several children sharing the same data, is only counted once.
+func TotalBytes(arr Interface, dedupeShared bool) int64 {
+	var seen map[*memory.Buffer]struct{}
+	if dedupeShared {
+		seen = make(map[*memory.Buffer]struct{})
+	}
+	return dataTotalBytes(arr.Data(), seen)
+}
+
+// dataTotalBytes returns the number of bytes held by the buffers of data
+// and of its children, skipping the buffers already in seen, when not nil.
+func dataTotalBytes(data *Data, seen map[*memory.Buffer]struct{}) int64 {
+	var n int64
+	for _, buf := range data.buffers {
+		if buf == nil {
+			continue
+		}
+		if seen != nil {
+			if _, dup := seen[buf]; dup {
+				continue
+			}
+			seen[buf] = struct{}{}
+		}
+		n += int64(buf.Len())
+	}
+	for _, child := range data.childData {
+		if child != nil {
+			n += dataTotalBytes(child, seen)
+		}
+	}
+	return n
+}
@@ -0,0 +1,88 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestTotalBytes(t *testing.T) {
+	// struct<l: list<int32>, i: int64>, with 4 elements:
+	//  - int32 values: 6 values, no validity bitmap -> 24 bytes
+	//  - list: validity bitmap (1 byte) and 5 offsets -> 1 + 20 bytes
+	//  - int64 field: 4 values, no validity bitmap -> 32 bytes
+	//  - struct: validity bitmap -> 1 byte
+	var (
+		ltype = arrow.ListOf(arrow.PrimitiveTypes.Int32)
+		stype = arrow.StructOf(
+			arrow.Field{Name: "l", Type: ltype, Nullable: true},
+			arrow.Field{Name: "i", Type: arrow.PrimitiveTypes.Int64},
+		)
+		bitmap = memory.NewBufferBytes([]byte{0x0d}) // 1011
+	)
+
+	values := array.NewData(arrow.PrimitiveTypes.Int32, 6,
+		[]*memory.Buffer{nil, memory.NewBufferBytes(arrow.Int32Traits.CastToBytes([]int32{0, 1, 2, 3, 4, 5}))},
+		nil, 0, 0,
+	)
+	defer values.Release()
+
+	list := array.NewData(ltype, 4,
+		[]*memory.Buffer{bitmap, memory.NewBufferBytes(arrow.Int32Traits.CastToBytes([]int32{0, 2, 2, 5, 6}))},
+		[]*array.Data{values}, 1, 0,
+	)
+	defer list.Release()
+
+	ints := array.NewData(arrow.PrimitiveTypes.Int64, 4,
+		[]*memory.Buffer{nil, memory.NewBufferBytes(arrow.Int64Traits.CastToBytes([]int64{1, 2, 3, 4}))},
+		nil, 0, 0,
+	)
+	defer ints.Release()
+
+	data := array.NewData(stype, 4, []*memory.Buffer{bitmap}, []*array.Data{list, ints}, 1, 0)
+	defer data.Release()
+
+	arr := array.MakeFromData(data)
+	defer arr.Release()
+
+	if got, want := array.TotalBytes(arr, false), int64(24+1+20+32+1); got != want {
+		t.Fatalf("invalid total bytes: got=%d, want=%d", got, want)
+	}
+	// the validity bitmap is shared by the struct and the list.
+	if got, want := array.TotalBytes(arr, true), int64(24+1+20+32); got != want {
+		t.Fatalf("invalid deduplicated total bytes: got=%d, want=%d", got, want)
+	}
+
+	// a slice shares the buffers of its parent.
+	slice := array.NewSlice(arr, 1, 3)
+	defer slice.Release()
+	if got, want := array.TotalBytes(slice, false), array.TotalBytes(arr, false); got != want {
+		t.Fatalf("invalid total bytes of slice: got=%d, want=%d", got, want)
+	}
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "a", Type: stype}, {Name: "b", Type: stype}}, nil)
+	rec := array.NewRecord(schema, []array.Interface{arr, slice}, 2)
+	defer rec.Release()
+
+	if got, want := rec.TotalBytes(), array.TotalBytes(arr, true); got != want {
+		t.Fatalf("invalid record total bytes: got=%d, want=%d", got, want)
+	}
+}
@@ -0,0 +1,336 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+)
+
+// Validate checks the structural invariants of the array which can be
+// verified without scanning its values: the number and sizes of its
+// buffers, the bounds of its offsets and the lengths and types of its
+// child arrays.
+//
+// Validate returns an error describing the first violation found.
+func (a *array) Validate() error { return validateArrayData(a.data, false) }
+
+// ValidateFull checks the invariants verified by Validate, and the ones
+// which require scanning the values of the array: offsets are monotonic,
+// the values of String arrays are valid UTF-8, dictionary indices and run
+// ends are in range, and the null count matches the validity bitmap.
+//
+// ValidateFull returns an error describing the first violation found.
+func (a *array) ValidateFull() error { return validateArrayData(a.data, true) }
+
+func validateArrayData(data *Data, full bool) error {
+	if err := validateData(data, full); err != nil {
+		return fmt.Errorf("arrow/array: invalid %v array: %v", data.dtype, err)
+	}
+	return nil
+}
+
+func validateData(data *Data, full bool) error {
+	if data.length < 0 {
+		return fmt.Errorf("negative length %d", data.length)
+	}
+	if data.offset < 0 {
+		return fmt.Errorf("negative offset %d", data.offset)
+	}
+
+	layout := data.dtype.Layout()
+	for i := len(layout.Buffers); i < len(data.buffers); i++ {
+		// trailing buffers are tolerated if nil, as produced by StructBuilder.
+		if data.buffers[i] != nil {
+			return fmt.Errorf("invalid number of buffers (got=%d, want=%d)", len(data.buffers), len(layout.Buffers))
+		}
+	}
+
+	end := data.offset + data.length
+	for i, spec := range layout.Buffers {
+		var size int
+		if i < len(data.buffers) && data.buffers[i] != nil {
+			size = data.buffers[i].Len()
+		}
+
+		var want int
+		switch spec.Kind {
+		case arrow.KindAlwaysNull:
+			if size != 0 {
+				return fmt.Errorf("buffer %d must be nil", i)
+			}
+			continue
+		case arrow.KindBitmap:
+			if i == 0 && size == 0 {
+				if data.nulls > 0 {
+					return fmt.Errorf("null count is %d but there is no validity bitmap", data.nulls)
+				}
+				continue
+			}
+			want = int(bitutil.BytesForBits(int64(end)))
+		case arrow.KindFixedWidth:
+			want = end * spec.ByteWidth
+			if i == 1 && (len(layout.Buffers) == 3 || isListType(data.dtype)) {
+				// offsets buffer of a variable-width or list type.
+				if data.length > 0 {
+					want = (end + 1) * spec.ByteWidth
+				}
+			}
+		case arrow.KindVarWidth:
+			// checked with the offsets.
+			continue
+		}
+		if size < want {
+			return fmt.Errorf("buffer %d too small for %d elements at offset %d (got=%d bytes, want>=%d)", i, data.length, data.offset, size, want)
+		}
+	}
+
+	if data.nulls > data.length {
+		return fmt.Errorf("null count %d greater than length %d", data.nulls, data.length)
+	}
+	if full && data.nulls != UnknownNullCount && len(data.buffers) > 0 && data.buffers[0] != nil && layout.Buffers[0].Kind == arrow.KindBitmap {
+		nulls := data.length - bitutil.CountSetBits(data.buffers[0].Bytes(), data.offset, data.length)
+		if nulls != data.nulls {
+			return fmt.Errorf("null count %d does not match validity bitmap (%d nulls)", data.nulls, nulls)
+		}
+	}
+
+	nchildren := layout.NumChildren
+	if data.dtype.ID() == arrow.DICTIONARY {
+		nchildren = 1
+	}
+	if len(data.childData) != nchildren {
+		return fmt.Errorf("invalid number of child arrays (got=%d, want=%d)", len(data.childData), nchildren)
+	}
+	for i, child := range data.childData {
+		if child == nil {
+			return fmt.Errorf("child array %d is nil", i)
+		}
+	}
+
+	switch dt := data.dtype.(type) {
+	case *arrow.StringType:
+		offsets := arrow.Int32Traits.CastFromBytes(dataBuffer(data, 1))
+		if err := validateOffsets(data, full, int32Offsets(offsets), len(dataBuffer(data, 2))); err != nil {
+			return err
+		}
+		if full {
+			return validateUTF8(data, int32Offsets(offsets))
+		}
+	case *arrow.BinaryType:
+		offsets := arrow.Int32Traits.CastFromBytes(dataBuffer(data, 1))
+		return validateOffsets(data, full, int32Offsets(offsets), len(dataBuffer(data, 2)))
+	case *arrow.LargeStringType:
+		offsets := arrow.Int64Traits.CastFromBytes(dataBuffer(data, 1))
+		if err := validateOffsets(data, full, int64Offsets(offsets), len(dataBuffer(data, 2))); err != nil {
+			return err
+		}
+		if full {
+			return validateUTF8(data, int64Offsets(offsets))
+		}
+	case *arrow.LargeBinaryType:
+		offsets := arrow.Int64Traits.CastFromBytes(dataBuffer(data, 1))
+		return validateOffsets(data, full, int64Offsets(offsets), len(dataBuffer(data, 2)))
+	case *arrow.ListType:
+		offsets := arrow.Int32Traits.CastFromBytes(dataBuffer(data, 1))
+		if err := validateOffsets(data, full, int32Offsets(offsets), data.childData[0].length); err != nil {
+			return err
+		}
+		return validateChild(data, 0, dt.Elem(), full)
+	case *arrow.LargeListType:
+		offsets := arrow.Int64Traits.CastFromBytes(dataBuffer(data, 1))
+		if err := validateOffsets(data, full, int64Offsets(offsets), data.childData[0].length); err != nil {
+			return err
+		}
+		return validateChild(data, 0, dt.Elem(), full)
+	case *arrow.FixedSizeListType:
+		if n, want := data.childData[0].length, end*int(dt.Len()); n < want {
+			return fmt.Errorf("child array 0 too short (got=%d, want>=%d)", n, want)
+		}
+		return validateChild(data, 0, dt.Elem(), full)
+	case *arrow.StructType:
+		for i, field := range dt.Fields() {
+			if n := data.childData[i].length; n < end {
+				return fmt.Errorf("child array %d (%s) too short (got=%d, want>=%d)", i, field.Name, n, end)
+			}
+			if err := validateChild(data, i, field.Type, full); err != nil {
+				return err
+			}
+		}
+	case *arrow.DictionaryType:
+		if err := validateChild(data, 0, dt.ValueType, full); err != nil {
+			return err
+		}
+		if full {
+			return validateDictionaryIndices(data)
+		}
+	case *arrow.RunEndEncodedType:
+		return validateRunEnds(data, dt, full)
+	}
+	return nil
+}
+
+func isListType(dt arrow.DataType) bool {
+	switch dt.ID() {
+	case arrow.LIST, arrow.LARGE_LIST:
+		return true
+	}
+	return false
+}
+
+// dataBuffer returns the bytes of the i-th buffer of data, or nil if there
+// is no such buffer.
+func dataBuffer(data *Data, i int) []byte {
+	if i >= len(data.buffers) || data.buffers[i] == nil {
+		return nil
+	}
+	return data.buffers[i].Bytes()
+}
+
+// validateChild validates the i-th child array of data, which must be of
+// type dt.
+func validateChild(data *Data, i int, dt arrow.DataType, full bool) error {
+	child := data.childData[i]
+	if !arrow.TypeEquals(child.dtype, dt) {
+		return fmt.Errorf("child array %d type %v does not match %v", i, child.dtype, dt)
+	}
+	if err := validateData(child, full); err != nil {
+		return fmt.Errorf("child array %d: %v", i, err)
+	}
+	return nil
+}
+
+type (
+	int32Offsets []int32
+	int64Offsets []int64
+)
+
+// offsetsReader reads the offsets of a variable-width or list array.
+type offsetsReader interface {
+	offset(i int) int
+}
+
+func (o int32Offsets) offset(i int) int { return int(o[i]) }
+func (o int64Offsets) offset(i int) int { return int(o[i]) }
+
+// validateOffsets checks that the offsets of the elements of data address
+// values within [0, size), and, if full, that they are monotonic.
+func validateOffsets(data *Data, full bool, offsets offsetsReader, size int) error {
+	if data.length == 0 {
+		return nil
+	}
+
+	beg, end := data.offset, data.offset+data.length
+	first, last := offsets.offset(beg), offsets.offset(end)
+	switch {
+	case first < 0 || first > size:
+		return fmt.Errorf("first offset %d out of bounds [0, %d]", first, size)
+	case last < first || last > size:
+		return fmt.Errorf("last offset %d out of bounds [%d, %d]", last, first, size)
+	}
+
+	if full {
+		for i := beg; i < end; i++ {
+			if offsets.offset(i+1) < offsets.offset(i) {
+				return fmt.Errorf("offsets not monotonic at index %d (%d > %d)", i-beg, offsets.offset(i), offsets.offset(i+1))
+			}
+		}
+	}
+	return nil
+}
+
+// validateUTF8 checks that the non-null values of the String or LargeString
+// data are valid UTF-8.
+func validateUTF8(data *Data, offsets offsetsReader) error {
+	values := dataBuffer(data, 2)
+	validity := dataBuffer(data, 0)
+	for i := 0; i < data.length; i++ {
+		j := data.offset + i
+		if validity != nil && bitutil.BitIsNotSet(validity, j) {
+			continue
+		}
+		if !utf8.Valid(values[offsets.offset(j):offsets.offset(j+1)]) {
+			return fmt.Errorf("invalid UTF-8 value at index %d", i)
+		}
+	}
+	return nil
+}
+
+// validateDictionaryIndices checks that the non-null indices of the
+// dictionary data are within the bounds of the dictionary.
+func validateDictionaryIndices(data *Data) error {
+	d := NewDictionaryData(data)
+	defer d.Release()
+
+	n := d.Dictionary().Len()
+	for i := 0; i < d.Len(); i++ {
+		if d.IsNull(i) {
+			continue
+		}
+		if j := d.GetValueIndex(i); j < 0 || j >= n {
+			return fmt.Errorf("dictionary index %d at index %d out of bounds [0, %d)", j, i, n)
+		}
+	}
+	return nil
+}
+
+// validateRunEnds checks the children of the run-end encoded data, and, if
+// full, that the run ends are positive, strictly increasing and cover the
+// elements of data.
+func validateRunEnds(data *Data, dt *arrow.RunEndEncodedType, full bool) error {
+	if err := validateChild(data, 0, dt.RunEnds, full); err != nil {
+		return err
+	}
+	if err := validateChild(data, 1, dt.Values, full); err != nil {
+		return err
+	}
+
+	ends := data.childData[0]
+	if n, want := data.childData[1].length, ends.length; n < want {
+		return fmt.Errorf("values shorter than run ends (got=%d, want>=%d)", n, want)
+	}
+	if ends.nulls > 0 {
+		return fmt.Errorf("run ends must not be null")
+	}
+	if data.length == 0 {
+		return nil
+	}
+	if ends.length == 0 {
+		return fmt.Errorf("no run ends for %d elements", data.length)
+	}
+
+	a := NewRunEndEncodedData(data)
+	defer a.Release()
+
+	if last, want := a.runEnd(ends.length-1), data.offset+data.length; last < want {
+		return fmt.Errorf("last run end %d does not cover %d elements at offset %d", last, data.length, data.offset)
+	}
+	if full {
+		prev := 0
+		for i := 0; i < ends.length; i++ {
+			end := a.runEnd(i)
+			if end <= prev {
+				return fmt.Errorf("run ends not strictly increasing at index %d (%d <= %d)", i, end, prev)
+			}
+			prev = end
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package array_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestValidateRecords(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			for _, rec := range recs {
+				for i, col := range rec.Columns() {
+					if err := col.ValidateFull(); err != nil {
+						t.Fatalf("column %q: unexpected error: %v", rec.ColumnName(i), err)
+					}
+					if col.Len() == 0 {
+						continue
+					}
+					slice := array.NewSlice(col, 1, int64(col.Len()))
+					err := slice.ValidateFull()
+					slice.Release()
+					if err != nil {
+						t.Fatalf("column %q: unexpected error on slice: %v", rec.ColumnName(i), err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestValidateInvalid(t *testing.T) {
+	var (
+		buf = func(b []byte) *memory.Buffer { return memory.NewBufferBytes(b) }
+		i32 = func(vs ...int32) *memory.Buffer { return buf(arrow.Int32Traits.CastToBytes(vs)) }
+		i8  = func(vs ...int8) *memory.Buffer { return buf(arrow.Int8Traits.CastToBytes(vs)) }
+		str = func(s string) *memory.Buffer { return buf([]byte(s)) }
+
+		int32Data = array.NewData(arrow.PrimitiveTypes.Int32, 3, []*memory.Buffer{nil, i32(1, 2, 3)}, nil, 0, 0)
+		dictData  = array.NewData(arrow.BinaryTypes.String, 2, []*memory.Buffer{nil, i32(0, 1, 2), str("ab")}, nil, 0, 0)
+	)
+	defer int32Data.Release()
+	defer dictData.Release()
+
+	for _, tc := range []struct {
+		name string
+		data *array.Data
+		full bool // whether the violation is only detected by ValidateFull
+		err  string
+	}{
+		{
+			name: "validity-too-small",
+			data: array.NewData(arrow.BinaryTypes.String, 20, []*memory.Buffer{buf([]byte{0xff}), i32(make([]int32, 21)...), nil}, nil, array.UnknownNullCount, 0),
+			err:  "arrow/array: invalid utf8 array: buffer 0 too small for 20 elements at offset 0 (got=1 bytes, want>=3)",
+		},
+		{
+			name: "offsets-out-of-bounds",
+			data: array.NewData(arrow.BinaryTypes.String, 2, []*memory.Buffer{nil, i32(0, 2, 10), str("abc")}, nil, 0, 0),
+			err:  "arrow/array: invalid utf8 array: last offset 10 out of bounds [0, 3]",
+		},
+		{
+			name: "offsets-not-monotonic",
+			data: array.NewData(arrow.BinaryTypes.Binary, 3, []*memory.Buffer{nil, i32(0, 3, 1, 3), str("abc")}, nil, 0, 0),
+			full: true,
+			err:  "arrow/array: invalid binary array: offsets not monotonic at index 1 (3 > 1)",
+		},
+		{
+			name: "invalid-utf8",
+			data: array.NewData(arrow.BinaryTypes.String, 2, []*memory.Buffer{nil, i32(0, 1, 2), str("a\xff")}, nil, 0, 0),
+			full: true,
+			err:  "arrow/array: invalid utf8 array: invalid UTF-8 value at index 1",
+		},
+		{
+			name: "list-child-too-short",
+			data: array.NewData(arrow.ListOf(arrow.PrimitiveTypes.Int32), 2, []*memory.Buffer{nil, i32(0, 2, 5)}, []*array.Data{int32Data}, 0, 0),
+			err:  "arrow/array: invalid list<item: int32> array: last offset 5 out of bounds [0, 3]",
+		},
+		{
+			name: "list-child-type",
+			data: array.NewData(arrow.ListOf(arrow.PrimitiveTypes.Int64), 1, []*memory.Buffer{nil, i32(0, 2)}, []*array.Data{int32Data}, 0, 0),
+			err:  "arrow/array: invalid list<item: int64> array: child array 0 type int32 does not match int64",
+		},
+		{
+			name: "struct-child-too-short",
+			data: array.NewData(arrow.StructOf(arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32}), 4, []*memory.Buffer{nil}, []*array.Data{int32Data}, 0, 0),
+			err:  "arrow/array: invalid struct<a: int32> array: child array 0 (a) too short (got=3, want>=4)",
+		},
+		{
+			name: "dictionary-index-out-of-range",
+			data: array.NewData(&arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String}, 3, []*memory.Buffer{nil, i8(0, 1, 2)}, []*array.Data{dictData}, 0, 0),
+			full: true,
+			err:  "arrow/array: invalid dictionary<values=utf8, indices=int8, ordered=false> array: dictionary index 2 at index 2 out of bounds [0, 2)",
+		},
+		{
+			name: "null-count-mismatch",
+			data: array.NewData(arrow.PrimitiveTypes.Int32, 3, []*memory.Buffer{buf([]byte{0x07}), i32(1, 2, 3)}, nil, 1, 0),
+			full: true,
+			err:  "arrow/array: invalid int32 array: null count 1 does not match validity bitmap (0 nulls)",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defer tc.data.Release()
+			arr := array.MakeFromData(tc.data)
+			defer arr.Release()
+
+			err := arr.Validate()
+			if tc.full {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				err = arr.ValidateFull()
+			}
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if got, want := err.Error(), tc.err; got != want {
+				t.Fatalf("invalid error:\ngot= %q\nwant=%q", got, want)
+			}
+		})
+	}
+}
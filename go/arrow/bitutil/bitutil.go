@@ -62,6 +62,74 @@ func SetBitTo(buf []byte, i int, val bool) {
 	}
 }
 
+// SetBitsTo sets the length bits of buf starting at bit index start to v.
+func SetBitsTo(buf []byte, start, length int64, v bool) {
+	if length == 0 {
+		return
+	}
+
+	var fill byte
+	if v {
+		fill = 0xff
+	}
+
+	beg := start / 8
+	end := (start + length - 1) / 8
+	// masks selecting the bits to modify in the first and last bytes.
+	first := byte(0xff) << uint(start%8)
+	last := byte(0xff) >> uint(7-(start+length-1)%8)
+
+	if beg == end {
+		mask := first & last
+		buf[beg] = buf[beg]&^mask | fill&mask
+		return
+	}
+
+	buf[beg] = buf[beg]&^first | fill&first
+	for i := beg + 1; i < end; i++ {
+		buf[i] = fill
+	}
+	buf[end] = buf[end]&^last | fill&last
+}
+
+// CopyBitmap copies the length bits of src starting at bit index srcOffset
+// to dst starting at bit index dstOffset.
+// The other bits of dst are left untouched.
+func CopyBitmap(src []byte, srcOffset, length int, dst []byte, dstOffset int) {
+	if srcOffset%8 == 0 && dstOffset%8 == 0 {
+		// byte-aligned: copy the whole bytes, then the tail bits.
+		n := length / 8
+		copy(dst[dstOffset/8:dstOffset/8+n], src[srcOffset/8:srcOffset/8+n])
+		srcOffset += 8 * n
+		dstOffset += 8 * n
+		length -= 8 * n
+	}
+
+	for length > 0 {
+		// fill the current destination byte with as many bits as it can hold.
+		shift := uint(dstOffset % 8)
+		n := min(8-int(shift), length)
+		mask := byte(1<<uint(n)-1) << shift
+		v := readBits(src, srcOffset, n) << shift
+		dst[dstOffset/8] = dst[dstOffset/8]&^mask | v&mask
+		srcOffset += n
+		dstOffset += n
+		length -= n
+	}
+}
+
+// readBits returns the n ≤ 8 bits of buf starting at bit index offset,
+// in the low bits of the returned byte.
+func readBits(buf []byte, offset, n int) byte {
+	i := offset / 8
+	shift := uint(offset % 8)
+	v := uint16(buf[i]) >> shift
+	if int(shift)+n > 8 {
+		v |= uint16(buf[i+1]) << (8 - shift)
+	}
+	return byte(v) & byte(1<<uint(n)-1)
+}
+
 // CountSetBits counts the number of 1's in buf up to n bits.
 func CountSetBits(buf []byte, offset, n int) int {
 	if offset > 0 {
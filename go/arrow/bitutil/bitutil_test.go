@@ -126,6 +126,47 @@ func TestSetBitTo(t *testing.T) {
 	assert.Equal(t, []byte{0xa1, 0xc2}, buf)
 }
 
+func TestSetBitsTo(t *testing.T) {
+	for _, fill := range []byte{0x00, 0xff} {
+		for _, v := range []bool{false, true} {
+			for start := int64(0); start < 24; start++ {
+				for length := int64(0); start+length <= 32; length++ {
+					want := []byte{fill, fill, fill, fill}
+					for i := start; i < start+length; i++ {
+						bitutil.SetBitTo(want, int(i), v)
+					}
+					got := []byte{fill, fill, fill, fill}
+					bitutil.SetBitsTo(got, start, length, v)
+					if !assert.Equal(t, want, got, "fill=%#x, v=%v, start=%d, length=%d", fill, v, start, length) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestCopyBitmap(t *testing.T) {
+	src := []byte{0xa5, 0x3c, 0x0f, 0x96}
+	for _, fill := range []byte{0x00, 0xff} {
+		for srcOffset := 0; srcOffset < 16; srcOffset++ {
+			for dstOffset := 0; dstOffset < 16; dstOffset++ {
+				for length := 0; srcOffset+length <= 32 && dstOffset+length <= 32; length++ {
+					want := []byte{fill, fill, fill, fill}
+					for i := 0; i < length; i++ {
+						bitutil.SetBitTo(want, dstOffset+i, bitutil.BitIsSet(src, srcOffset+i))
+					}
+					got := []byte{fill, fill, fill, fill}
+					bitutil.CopyBitmap(src, srcOffset, length, got, dstOffset)
+					if !assert.Equal(t, want, got, "fill=%#x, src=%d, dst=%d, length=%d", fill, srcOffset, dstOffset, length) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
 func TestCountSetBits(t *testing.T) {
 	tests := []struct {
 		name string
@@ -153,6 +194,10 @@ func TestCountSetBits(t *testing.T) {
 		{"some 11 bits - offset+6", bbits(0x11000011, 0x01000000, 0x00000000), 6, 11, 3},
 		{"some 11 bits - offset+7", bbits(0x11000011, 0x01000000, 0x00000000), 7, 11, 2},
 		{"some 11 bits - offset+8", bbits(0x11000011, 0x01000000, 0x00000000), 8, 11, 1},
+
+		{"none 00 bits", bbits(0x11111111), 0, 0, 0},
+		{"none 00 bits - offset+3", bbits(0x11111111), 3, 0, 0},
+		{"all  72 bits - offset+3", bbits(0x11111111, 0x11111111, 0x11111111, 0x11111111, 0x11111111, 0x11111111, 0x11111111, 0x11111111, 0x11111111, 0x11111111), 3, 9 * 8, 72},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -226,6 +271,14 @@ func BenchmarkSetBitTo(b *testing.B) {
 	}
 }
 
+func BenchmarkSetBitsTo(b *testing.B) {
+	buf := make([]byte, 1024)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bitutil.SetBitsTo(buf, 3, 8*1000, i%2 == 0)
+	}
+}
+
 var (
 	intval int
 )
@@ -285,3 +338,32 @@ func BenchmarkCountSetBitsOffset_1000(b *testing.B) {
 func BenchmarkCountSetBitsOffset_1024(b *testing.B) {
 	benchmarkCountSetBitsN(b, 1, 1024)
 }
+
+func BenchmarkCountSetBitsLarge(b *testing.B) {
+	const n = 1 << 20
+	buf := make([]byte, bitutil.CeilByte(n+3)/8)
+	for i := range buf {
+		buf[i] = byte(i*31 + 7)
+	}
+
+	for _, off := range []int{0, 3} {
+		b.Run(fmt.Sprintf("popcount/offset=%d", off), func(b *testing.B) {
+			b.SetBytes(n / 8)
+			for i := 0; i < b.N; i++ {
+				_ = bitutil.CountSetBits(buf, off, n)
+			}
+		})
+		b.Run(fmt.Sprintf("per-bit/offset=%d", off), func(b *testing.B) {
+			b.SetBytes(n / 8)
+			for i := 0; i < b.N; i++ {
+				count := 0
+				for j := off; j < off+n; j++ {
+					if bitutil.BitIsSet(buf, j) {
+						count++
+					}
+				}
+				_ = count
+			}
+		})
+	}
+}
@@ -0,0 +1,631 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdata
+
+// #include <stdlib.h>
+// #include "abi.h"
+//
+// void releaseExportedSchema(struct ArrowSchema*);
+// void releaseExportedArray(struct ArrowArray*);
+//
+// static void callReleaseSchema(struct ArrowSchema* s) { s->release(s); }
+// static void callReleaseArray(struct ArrowArray* a) { a->release(a); }
+//
+// static void* handleToPtr(uintptr_t h) { return (void*)h; }
+// static uintptr_t ptrToHandle(void* p) { return (uintptr_t)p; }
+import "C"
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+type (
+	// CArrowSchema is the ArrowSchema C struct, describing the data type of
+	// an array.
+	CArrowSchema = C.struct_ArrowSchema
+	// CArrowArray is the ArrowArray C struct, describing the data of an
+	// array.
+	CArrowArray = C.struct_ArrowArray
+)
+
+// ExportArrowArray exports arr through the C Data Interface, as an ArrowArray
+// C struct describing its data and an ArrowSchema C struct describing its
+// data type. The buffers of arr are shared with the consumer: no data is
+// copied.
+//
+// The ArrowArray retains the data of arr, and pins its buffers in memory,
+// until its release callback is called, so that arr itself may be released
+// right away.
+// Both structs are allocated with malloc: once the consumer is done with
+// them, they must be freed with FreeCArrowArray and FreeCArrowSchema.
+//
+// ExportArrowArray panics if the data type of arr is not supported.
+// Supported data types are the null, boolean, numeric, binary, string,
+// temporal, list and struct data types.
+func ExportArrowArray(arr array.Interface) (*CArrowArray, *CArrowSchema) {
+	if err := checkExportable(arr.DataType()); err != nil {
+		panic(err)
+	}
+
+	schema := (*CArrowSchema)(C.calloc(1, C.sizeof_struct_ArrowSchema))
+	exportSchema(schema, "", arr.DataType(), true)
+
+	out := (*CArrowArray)(C.calloc(1, C.sizeof_struct_ArrowArray))
+	exportArray(out, arr.Data())
+
+	return out, schema
+}
+
+// ImportArrowArray imports the array described by the arr and schema C
+// structs, as produced by another implementation of the C Data Interface.
+// The buffers of arr are shared with the returned array: no data is copied.
+//
+// ImportArrowArray takes ownership of arr, which is moved and marked as
+// released, and releases schema: neither may be used afterwards, even if an
+// error is returned. The structs themselves are not freed, as they are owned
+// by the caller. The release callback of arr is called once the returned
+// array, and every array sharing its buffers, has been released.
+func ImportArrowArray(arr *CArrowArray, schema *CArrowSchema) (array.Interface, error) {
+	defer ReleaseCArrowSchema(schema)
+
+	if arr.release == nil {
+		return nil, fmt.Errorf("arrow/cdata: cannot import released array")
+	}
+	imp := &importedArray{arr: moveArray(arr), refs: 1}
+	defer imp.release()
+
+	if schema.release == nil {
+		return nil, fmt.Errorf("arrow/cdata: cannot import released schema")
+	}
+	field, err := importField(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := importData(imp.arr, field.Type, imp)
+	if err != nil {
+		return nil, err
+	}
+	defer data.Release()
+
+	return array.MakeFromData(data), nil
+}
+
+// ReleaseCArrowSchema calls the release callback of schema, unless schema
+// was already released.
+func ReleaseCArrowSchema(schema *CArrowSchema) {
+	if schema.release != nil {
+		C.callReleaseSchema(schema)
+	}
+}
+
+// ReleaseCArrowArray calls the release callback of arr, unless arr was
+// already released.
+func ReleaseCArrowArray(arr *CArrowArray) {
+	if arr.release != nil {
+		C.callReleaseArray(arr)
+	}
+}
+
+// FreeCArrowSchema releases schema, as with ReleaseCArrowSchema, and frees
+// it. schema must have been allocated with malloc, as by ExportArrowArray.
+func FreeCArrowSchema(schema *CArrowSchema) {
+	ReleaseCArrowSchema(schema)
+	C.free(unsafe.Pointer(schema))
+}
+
+// FreeCArrowArray releases arr, as with ReleaseCArrowArray, and frees it.
+// arr must have been allocated with malloc, as by ExportArrowArray.
+func FreeCArrowArray(arr *CArrowArray) {
+	ReleaseCArrowArray(arr)
+	C.free(unsafe.Pointer(arr))
+}
+
+// exportedArray holds the data of an exported array, and the pinner keeping
+// its buffers in place while they are referenced from C memory.
+type exportedArray struct {
+	data   *array.Data
+	pinner runtime.Pinner
+}
+
+// handles holds the exported arrays, keyed by the handle stored in the
+// private data of their ArrowArray, until they are released.
+var handles = struct {
+	sync.Mutex
+	next uintptr
+	data map[uintptr]*exportedArray
+}{data: make(map[uintptr]*exportedArray)}
+
+func newHandle(exp *exportedArray) unsafe.Pointer {
+	handles.Lock()
+	defer handles.Unlock()
+	handles.next++
+	handles.data[handles.next] = exp
+	return C.handleToPtr(C.uintptr_t(handles.next))
+}
+
+func dropHandle(p unsafe.Pointer) *exportedArray {
+	h := uintptr(C.ptrToHandle(p))
+	handles.Lock()
+	defer handles.Unlock()
+	exp := handles.data[h]
+	delete(handles.data, h)
+	return exp
+}
+
+// importedArray is the memory.Allocator of the buffers of an imported array,
+// which are owned by its producer: the moved ArrowArray is released, and
+// freed, once every buffer has been freed.
+type importedArray struct {
+	arr  *CArrowArray
+	refs int64
+}
+
+func (*importedArray) Allocate(int) []byte {
+	panic("arrow/cdata: cannot allocate imported memory")
+}
+
+func (*importedArray) Reallocate(int, []byte) []byte {
+	panic("arrow/cdata: cannot reallocate imported memory")
+}
+
+func (imp *importedArray) Free([]byte) { imp.release() }
+
+func (imp *importedArray) retain() { atomic.AddInt64(&imp.refs, 1) }
+
+func (imp *importedArray) release() {
+	if atomic.AddInt64(&imp.refs, -1) == 0 {
+		FreeCArrowArray(imp.arr)
+	}
+}
+
+// moveArray moves arr into a new struct allocated with malloc, and marks arr
+// as released, as the C Data Interface allows consumers to do.
+func moveArray(arr *CArrowArray) *CArrowArray {
+	out := (*CArrowArray)(C.malloc(C.sizeof_struct_ArrowArray))
+	*out = *arr
+	arr.release = nil
+	return out
+}
+
+const ptrSize = C.size_t(unsafe.Sizeof(uintptr(0)))
+
+func schemaChildren(s *CArrowSchema) []*CArrowSchema {
+	n := int(s.n_children)
+	if n == 0 || s.children == nil {
+		return nil
+	}
+	return (*[1 << 28]*CArrowSchema)(unsafe.Pointer(s.children))[:n:n]
+}
+
+func arrayChildren(a *CArrowArray) []*CArrowArray {
+	n := int(a.n_children)
+	if n == 0 || a.children == nil {
+		return nil
+	}
+	return (*[1 << 28]*CArrowArray)(unsafe.Pointer(a.children))[:n:n]
+}
+
+func arrayBuffers(a *CArrowArray) []unsafe.Pointer {
+	n := int(a.n_buffers)
+	if n == 0 || a.buffers == nil {
+		return nil
+	}
+	return (*[1 << 28]unsafe.Pointer)(unsafe.Pointer(a.buffers))[:n:n]
+}
+
+// primitiveFormats maps the format strings of the C Data Interface to the
+// data types without parameters.
+var primitiveFormats = map[string]arrow.DataType{
+	"n":   arrow.Null,
+	"b":   arrow.FixedWidthTypes.Boolean,
+	"c":   arrow.PrimitiveTypes.Int8,
+	"C":   arrow.PrimitiveTypes.Uint8,
+	"s":   arrow.PrimitiveTypes.Int16,
+	"S":   arrow.PrimitiveTypes.Uint16,
+	"i":   arrow.PrimitiveTypes.Int32,
+	"I":   arrow.PrimitiveTypes.Uint32,
+	"l":   arrow.PrimitiveTypes.Int64,
+	"L":   arrow.PrimitiveTypes.Uint64,
+	"e":   arrow.FixedWidthTypes.Float16,
+	"f":   arrow.PrimitiveTypes.Float32,
+	"g":   arrow.PrimitiveTypes.Float64,
+	"z":   arrow.BinaryTypes.Binary,
+	"Z":   arrow.BinaryTypes.LargeBinary,
+	"u":   arrow.BinaryTypes.String,
+	"U":   arrow.BinaryTypes.LargeString,
+	"tdD": arrow.FixedWidthTypes.Date32,
+	"tdm": arrow.FixedWidthTypes.Date64,
+	"tts": arrow.FixedWidthTypes.Time32s,
+	"ttm": arrow.FixedWidthTypes.Time32ms,
+	"ttu": arrow.FixedWidthTypes.Time64us,
+	"ttn": arrow.FixedWidthTypes.Time64ns,
+	"tDs": arrow.FixedWidthTypes.Duration_s,
+	"tDm": arrow.FixedWidthTypes.Duration_ms,
+	"tDu": arrow.FixedWidthTypes.Duration_us,
+	"tDn": arrow.FixedWidthTypes.Duration_ns,
+}
+
+// timeUnits holds the time unit of each format character.
+var timeUnits = map[byte]arrow.TimeUnit{
+	's': arrow.Second,
+	'm': arrow.Millisecond,
+	'u': arrow.Microsecond,
+	'n': arrow.Nanosecond,
+}
+
+// exportFormat returns the format string of dt.
+func exportFormat(dt arrow.DataType) (string, error) {
+	switch dt := dt.(type) {
+	case *arrow.FixedSizeBinaryType:
+		return "w:" + strconv.Itoa(dt.ByteWidth), nil
+	case *arrow.TimestampType:
+		return "ts" + string(dt.Unit.String()[0]) + ":" + dt.TimeZone, nil
+	case *arrow.ListType:
+		return "+l", nil
+	case *arrow.LargeListType:
+		return "+L", nil
+	case *arrow.FixedSizeListType:
+		return "+w:" + strconv.Itoa(int(dt.Len())), nil
+	case *arrow.StructType:
+		return "+s", nil
+	}
+	for format, typ := range primitiveFormats {
+		if arrow.TypeEquals(dt, typ) {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("arrow/cdata: unsupported data type %v", dt)
+}
+
+// exportChildren returns the fields of the child arrays of dt.
+func exportChildren(dt arrow.DataType) []arrow.Field {
+	switch dt := dt.(type) {
+	case *arrow.ListType:
+		return []arrow.Field{{Name: "item", Type: dt.Elem(), Nullable: true}}
+	case *arrow.LargeListType:
+		return []arrow.Field{{Name: "item", Type: dt.Elem(), Nullable: true}}
+	case *arrow.FixedSizeListType:
+		return []arrow.Field{{Name: "item", Type: dt.Elem(), Nullable: true}}
+	case *arrow.StructType:
+		return dt.Fields()
+	default:
+		return nil
+	}
+}
+
+func checkExportable(dt arrow.DataType) error {
+	if _, err := exportFormat(dt); err != nil {
+		return err
+	}
+	for _, f := range exportChildren(dt) {
+		if err := checkExportable(f.Type); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func exportSchema(out *CArrowSchema, name string, dt arrow.DataType, nullable bool) {
+	format, _ := exportFormat(dt)
+	out.format = C.CString(format)
+	out.name = C.CString(name)
+	out.metadata = nil
+	out.flags = 0
+	if nullable {
+		out.flags = C.ARROW_FLAG_NULLABLE
+	}
+
+	fields := exportChildren(dt)
+	out.n_children = C.int64_t(len(fields))
+	out.children = nil
+	if len(fields) > 0 {
+		out.children = (**CArrowSchema)(C.malloc(C.size_t(len(fields)) * ptrSize))
+		cchildren := schemaChildren(out)
+		for i, f := range fields {
+			cchildren[i] = (*CArrowSchema)(C.calloc(1, C.sizeof_struct_ArrowSchema))
+			exportSchema(cchildren[i], f.Name, f.Type, f.Nullable)
+		}
+	}
+
+	out.dictionary = nil
+	out.private_data = nil
+	out.release = (*[0]byte)(C.releaseExportedSchema)
+}
+
+func exportArray(out *CArrowArray, data *array.Data) {
+	exp := &exportedArray{data: data}
+	out.length = C.int64_t(data.Len())
+	out.null_count = C.int64_t(data.NullN())
+	out.offset = C.int64_t(data.Offset())
+
+	// only the buffers of the data type layout are exported, and the null
+	// data type has no buffers in the C Data Interface.
+	buffers := data.Buffers()
+	if n := len(data.DataType().Layout().Buffers); len(buffers) > n {
+		buffers = buffers[:n]
+	}
+	if data.DataType().ID() == arrow.NULL {
+		buffers = nil
+	}
+	out.n_buffers = C.int64_t(len(buffers))
+	out.buffers = nil
+	if len(buffers) > 0 {
+		out.buffers = (*unsafe.Pointer)(C.malloc(C.size_t(len(buffers)) * ptrSize))
+		cbufs := arrayBuffers(out)
+		for i, buf := range buffers {
+			cbufs[i] = nil
+			if buf != nil && buf.Len() > 0 {
+				p := &buf.Bytes()[0]
+				exp.pinner.Pin(p)
+				cbufs[i] = unsafe.Pointer(p)
+			}
+		}
+	}
+
+	children := data.Children()
+	out.n_children = C.int64_t(len(children))
+	out.children = nil
+	if len(children) > 0 {
+		out.children = (**CArrowArray)(C.malloc(C.size_t(len(children)) * ptrSize))
+		cchildren := arrayChildren(out)
+		for i, child := range children {
+			cchildren[i] = (*CArrowArray)(C.calloc(1, C.sizeof_struct_ArrowArray))
+			exportArray(cchildren[i], child)
+		}
+	}
+
+	data.Retain()
+	out.dictionary = nil
+	out.private_data = newHandle(exp)
+	out.release = (*[0]byte)(C.releaseExportedArray)
+}
+
+func releaseSchema(s *CArrowSchema) {
+	if s.release == nil {
+		return
+	}
+	C.free(unsafe.Pointer(s.format))
+	C.free(unsafe.Pointer(s.name))
+	for _, child := range schemaChildren(s) {
+		ReleaseCArrowSchema(child)
+		C.free(unsafe.Pointer(child))
+	}
+	C.free(unsafe.Pointer(s.children))
+	s.release = nil
+}
+
+func releaseArray(a *CArrowArray) {
+	if a.release == nil {
+		return
+	}
+	for _, child := range arrayChildren(a) {
+		ReleaseCArrowArray(child)
+		C.free(unsafe.Pointer(child))
+	}
+	C.free(unsafe.Pointer(a.children))
+	C.free(unsafe.Pointer(a.buffers))
+	if exp := dropHandle(a.private_data); exp != nil {
+		exp.pinner.Unpin()
+		exp.data.Release()
+	}
+	a.release = nil
+}
+
+func importField(s *CArrowSchema) (arrow.Field, error) {
+	children := make([]arrow.Field, 0, int(s.n_children))
+	for _, child := range schemaChildren(s) {
+		f, err := importField(child)
+		if err != nil {
+			return arrow.Field{}, err
+		}
+		children = append(children, f)
+	}
+
+	dt, err := importType(C.GoString(s.format), children)
+	if err != nil {
+		return arrow.Field{}, err
+	}
+	return arrow.Field{
+		Name:     C.GoString(s.name),
+		Type:     dt,
+		Nullable: s.flags&C.ARROW_FLAG_NULLABLE != 0,
+	}, nil
+}
+
+// importType returns the data type described by format, with the provided
+// child fields.
+func importType(format string, children []arrow.Field) (arrow.DataType, error) {
+	if dt, ok := primitiveFormats[format]; ok {
+		return dt, nil
+	}
+
+	nchildren := 0
+	var dt arrow.DataType
+	switch {
+	case format == "+l":
+		nchildren = 1
+		if len(children) == nchildren {
+			dt = arrow.ListOf(children[0].Type)
+		}
+	case format == "+L":
+		nchildren = 1
+		if len(children) == nchildren {
+			dt = arrow.LargeListOf(children[0].Type)
+		}
+	case format == "+s":
+		nchildren = len(children)
+		seen := make(map[string]bool, len(children))
+		for _, f := range children {
+			if seen[f.Name] {
+				return nil, fmt.Errorf("arrow/cdata: duplicate struct field with name %q", f.Name)
+			}
+			seen[f.Name] = true
+		}
+		dt = arrow.StructOf(children...)
+	case strings.HasPrefix(format, "+w:"):
+		n, err := strconv.Atoi(format[3:])
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("arrow/cdata: invalid format %q", format)
+		}
+		nchildren = 1
+		if len(children) == nchildren {
+			dt = arrow.FixedSizeListOf(int32(n), children[0].Type)
+		}
+	case strings.HasPrefix(format, "w:"):
+		n, err := strconv.Atoi(format[2:])
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("arrow/cdata: invalid format %q", format)
+		}
+		dt = &arrow.FixedSizeBinaryType{ByteWidth: n}
+	case len(format) >= 4 && format[:2] == "ts" && format[3] == ':':
+		unit, ok := timeUnits[format[2]]
+		if !ok {
+			return nil, fmt.Errorf("arrow/cdata: invalid format %q", format)
+		}
+		dt = &arrow.TimestampType{Unit: unit, TimeZone: format[4:]}
+	default:
+		return nil, fmt.Errorf("arrow/cdata: unsupported format %q", format)
+	}
+
+	if len(children) != nchildren {
+		return nil, fmt.Errorf("arrow/cdata: invalid number of children for format %q: got=%d, want=%d", format, len(children), nchildren)
+	}
+	return dt, nil
+}
+
+// importData returns the data of a, of data type dt, sharing the buffers of
+// a through imp.
+func importData(a *CArrowArray, dt arrow.DataType, imp *importedArray) (*array.Data, error) {
+	if a.release == nil {
+		return nil, fmt.Errorf("arrow/cdata: cannot import released array")
+	}
+
+	var (
+		length = int(a.length)
+		offset = int(a.offset)
+		nulls  = int(a.null_count)
+	)
+	if dt.ID() == arrow.NULL {
+		return array.NewData(dt, length, []*memory.Buffer{nil}, nil, length, 0), nil
+	}
+
+	specs := dt.Layout().Buffers
+	cbufs := arrayBuffers(a)
+	if len(cbufs) != len(specs) {
+		return nil, fmt.Errorf("arrow/cdata: invalid number of buffers for %v: got=%d, want=%d", dt, len(cbufs), len(specs))
+	}
+
+	buffers := make([]*memory.Buffer, len(specs))
+	defer func() {
+		for _, buf := range buffers {
+			if buf != nil {
+				buf.Release()
+			}
+		}
+	}()
+	for i, spec := range specs {
+		var size int
+		switch spec.Kind {
+		case arrow.KindBitmap:
+			size = int(bitutil.BytesForBits(int64(offset + length)))
+		case arrow.KindFixedWidth:
+			size = (offset + length) * spec.ByteWidth
+			if i == 1 && hasOffsets(dt) {
+				size += spec.ByteWidth
+			}
+		case arrow.KindVarWidth:
+			size = lastOffset(buffers[i-1], specs[i-1].ByteWidth, offset+length)
+		}
+		buffers[i] = importBuffer(cbufs[i], size, imp)
+	}
+
+	var types []arrow.DataType
+	for _, f := range exportChildren(dt) {
+		types = append(types, f.Type)
+	}
+	cchildren := arrayChildren(a)
+	if len(cchildren) != len(types) {
+		return nil, fmt.Errorf("arrow/cdata: invalid number of children for %v: got=%d, want=%d", dt, len(cchildren), len(types))
+	}
+
+	children := make([]*array.Data, len(types))
+	defer func() {
+		for _, child := range children {
+			if child != nil {
+				child.Release()
+			}
+		}
+	}()
+	for i, child := range cchildren {
+		var err error
+		if children[i], err = importData(child, types[i], imp); err != nil {
+			return nil, err
+		}
+	}
+
+	return array.NewData(dt, length, buffers, children, nulls, offset), nil
+}
+
+// hasOffsets reports whether the second buffer of the arrays of dt holds
+// offsets, which have one more element than the arrays.
+func hasOffsets(dt arrow.DataType) bool {
+	switch dt.ID() {
+	case arrow.BINARY, arrow.STRING, arrow.LARGE_BINARY, arrow.LARGE_STRING, arrow.LIST, arrow.LARGE_LIST:
+		return true
+	default:
+		return false
+	}
+}
+
+// lastOffset returns the i-th offset of the offsets buffer buf, which holds
+// offsets of w bytes.
+func lastOffset(buf *memory.Buffer, w, i int) int {
+	if buf == nil {
+		return 0
+	}
+	if w == arrow.Int64SizeBytes {
+		return int(arrow.Int64Traits.CastFromBytes(buf.Bytes())[i])
+	}
+	return int(arrow.Int32Traits.CastFromBytes(buf.Bytes())[i])
+}
+
+// importBuffer returns a buffer holding the size bytes at p, which retains
+// imp until it is released. importBuffer returns nil if p is nil.
+func importBuffer(p unsafe.Pointer, size int, imp *importedArray) *memory.Buffer {
+	if p == nil || size == 0 {
+		return nil
+	}
+	var b []byte
+	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
+	h.Data, h.Len, h.Cap = uintptr(p), size, size
+
+	imp.retain()
+	return memory.NewBufferWithAllocator(b, imp)
+}
@@ -0,0 +1,66 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdata
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+func TestImportTypeInvalid(t *testing.T) {
+	i32 := arrow.PrimitiveTypes.Int32
+	for _, tc := range []struct {
+		format   string
+		children []arrow.Field
+		want     string
+	}{
+		{
+			format:   "+s",
+			children: []arrow.Field{{Name: "a", Type: i32}, {Name: "a", Type: i32}},
+			want:     `arrow/cdata: duplicate struct field with name "a"`,
+		},
+		{
+			format:   "+w:0",
+			children: []arrow.Field{{Name: "item", Type: i32}},
+			want:     `arrow/cdata: invalid format "+w:0"`,
+		},
+		{
+			format:   "+w:-1",
+			children: []arrow.Field{{Name: "item", Type: i32}},
+			want:     `arrow/cdata: invalid format "+w:-1"`,
+		},
+		{
+			format: "+l",
+			want:   `arrow/cdata: invalid number of children for format "+l": got=0, want=1`,
+		},
+		{
+			format: "?",
+			want:   `arrow/cdata: unsupported format "?"`,
+		},
+	} {
+		t.Run(tc.format, func(t *testing.T) {
+			_, err := importType(tc.format, tc.children)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if got := err.Error(); got != tc.want {
+				t.Fatalf("invalid error:\ngot= %s\nwant=%s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdata_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/cdata"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		build func(mem memory.Allocator) array.Interface
+	}{
+		{
+			name: "int32",
+			build: func(mem memory.Allocator) array.Interface {
+				b := array.NewInt32Builder(mem)
+				defer b.Release()
+				b.AppendValues([]int32{1, 0, 3, -4}, []bool{true, false, true, true})
+				return b.NewArray()
+			},
+		},
+		{
+			name: "float64",
+			build: func(mem memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(mem)
+				defer b.Release()
+				b.AppendValues([]float64{1.5, 2.5, 0}, []bool{true, true, false})
+				return b.NewArray()
+			},
+		},
+		{
+			name: "bool",
+			build: func(mem memory.Allocator) array.Interface {
+				b := array.NewBooleanBuilder(mem)
+				defer b.Release()
+				b.AppendValues([]bool{true, false, true}, []bool{true, true, false})
+				return b.NewArray()
+			},
+		},
+		{
+			name: "timestamp",
+			build: func(mem memory.Allocator) array.Interface {
+				b := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"})
+				defer b.Release()
+				b.AppendValues([]arrow.Timestamp{1, 2}, nil)
+				return b.NewArray()
+			},
+		},
+		{
+			name: "string",
+			build: func(mem memory.Allocator) array.Interface {
+				b := array.NewStringBuilder(mem)
+				defer b.Release()
+				b.AppendValues([]string{"a", "", "bcd", "ef"}, []bool{true, false, true, true})
+				return b.NewArray()
+			},
+		},
+		{
+			name: "string-slice",
+			build: func(mem memory.Allocator) array.Interface {
+				b := array.NewStringBuilder(mem)
+				defer b.Release()
+				b.AppendValues([]string{"a", "", "bcd", "ef"}, []bool{true, false, true, true})
+				arr := b.NewArray()
+				defer arr.Release()
+				return array.NewSlice(arr, 1, 3)
+			},
+		},
+		{
+			name: "empty-string",
+			build: func(mem memory.Allocator) array.Interface {
+				b := array.NewStringBuilder(mem)
+				defer b.Release()
+				return b.NewArray()
+			},
+		},
+		{
+			name: "null",
+			build: func(mem memory.Allocator) array.Interface {
+				return array.NewNull(3)
+			},
+		},
+		{
+			name: "list",
+			build: func(mem memory.Allocator) array.Interface {
+				b := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int64)
+				defer b.Release()
+				vb := b.ValueBuilder().(*array.Int64Builder)
+				b.Append(true)
+				vb.AppendValues([]int64{1, 2, 3}, nil)
+				b.AppendNull()
+				b.Append(true)
+				b.Append(true)
+				vb.AppendValues([]int64{4, 5}, []bool{false, true})
+				return b.NewArray()
+			},
+		},
+		{
+			name: "list-slice",
+			build: func(mem memory.Allocator) array.Interface {
+				b := array.NewListBuilder(mem, arrow.BinaryTypes.String)
+				defer b.Release()
+				vb := b.ValueBuilder().(*array.StringBuilder)
+				b.Append(true)
+				vb.AppendValues([]string{"a", "b"}, nil)
+				b.Append(true)
+				vb.Append("c")
+				b.AppendNull()
+				arr := b.NewArray()
+				defer arr.Release()
+				return array.NewSlice(arr, 1, 3)
+			},
+		},
+		{
+			name: "struct",
+			build: func(mem memory.Allocator) array.Interface {
+				dtype := arrow.StructOf(
+					arrow.Field{Name: "i", Type: arrow.PrimitiveTypes.Int32},
+					arrow.Field{Name: "s", Type: arrow.BinaryTypes.String, Nullable: true},
+				)
+				b := array.NewStructBuilder(mem, dtype)
+				defer b.Release()
+				ib := b.FieldBuilder(0).(*array.Int32Builder)
+				sb := b.FieldBuilder(1).(*array.StringBuilder)
+				b.Append(true)
+				ib.Append(1)
+				sb.Append("a")
+				b.AppendNull()
+				b.Append(true)
+				ib.Append(3)
+				sb.AppendNull()
+				return b.NewArray()
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			emem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer emem.AssertSize(t, 0)
+
+			arr := tc.build(emem)
+			defer arr.Release()
+
+			carr, cschema := cdata.ExportArrowArray(arr)
+			defer cdata.FreeCArrowArray(carr)
+			defer cdata.FreeCArrowSchema(cschema)
+
+			got, err := cdata.ImportArrowArray(carr, cschema)
+			if err != nil {
+				t.Fatalf("could not import array: %+v", err)
+			}
+			defer got.Release()
+
+			if !arrow.TypeEquals(got.DataType(), arr.DataType()) {
+				t.Fatalf("invalid data type: got=%v, want=%v", got.DataType(), arr.DataType())
+			}
+			if !array.ArrayEqual(got, arr) {
+				t.Fatalf("invalid array:\ngot= %v\nwant=%v", got, arr)
+			}
+		})
+	}
+}
+
+func TestExportRelease(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewListBuilder(mem, arrow.PrimitiveTypes.Int32)
+	defer b.Release()
+	b.Append(true)
+	b.ValueBuilder().(*array.Int32Builder).AppendValues([]int32{1, 2}, nil)
+
+	arr := b.NewArray()
+	carr, cschema := cdata.ExportArrowArray(arr)
+	arr.Release()
+
+	if mem.CurrentAlloc() == 0 {
+		t.Fatalf("exported array should retain its buffers")
+	}
+
+	cdata.ReleaseCArrowArray(carr)
+	cdata.ReleaseCArrowSchema(cschema)
+	mem.AssertSize(t, 0)
+
+	// releasing twice is a no-op.
+	cdata.FreeCArrowArray(carr)
+	cdata.FreeCArrowSchema(cschema)
+}
+
+func TestExportUnsupported(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewDayTimeIntervalBuilder(mem)
+	defer b.Release()
+	b.Append(arrow.DayTimeInterval{Days: 1})
+
+	arr := b.NewArray()
+	defer arr.Release()
+
+	defer func() {
+		if e := recover(); e == nil {
+			t.Fatalf("expected a panic")
+		}
+	}()
+	cdata.ExportArrowArray(arr)
+}
+
+func TestImportRelease(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewInt64Builder(mem)
+	defer b.Release()
+	b.AppendValues([]int64{1, 2, 3}, nil)
+
+	arr := b.NewInt64Array()
+	carr, cschema := cdata.ExportArrowArray(arr)
+	defer cdata.FreeCArrowArray(carr)
+	defer cdata.FreeCArrowSchema(cschema)
+
+	got, err := cdata.ImportArrowArray(carr, cschema)
+	if err != nil {
+		t.Fatalf("could not import array: %+v", err)
+	}
+	want := &arr.Int64Values()[0]
+	arr.Release()
+
+	// the imported array shares the buffers of the exported one, which are
+	// released along with it.
+	if p := &got.(*array.Int64).Int64Values()[0]; p != want {
+		t.Fatalf("imported array should share the exported buffers")
+	}
+	if mem.CurrentAlloc() == 0 {
+		t.Fatalf("imported array should retain the exported buffers")
+	}
+
+	got.Release()
+	mem.AssertSize(t, 0)
+
+	// the imported array was moved out of carr.
+	if _, err := cdata.ImportArrowArray(carr, cschema); err == nil {
+		t.Fatalf("expected an error importing a released array")
+	}
+}
@@ -0,0 +1,27 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cdata implements the Arrow C Data Interface, to share arrays with
+// other Arrow implementations living in the same process, such as pyarrow,
+// through cgo.
+//
+// Arrays share their buffers across the interface in both directions: no
+// data is copied. Exported buffers are pinned with runtime.Pinner, so this
+// package requires Go 1.21 or later.
+//
+// See https://arrow.apache.org/docs/format/CDataInterface.html for the
+// specification of the interface.
+package cdata // import "github.com/apache/arrow/go/arrow/cdata"
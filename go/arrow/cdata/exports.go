@@ -14,11 +14,17 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package array
+package cdata
 
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
+// #include "abi.h"
+import "C"
+
+//export releaseExportedSchema
+func releaseExportedSchema(s *C.struct_ArrowSchema) {
+	releaseSchema(s)
+}
+
+//export releaseExportedArray
+func releaseExportedArray(a *C.struct_ArrowArray) {
+	releaseArray(a)
 }
@@ -29,7 +29,8 @@ type typeEqualsConfig struct {
 type TypeEqualsOption func(*typeEqualsConfig)
 
 // CheckMetadata is an option for TypeEquals that allows checking for metadata
-// equality besides type equality. It only makes sense for STRUCT type.
+// equality besides type equality. It only makes sense for STRUCT type, and
+// for the types nesting a STRUCT type.
 func CheckMetadata() TypeEqualsOption {
 	return func(cfg *typeEqualsConfig) {
 		cfg.metadata = true
@@ -37,7 +38,7 @@ func CheckMetadata() TypeEqualsOption {
 }
 
 // TypeEquals checks if two DataType are the same, optionally checking metadata
-// equality for STRUCT types.
+// equality for STRUCT types, at any nesting level.
 func TypeEquals(left, right DataType, opts ...TypeEqualsOption) bool {
 	var cfg typeEqualsConfig
 	for _, opt := range opts {
@@ -51,29 +52,40 @@ func TypeEquals(left, right DataType, opts ...TypeEqualsOption) bool {
 		return false
 	}
 
-	// StructType is the only type that has metadata.
-	l, ok := left.(*StructType)
-	if !ok || cfg.metadata {
-		return reflect.DeepEqual(left, right)
-	}
-
-	r := right.(*StructType)
-	switch {
-	case len(l.fields) != len(r.fields):
-		return false
-	case !reflect.DeepEqual(l.index, r.index):
-		return false
-	}
-	for i := range l.fields {
-		leftField, rightField := l.fields[i], r.fields[i]
+	// StructType is the only type that has metadata: the types nesting
+	// other types are compared recursively, so that the metadata of nested
+	// struct fields is only checked with CheckMetadata.
+	switch l := left.(type) {
+	case *ListType:
+		return TypeEquals(l.elem, right.(*ListType).elem, opts...)
+	case *LargeListType:
+		return TypeEquals(l.elem, right.(*LargeListType).elem, opts...)
+	case *FixedSizeListType:
+		r := right.(*FixedSizeListType)
+		return l.n == r.n && TypeEquals(l.elem, r.elem, opts...)
+	case *DictionaryType:
+		r := right.(*DictionaryType)
+		return l.Ordered == r.Ordered &&
+			TypeEquals(l.IndexType, r.IndexType, opts...) &&
+			TypeEquals(l.ValueType, r.ValueType, opts...)
+	case *RunEndEncodedType:
+		r := right.(*RunEndEncodedType)
+		return TypeEquals(l.RunEnds, r.RunEnds, opts...) && TypeEquals(l.Values, r.Values, opts...)
+	case *StructType:
+		r := right.(*StructType)
 		switch {
-		case leftField.Name != rightField.Name:
-			return false
-		case leftField.Nullable != rightField.Nullable:
+		case len(l.fields) != len(r.fields):
 			return false
-		case !TypeEquals(leftField.Type, rightField.Type, opts...):
+		case cfg.metadata && !l.meta.equal(r.meta):
 			return false
 		}
+		for i := range l.fields {
+			if !l.fields[i].equal(r.fields[i], cfg.metadata) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(left, right)
 	}
-	return true
 }
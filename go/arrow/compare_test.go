@@ -56,6 +56,15 @@ func TestTypeEquals(t *testing.T) {
 		{
 			&TimestampType{Unit: Second, TimeZone: "UTC"}, &TimestampType{Unit: Second, TimeZone: "UTC"}, true, false,
 		},
+		{
+			&DurationType{Unit: Nanosecond}, &DurationType{Unit: Nanosecond}, true, false,
+		},
+		{
+			&DurationType{Unit: Second}, &DurationType{Unit: Millisecond}, false, false,
+		},
+		{
+			FixedWidthTypes.MonthInterval, FixedWidthTypes.DayTimeInterval, false, false,
+		},
 		{
 			&TimestampType{Unit: Microsecond, TimeZone: "UTC"}, &TimestampType{Unit: Millisecond, TimeZone: "UTC"}, false, false,
 		},
@@ -0,0 +1,71 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"sort"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// CumulativeDistribution returns, for each element of arr, the fraction of
+// non-null elements of arr that are less than or equal to that element.
+// Equal elements share the same value, and all values are in the ]0, 1] range.
+// Null elements map to null outputs.
+//
+// CumulativeDistribution supports numeric, temporal, string and binary arrays.
+func CumulativeDistribution(arr array.Interface, mem memory.Allocator) (*array.Float64, error) {
+	cmp, err := newComparator(arr)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		n     = arr.Len()
+		out   = make([]float64, n)
+		valid = make([]bool, n)
+		idx   = make([]int, 0, n-arr.NullN())
+	)
+	for i := 0; i < n; i++ {
+		if arr.IsValid(i) {
+			valid[i] = true
+			idx = append(idx, i)
+		}
+	}
+
+	sort.SliceStable(idx, func(i, j int) bool { return cmp(idx[i], idx[j]) < 0 })
+
+	total := float64(len(idx))
+	for beg := 0; beg < len(idx); {
+		end := beg + 1
+		for end < len(idx) && cmp(idx[beg], idx[end]) == 0 {
+			end++
+		}
+		v := float64(end) / total
+		for _, i := range idx[beg:end] {
+			out[i] = v
+		}
+		beg = end
+	}
+
+	bldr := array.NewFloat64Builder(mem)
+	defer bldr.Release()
+
+	bldr.AppendValues(out, valid)
+	return bldr.NewFloat64Array(), nil
+}
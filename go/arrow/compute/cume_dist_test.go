@@ -0,0 +1,136 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestCumulativeDistribution(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, tc := range []struct {
+		name  string
+		arr   func() array.Interface
+		want  []float64
+		valid []bool
+	}{
+		{
+			name: "int64-ties-nulls",
+			arr: func() array.Interface {
+				b := array.NewInt64Builder(mem)
+				defer b.Release()
+				b.AppendValues(
+					[]int64{3, 1, 0, 3, 2, 1, 5},
+					[]bool{true, true, false, true, true, true, true},
+				)
+				return b.NewArray()
+			},
+			want:  []float64{5. / 6, 2. / 6, 0, 5. / 6, 3. / 6, 2. / 6, 1},
+			valid: []bool{true, true, false, true, true, true, true},
+		},
+		{
+			name: "float64-nan",
+			arr: func() array.Interface {
+				b := array.NewFloat64Builder(mem)
+				defer b.Release()
+				b.AppendValues([]float64{math.NaN(), -1, 2.5, -1}, nil)
+				return b.NewArray()
+			},
+			want:  []float64{1, 0.5, 0.75, 0.5},
+			valid: []bool{true, true, true, true},
+		},
+		{
+			name: "string",
+			arr: func() array.Interface {
+				b := array.NewStringBuilder(mem)
+				defer b.Release()
+				b.AppendValues([]string{"b", "a", "", "c", "b"}, []bool{true, true, false, true, true})
+				return b.NewArray()
+			},
+			want:  []float64{0.75, 0.25, 0, 1, 0.75},
+			valid: []bool{true, true, false, true, true},
+		},
+		{
+			name: "all-nulls",
+			arr: func() array.Interface {
+				b := array.NewUint8Builder(mem)
+				defer b.Release()
+				b.AppendNull()
+				b.AppendNull()
+				return b.NewArray()
+			},
+			want:  []float64{0, 0},
+			valid: []bool{false, false},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			arr := tc.arr()
+			defer arr.Release()
+
+			got, err := compute.CumulativeDistribution(arr, mem)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer got.Release()
+
+			if got, want := got.Len(), arr.Len(); got != want {
+				t.Fatalf("invalid length: got=%d, want=%d", got, want)
+			}
+
+			valid := make([]bool, got.Len())
+			for i := range valid {
+				valid[i] = got.IsValid(i)
+				if !valid[i] {
+					continue
+				}
+				v := got.Value(i)
+				if v <= 0 || v > 1 {
+					t.Fatalf("value %d out of ]0, 1]: %v", i, v)
+				}
+				if v != tc.want[i] {
+					t.Fatalf("invalid value %d: got=%v, want=%v", i, v, tc.want[i])
+				}
+			}
+			if !reflect.DeepEqual(valid, tc.valid) {
+				t.Fatalf("invalid validity:\ngot= %v\nwant=%v", valid, tc.valid)
+			}
+		})
+	}
+}
+
+func TestCumulativeDistributionUnsupported(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	b := array.NewBooleanBuilder(mem)
+	defer b.Release()
+	b.Append(true)
+	arr := b.NewArray()
+	defer arr.Release()
+
+	if _, err := compute.CumulativeDistribution(arr, mem); err == nil {
+		t.Fatalf("expected an error")
+	}
+}
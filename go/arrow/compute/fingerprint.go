@@ -0,0 +1,176 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// RecordFingerprint returns a deterministic 64-bit hash of rec, computed
+// from its schema and from all its element values, column by column and
+// row by row.
+//
+// Equal records have the same fingerprint. Records with different schemas,
+// values or null elements almost certainly have different fingerprints.
+// The fingerprint is stable across runs and platforms.
+func RecordFingerprint(rec array.Record) (uint64, error) {
+	f := fingerprinter{h: fnv.New64a()}
+
+	schema := rec.Schema()
+	f.writeInt(int64(len(schema.Fields())))
+	for _, field := range schema.Fields() {
+		f.writeString(field.Name)
+		f.writeString(fmt.Sprintf("%v", field.Type))
+		f.writeBool(field.Nullable)
+	}
+
+	f.writeInt(rec.NumRows())
+	for i, col := range rec.Columns() {
+		for j := 0; j < col.Len(); j++ {
+			if err := f.writeElement(col, j); err != nil {
+				return 0, fmt.Errorf("arrow/compute: column %q: %v", rec.ColumnName(i), err)
+			}
+		}
+	}
+
+	return f.h.Sum64(), nil
+}
+
+type fingerprinter struct {
+	h   hash.Hash64
+	buf [8]byte
+}
+
+func (f *fingerprinter) writeUint(v uint64) {
+	binary.LittleEndian.PutUint64(f.buf[:], v)
+	f.h.Write(f.buf[:])
+}
+
+func (f *fingerprinter) writeInt(v int64) { f.writeUint(uint64(v)) }
+
+func (f *fingerprinter) writeBool(v bool) {
+	if v {
+		f.writeUint(1)
+		return
+	}
+	f.writeUint(0)
+}
+
+func (f *fingerprinter) writeBytes(v []byte) {
+	f.writeInt(int64(len(v)))
+	f.h.Write(v)
+}
+
+func (f *fingerprinter) writeString(v string) { f.writeBytes([]byte(v)) }
+
+// writeElement feeds the i-th element of arr, prefixed by its validity, to the hash.
+func (f *fingerprinter) writeElement(arr array.Interface, i int) error {
+	if arr.IsNull(i) {
+		f.writeBool(false)
+		return nil
+	}
+	f.writeBool(true)
+
+	switch arr := arr.(type) {
+	case *array.Null:
+	case *array.Boolean:
+		f.writeBool(arr.Value(i))
+	case *array.Int8:
+		f.writeInt(int64(arr.Value(i)))
+	case *array.Int16:
+		f.writeInt(int64(arr.Value(i)))
+	case *array.Int32:
+		f.writeInt(int64(arr.Value(i)))
+	case *array.Int64:
+		f.writeInt(arr.Value(i))
+	case *array.Uint8:
+		f.writeUint(uint64(arr.Value(i)))
+	case *array.Uint16:
+		f.writeUint(uint64(arr.Value(i)))
+	case *array.Uint32:
+		f.writeUint(uint64(arr.Value(i)))
+	case *array.Uint64:
+		f.writeUint(arr.Value(i))
+	case *array.Float16:
+		f.writeUint(uint64(arr.Value(i).Uint16()))
+	case *array.Float32:
+		f.writeUint(uint64(math.Float32bits(arr.Value(i))))
+	case *array.Float64:
+		f.writeUint(math.Float64bits(arr.Value(i)))
+	case *array.Date32:
+		f.writeInt(int64(arr.Value(i)))
+	case *array.Date64:
+		f.writeInt(int64(arr.Value(i)))
+	case *array.Time32:
+		f.writeInt(int64(arr.Value(i)))
+	case *array.Time64:
+		f.writeInt(int64(arr.Value(i)))
+	case *array.Timestamp:
+		f.writeInt(int64(arr.Value(i)))
+	case *array.Duration:
+		f.writeInt(int64(arr.Value(i)))
+	case *array.MonthInterval:
+		f.writeInt(int64(arr.Value(i)))
+	case *array.DayTimeInterval:
+		v := arr.Value(i)
+		f.writeInt(int64(v.Days))
+		f.writeInt(int64(v.Milliseconds))
+	case *array.Decimal128:
+		v := arr.Value(i)
+		f.writeInt(v.HighBits())
+		f.writeUint(v.LowBits())
+	case *array.String:
+		f.writeString(arr.Value(i))
+	case *array.Binary:
+		f.writeBytes(arr.Value(i))
+	case *array.FixedSizeBinary:
+		f.writeBytes(arr.Value(i))
+	case *array.List:
+		offsets := arr.Offsets()[arr.Offset():]
+		beg, end := int(offsets[i]), int(offsets[i+1])
+		f.writeInt(int64(end - beg))
+		for j := beg; j < end; j++ {
+			if err := f.writeElement(arr.ListValues(), j); err != nil {
+				return err
+			}
+		}
+	case *array.FixedSizeList:
+		n := int(arr.DataType().(*arrow.FixedSizeListType).Len())
+		beg := (arr.Offset() + i) * n
+		for j := beg; j < beg+n; j++ {
+			if err := f.writeElement(arr.ListValues(), j); err != nil {
+				return err
+			}
+		}
+	case *array.Struct:
+		for j := 0; j < arr.NumField(); j++ {
+			if err := f.writeElement(arr.Field(j), i); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported data type %v", arr.DataType())
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestRecordFingerprint(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "vals", Type: arrow.ListOf(arrow.PrimitiveTypes.Float64), Nullable: true},
+		},
+		nil,
+	)
+
+	build := func(rows []map[string]interface{}) array.Record {
+		rec, err := array.RecordFromMaps(schema, rows, mem)
+		if err != nil {
+			t.Fatalf("could not build record: %+v", err)
+		}
+		return rec
+	}
+
+	fingerprint := func(rec array.Record) uint64 {
+		defer rec.Release()
+		v, err := compute.RecordFingerprint(rec)
+		if err != nil {
+			t.Fatalf("could not compute fingerprint: %+v", err)
+		}
+		return v
+	}
+
+	rows := func() []map[string]interface{} {
+		return []map[string]interface{}{
+			{"id": 1, "name": "a", "vals": []interface{}{1.0, 2.0}},
+			{"id": 2, "vals": []interface{}{}},
+			{"id": 3, "name": "c"},
+		}
+	}
+
+	ref := fingerprint(build(rows()))
+	if got := fingerprint(build(rows())); got != ref {
+		t.Fatalf("equal records have different fingerprints: %x != %x", got, ref)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		mutate func(rows []map[string]interface{})
+	}{
+		{"value", func(rows []map[string]interface{}) { rows[2]["id"] = 4 }},
+		{"string", func(rows []map[string]interface{}) { rows[0]["name"] = "b" }},
+		{"null", func(rows []map[string]interface{}) { rows[1]["name"] = "" }},
+		{"nested", func(rows []map[string]interface{}) { rows[0]["vals"] = []interface{}{1.0, 2.5} }},
+		{"empty-list", func(rows []map[string]interface{}) { rows[1]["vals"] = nil }},
+		{"order", func(rows []map[string]interface{}) { rows[0], rows[1] = rows[1], rows[0] }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rows := rows()
+			tc.mutate(rows)
+			if got := fingerprint(build(rows)); got == ref {
+				t.Fatalf("different records have the same fingerprint %x", got)
+			}
+		})
+	}
+
+	t.Run("schema", func(t *testing.T) {
+		rec := build(rows())
+		defer rec.Release()
+
+		renamed, err := rec.Schema().SetField(0, arrow.Field{Name: "key", Type: arrow.PrimitiveTypes.Int64})
+		if err != nil {
+			t.Fatal(err)
+		}
+		other := array.NewRecord(renamed, rec.Columns(), rec.NumRows())
+		if got := fingerprint(other); got == ref {
+			t.Fatalf("records with different schemas have the same fingerprint %x", got)
+		}
+	})
+}
@@ -0,0 +1,99 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Map returns a new array where each element of arr found in the lookup
+// array from is replaced with the element of to at the same position.
+//
+// Elements of arr not found in from are replaced with defaultVal, or with
+// null if defaultVal is nil. When not nil, defaultVal must have the same data
+// type as to. Null elements of arr stay null.
+//
+// from must have the same data type as arr and hold distinct non-null
+// elements, and to must have the same length as from.
+// Map supports numeric, temporal, string and binary arrays.
+func Map(arr, from, to array.Interface, defaultVal *array.Scalar, mem memory.Allocator) (array.Interface, error) {
+	switch {
+	case !arrow.TypeEquals(arr.DataType(), from.DataType()):
+		return nil, fmt.Errorf("arrow/compute: lookup type %v does not match array type %v", from.DataType(), arr.DataType())
+	case from.Len() != to.Len():
+		return nil, fmt.Errorf("arrow/compute: lookup arrays length mismatch (from=%d, to=%d)", from.Len(), to.Len())
+	case defaultVal != nil && !arrow.TypeEquals(defaultVal.DataType(), to.DataType()):
+		return nil, fmt.Errorf("arrow/compute: default type %v does not match lookup type %v", defaultVal.DataType(), to.DataType())
+	}
+
+	var def interface{}
+	if defaultVal != nil {
+		v, err := valueAt(defaultVal.Array(), 0)
+		if err != nil {
+			return nil, err
+		}
+		def = v
+	}
+
+	lookup := make(map[interface{}]int, from.Len())
+	for i := 0; i < from.Len(); i++ {
+		v, err := valueAt(from, i)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			return nil, fmt.Errorf("arrow/compute: null lookup element at index %d", i)
+		}
+		k := hashKey(v)
+		if _, dup := lookup[k]; dup {
+			return nil, fmt.Errorf("arrow/compute: duplicate lookup element %v at index %d", v, i)
+		}
+		lookup[k] = i
+	}
+
+	bldr := array.NewBuilder(mem, to.DataType())
+	defer bldr.Release()
+
+	bldr.Reserve(arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		v, err := valueAt(arr, i)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			bldr.AppendNull()
+			continue
+		}
+
+		out := def
+		if j, ok := lookup[hashKey(v)]; ok {
+			out, err = valueAt(to, j)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := appendValue(bldr, out); err != nil {
+			return nil, err
+		}
+	}
+
+	return bldr.NewArray(), nil
+}
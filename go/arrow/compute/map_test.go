@@ -0,0 +1,161 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestMap(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt32Builder(mem)
+	defer ib.Release()
+
+	ib.AppendValues([]int32{1, 2, 3, 4, 0, 2}, []bool{true, true, true, true, false, true})
+	arr := ib.NewArray()
+	defer arr.Release()
+
+	ib.AppendValues([]int32{2, 1, 4}, nil)
+	from := ib.NewArray()
+	defer from.Release()
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+
+	sb.AppendValues([]string{"two", "one", ""}, []bool{true, true, false})
+	to := sb.NewArray()
+	defer to.Release()
+
+	other, err := array.NewScalarValue(arrow.BinaryTypes.String, "other", mem)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer other.Release()
+
+	null := array.NewScalar(to, 2)
+	defer null.Release()
+
+	for _, tc := range []struct {
+		name string
+		def  *array.Scalar
+		want string
+	}{
+		{
+			name: "default",
+			def:  other,
+			want: `["one" "two" "other" (null) (null) "two"]`,
+		},
+		{
+			name: "no-default",
+			def:  nil,
+			want: `["one" "two" (null) (null) (null) "two"]`,
+		},
+		{
+			name: "null-default",
+			def:  null,
+			want: `["one" "two" (null) (null) (null) "two"]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := compute.Map(arr, from, to, tc.def, mem)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer got.Release()
+
+			if got, want := got.(*array.String).String(), tc.want; got != want {
+				t.Fatalf("invalid result:\ngot= %s\nwant=%s", got, want)
+			}
+		})
+	}
+}
+
+func TestMapInvalid(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	ib := array.NewInt64Builder(mem)
+	defer ib.Release()
+
+	ib.AppendValues([]int64{1, 2, 3}, nil)
+	arr := ib.NewArray()
+	defer arr.Release()
+
+	ib.AppendValues([]int64{1, 2, 1}, nil)
+	dups := ib.NewArray()
+	defer dups.Release()
+
+	ib.AppendValues([]int64{1, 2}, nil)
+	from := ib.NewArray()
+	defer from.Release()
+
+	ib.AppendValues([]int64{10, 20}, nil)
+	to := ib.NewArray()
+	defer to.Release()
+
+	ub := array.NewUint64Builder(mem)
+	defer ub.Release()
+
+	ub.AppendValues([]uint64{1, 2}, nil)
+	ufrom := ub.NewArray()
+	defer ufrom.Release()
+
+	def := array.NewScalar(ufrom, 0)
+	defer def.Release()
+
+	for _, tc := range []struct {
+		name     string
+		from, to array.Interface
+		def      *array.Scalar
+		err      string
+	}{
+		{
+			name: "length-mismatch",
+			from: arr, to: to,
+			err: "arrow/compute: lookup arrays length mismatch (from=3, to=2)",
+		},
+		{
+			name: "duplicates",
+			from: dups, to: arr,
+			err: "arrow/compute: duplicate lookup element 1 at index 2",
+		},
+		{
+			name: "type-mismatch",
+			from: ufrom, to: to,
+			err: "arrow/compute: lookup type uint64 does not match array type int64",
+		},
+		{
+			name: "invalid-default",
+			from: from, to: to, def: def,
+			err: "arrow/compute: default type uint64 does not match lookup type int64",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := compute.Map(arr, tc.from, tc.to, tc.def, mem)
+			if err == nil || err.Error() != tc.err {
+				t.Fatalf("invalid error:\ngot= %v\nwant=%s", err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// DomainErrorPolicy describes how math kernels handle inputs outside of
+// the domain of their function, such as the logarithm of a negative number.
+type DomainErrorPolicy int
+
+const (
+	// DomainErrorNaN emits NaN for inputs outside of the domain of the function.
+	DomainErrorNaN DomainErrorPolicy = iota
+	// DomainErrorNull emits null for inputs outside of the domain of the function.
+	DomainErrorNull
+)
+
+// MathOption configures the math kernels.
+type MathOption func(*mathConfig)
+
+type mathConfig struct {
+	domain DomainErrorPolicy
+}
+
+// WithDomainErrorPolicy specifies how math kernels handle inputs outside of
+// the domain of their function. The default is DomainErrorNaN.
+func WithDomainErrorPolicy(p DomainErrorPolicy) MathOption {
+	return func(cfg *mathConfig) {
+		cfg.domain = p
+	}
+}
+
+// Power returns a new Float64 array holding each element of base raised to
+// the power exp.
+// Results that are not real numbers, such as the power 0.5 of a negative
+// number, are handled according to the DomainErrorPolicy.
+//
+// Power supports integer and floating-point arrays, integer values being
+// converted to float64. Null elements map to null outputs.
+func Power(base array.Interface, exp float64, mem memory.Allocator, opts ...MathOption) (*array.Float64, error) {
+	return unaryMath(base, mem, opts, func(x float64) (float64, bool) {
+		if x < 0 && exp != math.Trunc(exp) {
+			return math.NaN(), false
+		}
+		return math.Pow(x, exp), true
+	})
+}
+
+// Ln returns a new Float64 array holding the natural logarithm of each
+// element of arr.
+// Zero and negative numbers are outside of the domain of the logarithm,
+// and are handled according to the DomainErrorPolicy.
+//
+// Ln supports integer and floating-point arrays, integer values being
+// converted to float64. Null elements map to null outputs.
+func Ln(arr array.Interface, mem memory.Allocator, opts ...MathOption) (*array.Float64, error) {
+	return unaryMath(arr, mem, opts, logFunc(math.Log))
+}
+
+// Log10 returns a new Float64 array holding the decimal logarithm of each
+// element of arr. See Ln for the handling of zero and negative values.
+func Log10(arr array.Interface, mem memory.Allocator, opts ...MathOption) (*array.Float64, error) {
+	return unaryMath(arr, mem, opts, logFunc(math.Log10))
+}
+
+// Log2 returns a new Float64 array holding the binary logarithm of each
+// element of arr. See Ln for the handling of zero and negative values.
+func Log2(arr array.Interface, mem memory.Allocator, opts ...MathOption) (*array.Float64, error) {
+	return unaryMath(arr, mem, opts, logFunc(math.Log2))
+}
+
+func logFunc(log func(float64) float64) func(float64) (float64, bool) {
+	return func(x float64) (float64, bool) {
+		if x <= 0 {
+			return math.NaN(), false
+		}
+		return log(x), true
+	}
+}
+
+// unaryMath applies fn to each non-null element of arr.
+// fn reports whether its input was inside the domain of the function.
+func unaryMath(arr array.Interface, mem memory.Allocator, opts []MathOption, fn func(float64) (float64, bool)) (*array.Float64, error) {
+	var cfg mathConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	get, err := float64Getter(arr)
+	if err != nil {
+		return nil, err
+	}
+
+	bldr := array.NewFloat64Builder(mem)
+	defer bldr.Release()
+
+	bldr.Reserve(arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+		v, ok := fn(get(i))
+		if !ok && cfg.domain == DomainErrorNull {
+			bldr.AppendNull()
+			continue
+		}
+		bldr.Append(v)
+	}
+
+	return bldr.NewFloat64Array(), nil
+}
+
+// float64Getter returns a function converting the i-th element of the
+// numeric array arr to a float64.
+func float64Getter(arr array.Interface) (func(i int) float64, error) {
+	switch arr := arr.(type) {
+	case *array.Int8:
+		return func(i int) float64 { return float64(arr.Value(i)) }, nil
+	case *array.Int16:
+		return func(i int) float64 { return float64(arr.Value(i)) }, nil
+	case *array.Int32:
+		return func(i int) float64 { return float64(arr.Value(i)) }, nil
+	case *array.Int64:
+		return func(i int) float64 { return float64(arr.Value(i)) }, nil
+	case *array.Uint8:
+		return func(i int) float64 { return float64(arr.Value(i)) }, nil
+	case *array.Uint16:
+		return func(i int) float64 { return float64(arr.Value(i)) }, nil
+	case *array.Uint32:
+		return func(i int) float64 { return float64(arr.Value(i)) }, nil
+	case *array.Uint64:
+		return func(i int) float64 { return float64(arr.Value(i)) }, nil
+	case *array.Float32:
+		return func(i int) float64 { return float64(arr.Value(i)) }, nil
+	case *array.Float64:
+		return arr.Value, nil
+	default:
+		return nil, fmt.Errorf("arrow/compute: unsupported data type %v", arr.DataType())
+	}
+}
@@ -0,0 +1,152 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestLogarithms(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewFloat64Builder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]float64{1, 0, -1, 100, 0}, []bool{true, true, true, true, false})
+
+	arr := bldr.NewFloat64Array()
+	defer arr.Release()
+
+	for _, tc := range []struct {
+		name string
+		fn   func(array.Interface, memory.Allocator, ...compute.MathOption) (*array.Float64, error)
+		opts []compute.MathOption
+		want string
+	}{
+		{name: "ln", fn: compute.Ln, want: "[0 NaN NaN 4.605170185988092 (null)]"},
+		{name: "log10", fn: compute.Log10, want: "[0 NaN NaN 2 (null)]"},
+		{name: "log2", fn: compute.Log2, want: "[0 NaN NaN 6.643856189774724 (null)]"},
+		{
+			name: "ln-null",
+			fn:   compute.Ln,
+			opts: []compute.MathOption{compute.WithDomainErrorPolicy(compute.DomainErrorNull)},
+			want: "[0 (null) (null) 4.605170185988092 (null)]",
+		},
+		{
+			name: "log10-nan",
+			fn:   compute.Log10,
+			opts: []compute.MathOption{compute.WithDomainErrorPolicy(compute.DomainErrorNaN)},
+			want: "[0 NaN NaN 2 (null)]",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := tc.fn(arr, mem, tc.opts...)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer out.Release()
+
+			if got, want := out.String(), tc.want; got != want {
+				t.Fatalf("invalid result:\ngot= %s\nwant=%s", got, want)
+			}
+		})
+	}
+}
+
+func TestPower(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewInt32Builder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]int32{2, 0, -4, 9, 1}, []bool{true, true, true, true, false})
+
+	arr := bldr.NewInt32Array()
+	defer arr.Release()
+
+	for _, tc := range []struct {
+		name string
+		exp  float64
+		opts []compute.MathOption
+		want string
+	}{
+		{name: "square", exp: 2, want: "[4 0 16 81 (null)]"},
+		{name: "inverse", exp: -1, want: "[0.5 +Inf -0.25 0.1111111111111111 (null)]"},
+		{name: "sqrt", exp: 0.5, want: "[1.4142135623730951 0 NaN 3 (null)]"},
+		{
+			name: "sqrt-null",
+			exp:  0.5,
+			opts: []compute.MathOption{compute.WithDomainErrorPolicy(compute.DomainErrorNull)},
+			want: "[1.4142135623730951 0 (null) 3 (null)]",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := compute.Power(arr, tc.exp, mem, tc.opts...)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer out.Release()
+
+			if got, want := out.String(), tc.want; got != want {
+				t.Fatalf("invalid result:\ngot= %s\nwant=%s", got, want)
+			}
+		})
+	}
+}
+
+func TestMathPromotion(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bldr := array.NewUint64Builder(mem)
+	defer bldr.Release()
+	bldr.AppendValues([]uint64{1, 8, 1024}, nil)
+
+	arr := bldr.NewUint64Array()
+	defer arr.Release()
+
+	out, err := compute.Log2(arr, mem)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	defer out.Release()
+
+	if got, want := out.Float64Values(), []float64{0, 3, 10}; len(got) != len(want) {
+		t.Fatalf("got=%v, want=%v", got, want)
+	} else {
+		for i := range got {
+			if math.Abs(got[i]-want[i]) > 1e-12 {
+				t.Fatalf("got=%v, want=%v", got, want)
+			}
+		}
+	}
+
+	sb := array.NewStringBuilder(mem)
+	defer sb.Release()
+	sb.Append("1")
+	str := sb.NewArray()
+	defer str.Release()
+
+	if _, err := compute.Ln(str, mem); err == nil {
+		t.Fatalf("expected an error on string input")
+	}
+}
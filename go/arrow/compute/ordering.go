@@ -0,0 +1,128 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// comparator returns -1, 0 or +1 depending on whether the i-th element
+// of an array is less than, equal to or greater than its j-th element.
+// Comparators do not handle null elements.
+type comparator func(i, j int) int
+
+// newComparator returns a comparator over the values of arr.
+// NaNs compare equal to each other and greater than any other value.
+func newComparator(arr array.Interface) (comparator, error) {
+	switch arr := arr.(type) {
+	case *array.Int8:
+		v := arr.Int8Values()
+		return func(i, j int) int { return cmpInt64(int64(v[i]), int64(v[j])) }, nil
+	case *array.Int16:
+		v := arr.Int16Values()
+		return func(i, j int) int { return cmpInt64(int64(v[i]), int64(v[j])) }, nil
+	case *array.Int32:
+		v := arr.Int32Values()
+		return func(i, j int) int { return cmpInt64(int64(v[i]), int64(v[j])) }, nil
+	case *array.Int64:
+		v := arr.Int64Values()
+		return func(i, j int) int { return cmpInt64(v[i], v[j]) }, nil
+	case *array.Uint8:
+		v := arr.Uint8Values()
+		return func(i, j int) int { return cmpUint64(uint64(v[i]), uint64(v[j])) }, nil
+	case *array.Uint16:
+		v := arr.Uint16Values()
+		return func(i, j int) int { return cmpUint64(uint64(v[i]), uint64(v[j])) }, nil
+	case *array.Uint32:
+		v := arr.Uint32Values()
+		return func(i, j int) int { return cmpUint64(uint64(v[i]), uint64(v[j])) }, nil
+	case *array.Uint64:
+		v := arr.Uint64Values()
+		return func(i, j int) int { return cmpUint64(v[i], v[j]) }, nil
+	case *array.Float32:
+		v := arr.Float32Values()
+		return func(i, j int) int { return cmpFloat64(float64(v[i]), float64(v[j])) }, nil
+	case *array.Float64:
+		v := arr.Float64Values()
+		return func(i, j int) int { return cmpFloat64(v[i], v[j]) }, nil
+	case *array.Date32:
+		v := arr.Date32Values()
+		return func(i, j int) int { return cmpInt64(int64(v[i]), int64(v[j])) }, nil
+	case *array.Date64:
+		v := arr.Date64Values()
+		return func(i, j int) int { return cmpInt64(int64(v[i]), int64(v[j])) }, nil
+	case *array.Time32:
+		v := arr.Time32Values()
+		return func(i, j int) int { return cmpInt64(int64(v[i]), int64(v[j])) }, nil
+	case *array.Time64:
+		v := arr.Time64Values()
+		return func(i, j int) int { return cmpInt64(int64(v[i]), int64(v[j])) }, nil
+	case *array.Timestamp:
+		v := arr.TimestampValues()
+		return func(i, j int) int { return cmpInt64(int64(v[i]), int64(v[j])) }, nil
+	case *array.Duration:
+		v := arr.DurationValues()
+		return func(i, j int) int { return cmpInt64(int64(v[i]), int64(v[j])) }, nil
+	case *array.String:
+		return func(i, j int) int { return strings.Compare(arr.Value(i), arr.Value(j)) }, nil
+	case *array.Binary:
+		return func(i, j int) int { return bytes.Compare(arr.Value(i), arr.Value(j)) }, nil
+	default:
+		return nil, fmt.Errorf("arrow/compute: unsupported data type %v", arr.DataType())
+	}
+}
+
+func cmpInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+func cmpUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+func cmpFloat64(a, b float64) int {
+	switch an, bn := math.IsNaN(a), math.IsNaN(b); {
+	case an && bn:
+		return 0
+	case an:
+		return +1
+	case bn:
+		return -1
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
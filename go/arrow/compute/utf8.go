@@ -0,0 +1,129 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Align describes how a value is positioned within a fixed width.
+type Align int
+
+const (
+	// AlignLeft keeps values on the left: padding is appended after the value
+	// and truncation drops the trailing runes.
+	AlignLeft Align = iota
+	// AlignRight keeps values on the right: padding is prepended before the value
+	// and truncation drops the leading runes.
+	AlignRight
+	// AlignCenter keeps values centered: padding and truncation are split
+	// between both ends, the extra rune going to the right end.
+	AlignCenter
+)
+
+func (a Align) String() string {
+	switch a {
+	case AlignLeft:
+		return "left"
+	case AlignRight:
+		return "right"
+	case AlignCenter:
+		return "center"
+	}
+	return fmt.Sprintf("Align(%d)", int(a))
+}
+
+// Utf8PadTruncate returns a new String array where each value of arr is
+// exactly width runes long: shorter values are padded with pad, longer
+// values are truncated, both according to align.
+// Null elements of arr stay null.
+func Utf8PadTruncate(arr *array.String, width int, pad rune, align Align, mem memory.Allocator) (*array.String, error) {
+	switch {
+	case width < 0:
+		return nil, fmt.Errorf("arrow/compute: invalid negative width %d", width)
+	case !utf8.ValidRune(pad):
+		return nil, fmt.Errorf("arrow/compute: invalid pad rune %U", pad)
+	case align != AlignLeft && align != AlignRight && align != AlignCenter:
+		return nil, fmt.Errorf("arrow/compute: invalid alignment %v", align)
+	}
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+
+	bldr.Reserve(arr.Len())
+	var o strings.Builder
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			bldr.AppendNull()
+			continue
+		}
+		o.Reset()
+		padTruncate(&o, arr.Value(i), width, pad, align)
+		bldr.Append(o.String())
+	}
+
+	return bldr.NewStringArray(), nil
+}
+
+func padTruncate(o *strings.Builder, v string, width int, pad rune, align Align) {
+	n := utf8.RuneCountInString(v)
+	switch {
+	case n == width:
+		o.WriteString(v)
+
+	case n < width:
+		var left int
+		switch align {
+		case AlignRight:
+			left = width - n
+		case AlignCenter:
+			left = (width - n) / 2
+		}
+		right := width - n - left
+		for i := 0; i < left; i++ {
+			o.WriteRune(pad)
+		}
+		o.WriteString(v)
+		for i := 0; i < right; i++ {
+			o.WriteRune(pad)
+		}
+
+	default:
+		var skip int
+		switch align {
+		case AlignRight:
+			skip = n - width
+		case AlignCenter:
+			skip = (n - width) / 2
+		}
+		i := 0
+		for _, r := range v {
+			if i >= skip+width {
+				break
+			}
+			if i >= skip {
+				o.WriteRune(r)
+			}
+			i++
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/compute"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func TestUtf8PadTruncate(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	var (
+		values = []string{"ab", "", "abcde", "abcdefg", "héllo", "x"}
+		valids = []bool{true, true, true, true, true, false}
+	)
+
+	bldr := array.NewStringBuilder(mem)
+	defer bldr.Release()
+	bldr.AppendValues(values, valids)
+
+	arr := bldr.NewStringArray()
+	defer arr.Release()
+
+	for _, tc := range []struct {
+		name  string
+		width int
+		pad   rune
+		align compute.Align
+		want  string
+	}{
+		{
+			name:  "left",
+			width: 5,
+			pad:   '.',
+			align: compute.AlignLeft,
+			want:  `["ab..." "....." "abcde" "abcde" "héllo" (null)]`,
+		},
+		{
+			name:  "right",
+			width: 5,
+			pad:   '.',
+			align: compute.AlignRight,
+			want:  `["...ab" "....." "abcde" "cdefg" "héllo" (null)]`,
+		},
+		{
+			name:  "center",
+			width: 5,
+			pad:   '.',
+			align: compute.AlignCenter,
+			want:  `[".ab.." "....." "abcde" "bcdef" "héllo" (null)]`,
+		},
+		{
+			name:  "multi-byte-pad",
+			width: 4,
+			pad:   '·',
+			align: compute.AlignRight,
+			want:  `["··ab" "····" "bcde" "defg" "éllo" (null)]`,
+		},
+		{
+			name:  "truncate-center",
+			width: 2,
+			pad:   ' ',
+			align: compute.AlignCenter,
+			want:  `["ab" "  " "bc" "cd" "él" (null)]`,
+		},
+		{
+			name:  "zero-width",
+			width: 0,
+			pad:   ' ',
+			align: compute.AlignLeft,
+			want:  `["" "" "" "" "" (null)]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := compute.Utf8PadTruncate(arr, tc.width, tc.pad, tc.align, mem)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			defer out.Release()
+
+			if got, want := out.String(), tc.want; got != want {
+				t.Fatalf("invalid result:\ngot= %s\nwant=%s", got, want)
+			}
+			if got, want := out.NullN(), 1; got != want {
+				t.Fatalf("invalid nulls: got=%d, want=%d", got, want)
+			}
+		})
+	}
+
+	for _, tc := range []struct {
+		name  string
+		width int
+		pad   rune
+		align compute.Align
+	}{
+		{name: "negative-width", width: -1, pad: ' ', align: compute.AlignLeft},
+		{name: "invalid-pad", width: 3, pad: 0xD800, align: compute.AlignLeft},
+		{name: "invalid-align", width: 3, pad: ' ', align: compute.Align(42)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := compute.Utf8PadTruncate(arr, tc.width, tc.pad, tc.align, mem)
+			if err == nil {
+				out.Release()
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compute
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// valueAt returns the i-th element of arr as a Go value, or nil if that
+// element is null.
+func valueAt(arr array.Interface, i int) (interface{}, error) {
+	if arr.IsNull(i) {
+		return nil, nil
+	}
+
+	switch arr := arr.(type) {
+	case *array.Boolean:
+		return arr.Value(i), nil
+	case *array.Int8:
+		return arr.Value(i), nil
+	case *array.Int16:
+		return arr.Value(i), nil
+	case *array.Int32:
+		return arr.Value(i), nil
+	case *array.Int64:
+		return arr.Value(i), nil
+	case *array.Uint8:
+		return arr.Value(i), nil
+	case *array.Uint16:
+		return arr.Value(i), nil
+	case *array.Uint32:
+		return arr.Value(i), nil
+	case *array.Uint64:
+		return arr.Value(i), nil
+	case *array.Float32:
+		return arr.Value(i), nil
+	case *array.Float64:
+		return arr.Value(i), nil
+	case *array.Date32:
+		return arr.Value(i), nil
+	case *array.Date64:
+		return arr.Value(i), nil
+	case *array.Time32:
+		return arr.Value(i), nil
+	case *array.Time64:
+		return arr.Value(i), nil
+	case *array.Timestamp:
+		return arr.Value(i), nil
+	case *array.Duration:
+		return arr.Value(i), nil
+	case *array.String:
+		return arr.Value(i), nil
+	case *array.Binary:
+		return arr.Value(i), nil
+	default:
+		return nil, fmt.Errorf("arrow/compute: unsupported data type %v", arr.DataType())
+	}
+}
+
+// appendValue appends v to the builder b, or a null if v is nil.
+// The dynamic type of v must match the Go type of the builder elements.
+func appendValue(b array.Builder, v interface{}) error {
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+
+	switch b := b.(type) {
+	case *array.BooleanBuilder:
+		if v, ok := v.(bool); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Int8Builder:
+		if v, ok := v.(int8); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Int16Builder:
+		if v, ok := v.(int16); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Int32Builder:
+		if v, ok := v.(int32); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Int64Builder:
+		if v, ok := v.(int64); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Uint8Builder:
+		if v, ok := v.(uint8); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Uint16Builder:
+		if v, ok := v.(uint16); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Uint32Builder:
+		if v, ok := v.(uint32); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Uint64Builder:
+		if v, ok := v.(uint64); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Float32Builder:
+		if v, ok := v.(float32); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Float64Builder:
+		if v, ok := v.(float64); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Date32Builder:
+		if v, ok := v.(arrow.Date32); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Date64Builder:
+		if v, ok := v.(arrow.Date64); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Time32Builder:
+		if v, ok := v.(arrow.Time32); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.Time64Builder:
+		if v, ok := v.(arrow.Time64); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.TimestampBuilder:
+		if v, ok := v.(arrow.Timestamp); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.DurationBuilder:
+		if v, ok := v.(arrow.Duration); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.StringBuilder:
+		if v, ok := v.(string); ok {
+			b.Append(v)
+			return nil
+		}
+	case *array.BinaryBuilder:
+		if v, ok := v.([]byte); ok {
+			b.Append(v)
+			return nil
+		}
+	default:
+		return fmt.Errorf("arrow/compute: unsupported builder type %T", b)
+	}
+
+	return fmt.Errorf("arrow/compute: invalid value type %T for builder type %T", v, b)
+}
+
+// hashKey returns a comparable representation of a value returned by valueAt,
+// suitable for use as a map key.
+func hashKey(v interface{}) interface{} {
+	if v, ok := v.([]byte); ok {
+		return string(v)
+	}
+	return v
+}
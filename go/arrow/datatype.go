@@ -120,6 +120,23 @@ const (
 	// Measure of elapsed time in either seconds, milliseconds, microseconds
 	// or nanoseconds.
 	DURATION
+
+	// LARGE_STRING is a UTF8 variable-length string, with 64-bit offsets
+	LARGE_STRING
+
+	// LARGE_BINARY is a variable-length byte type, with 64-bit offsets
+	LARGE_BINARY
+
+	// LARGE_LIST is a list of some logical data type, with 64-bit offsets
+	LARGE_LIST
+
+	// DECIMAL256 is a precision- and scale-based decimal type, with 256 bits.
+	// Storage type depends on the parameters.
+	DECIMAL256
+
+	// RUN_END_ENCODED is a run-end encoded array of some logical data type,
+	// storing each run of equal values once along with the index its run ends at.
+	RUN_END_ENCODED
 )
 
 // DataType is the representation of an Arrow type.
@@ -127,6 +144,8 @@ type DataType interface {
 	ID() Type
 	// Name is name of the data type.
 	Name() string
+	// Layout returns the physical layout of the arrays of the data type.
+	Layout() DataTypeLayout
 }
 
 // FixedWidthDataType is the representation of an Arrow type that
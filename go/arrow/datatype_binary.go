@@ -23,6 +23,8 @@ func (t *BinaryType) Name() string   { return "binary" }
 func (t *BinaryType) String() string { return "binary" }
 func (t *BinaryType) binary()        {}
 
+func (t *BinaryType) Layout() DataTypeLayout { return varWidthLayout(Int32SizeBytes) }
+
 type StringType struct{}
 
 func (t *StringType) ID() Type       { return STRING }
@@ -30,12 +32,40 @@ func (t *StringType) Name() string   { return "utf8" }
 func (t *StringType) String() string { return "utf8" }
 func (t *StringType) binary()        {}
 
+func (t *StringType) Layout() DataTypeLayout { return varWidthLayout(Int32SizeBytes) }
+
+// LargeBinaryType is a variable-length binary type, using 64-bit offsets
+// to address its values.
+type LargeBinaryType struct{}
+
+func (t *LargeBinaryType) ID() Type       { return LARGE_BINARY }
+func (t *LargeBinaryType) Name() string   { return "large_binary" }
+func (t *LargeBinaryType) String() string { return "large_binary" }
+func (t *LargeBinaryType) binary()        {}
+
+func (t *LargeBinaryType) Layout() DataTypeLayout { return varWidthLayout(Int64SizeBytes) }
+
+// LargeStringType is a variable-length UTF-8 string type, using 64-bit offsets
+// to address its values.
+type LargeStringType struct{}
+
+func (t *LargeStringType) ID() Type       { return LARGE_STRING }
+func (t *LargeStringType) Name() string   { return "large_utf8" }
+func (t *LargeStringType) String() string { return "large_utf8" }
+func (t *LargeStringType) binary()        {}
+
+func (t *LargeStringType) Layout() DataTypeLayout { return varWidthLayout(Int64SizeBytes) }
+
 var (
 	BinaryTypes = struct {
-		Binary BinaryDataType
-		String BinaryDataType
+		Binary      BinaryDataType
+		String      BinaryDataType
+		LargeBinary BinaryDataType
+		LargeString BinaryDataType
 	}{
-		Binary: &BinaryType{},
-		String: &StringType{},
+		Binary:      &BinaryType{},
+		String:      &StringType{},
+		LargeBinary: &LargeBinaryType{},
+		LargeString: &LargeStringType{},
 	}
 )
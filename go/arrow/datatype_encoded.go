@@ -0,0 +1,111 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import "fmt"
+
+// RunEndEncodedType describes a run-end encoded array of values of type Values.
+//
+// A run-end encoded array stores each run of consecutive equal values once,
+// along with the logical index at which the run ends (exclusive).
+// The run ends are stored as integers of type RunEnds, which must be
+// int16, int32 or int64.
+type RunEndEncodedType struct {
+	RunEnds DataType // data type of the run ends.
+	Values  DataType // data type of the values.
+}
+
+// RunEndEncodedOf returns the run-end encoded type of values of type values,
+// with run ends of type runEnds.
+//
+// RunEndEncodedOf panics if values is nil, or if runEnds is not int16, int32 or int64.
+func RunEndEncodedOf(runEnds, values DataType) *RunEndEncodedType {
+	if values == nil {
+		panic("arrow: nil DataType")
+	}
+	if !ValidRunEndsType(runEnds) {
+		panic(fmt.Errorf("arrow: invalid run ends data type %v", runEnds))
+	}
+	return &RunEndEncodedType{RunEnds: runEnds, Values: values}
+}
+
+// ValidRunEndsType reports whether dt can be used as the data type of
+// the run ends of a run-end encoded array.
+func ValidRunEndsType(dt DataType) bool {
+	if dt == nil {
+		return false
+	}
+	switch dt.ID() {
+	case INT16, INT32, INT64:
+		return true
+	}
+	return false
+}
+
+func (*RunEndEncodedType) ID() Type     { return RUN_END_ENCODED }
+func (*RunEndEncodedType) Name() string { return "run_end_encoded" }
+func (t *RunEndEncodedType) String() string {
+	return fmt.Sprintf("run_end_encoded<run_ends: %v, values: %v>", t.RunEnds, t.Values)
+}
+
+// Layout returns the layout of run-end encoded arrays: they have no buffer
+// of their own, and two children, the run ends and the values.
+func (*RunEndEncodedType) Layout() DataTypeLayout {
+	return DataTypeLayout{Buffers: []BufferSpec{SpecAlwaysNull()}, NumChildren: 2}
+}
+
+// DictionaryType describes a dictionary-encoded array of values of type
+// ValueType.
+//
+// A dictionary-encoded array stores each distinct value once, in the
+// dictionary, and the index of the value of each element in that
+// dictionary. The indices are stored as integers of type IndexType.
+type DictionaryType struct {
+	IndexType DataType // data type of the indices.
+	ValueType DataType // data type of the dictionary values.
+	Ordered   bool     // whether the order of the dictionary values is meaningful.
+}
+
+// ValidDictionaryIndexType reports whether dt can be used as the data type
+// of the indices of a dictionary-encoded array.
+func ValidDictionaryIndexType(dt DataType) bool {
+	if dt == nil {
+		return false
+	}
+	switch dt.ID() {
+	case INT8, INT16, INT32, INT64, UINT8, UINT16, UINT32, UINT64:
+		return true
+	}
+	return false
+}
+
+func (*DictionaryType) ID() Type     { return DICTIONARY }
+func (*DictionaryType) Name() string { return "dictionary" }
+func (t *DictionaryType) String() string {
+	return fmt.Sprintf("dictionary<values=%v, indices=%v, ordered=%t>", t.ValueType, t.IndexType, t.Ordered)
+}
+
+// Layout returns the layout of dictionary-encoded arrays: the layout of
+// their indices. The dictionary values are not part of the layout.
+func (t *DictionaryType) Layout() DataTypeLayout {
+	return fixedWidthLayout(t.IndexType.(FixedWidthDataType).BitWidth() / 8)
+}
+
+var (
+	_ DataType = (*RunEndEncodedType)(nil)
+	_ DataType = (*DictionaryType)(nil)
+)
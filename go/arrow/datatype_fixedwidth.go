@@ -19,6 +19,8 @@ package arrow
 import (
 	"fmt"
 	"strconv"
+
+	"github.com/apache/arrow/go/arrow/decimal256"
 )
 
 type BooleanType struct{}
@@ -30,6 +32,10 @@ func (t *BooleanType) String() string { return "bool" }
 // BitWidth returns the number of bits required to store a single element of this data type in memory.
 func (t *BooleanType) BitWidth() int { return 1 }
 
+func (t *BooleanType) Layout() DataTypeLayout {
+	return DataTypeLayout{Buffers: []BufferSpec{SpecBitmap(), SpecBitmap()}}
+}
+
 type FixedSizeBinaryType struct {
 	ByteWidth int
 }
@@ -38,6 +44,8 @@ func (*FixedSizeBinaryType) ID() Type        { return FIXED_SIZE_BINARY }
 func (*FixedSizeBinaryType) Name() string    { return "fixed_size_binary" }
 func (t *FixedSizeBinaryType) BitWidth() int { return 8 * t.ByteWidth }
 
+func (t *FixedSizeBinaryType) Layout() DataTypeLayout { return fixedWidthLayout(t.ByteWidth) }
+
 func (t *FixedSizeBinaryType) String() string {
 	return "fixed_size_binary[" + strconv.Itoa(t.ByteWidth) + "]"
 }
@@ -83,26 +91,50 @@ func (t *TimestampType) String() string {
 // BitWidth returns the number of bits required to store a single element of this data type in memory.
 func (*TimestampType) BitWidth() int { return 64 }
 
+func (*TimestampType) Layout() DataTypeLayout { return fixedWidthLayout(TimestampSizeBytes) }
+
 // Time32Type is encoded as a 32-bit signed integer, representing either seconds or milliseconds since midnight.
 type Time32Type struct {
 	Unit TimeUnit
 }
 
+// NewTime32Type returns a new 32-bit time type with the provided unit.
+// NewTime32Type returns an error if unit is neither seconds nor milliseconds.
+func NewTime32Type(unit TimeUnit) (*Time32Type, error) {
+	if unit != Second && unit != Millisecond {
+		return nil, fmt.Errorf("arrow: invalid time32 unit %q (must be s or ms)", unit)
+	}
+	return &Time32Type{Unit: unit}, nil
+}
+
 func (*Time32Type) ID() Type         { return TIME32 }
 func (*Time32Type) Name() string     { return "time32" }
 func (*Time32Type) BitWidth() int    { return 32 }
 func (t *Time32Type) String() string { return "time32[" + t.Unit.String() + "]" }
 
+func (*Time32Type) Layout() DataTypeLayout { return fixedWidthLayout(Time32SizeBytes) }
+
 // Time64Type is encoded as a 64-bit signed integer, representing either microseconds or nanoseconds since midnight.
 type Time64Type struct {
 	Unit TimeUnit
 }
 
+// NewTime64Type returns a new 64-bit time type with the provided unit.
+// NewTime64Type returns an error if unit is neither microseconds nor nanoseconds.
+func NewTime64Type(unit TimeUnit) (*Time64Type, error) {
+	if unit != Microsecond && unit != Nanosecond {
+		return nil, fmt.Errorf("arrow: invalid time64 unit %q (must be us or ns)", unit)
+	}
+	return &Time64Type{Unit: unit}, nil
+}
+
 func (*Time64Type) ID() Type         { return TIME64 }
 func (*Time64Type) Name() string     { return "time64" }
 func (*Time64Type) BitWidth() int    { return 64 }
 func (t *Time64Type) String() string { return "time64[" + t.Unit.String() + "]" }
 
+func (*Time64Type) Layout() DataTypeLayout { return fixedWidthLayout(Time64SizeBytes) }
+
 // DurationType is encoded as a 64-bit signed integer, representing an amount
 // of elapsed time without any relation to a calendar artifact.
 type DurationType struct {
@@ -114,6 +146,8 @@ func (*DurationType) Name() string     { return "duration" }
 func (*DurationType) BitWidth() int    { return 64 }
 func (t *DurationType) String() string { return "duration[" + t.Unit.String() + "]" }
 
+func (*DurationType) Layout() DataTypeLayout { return fixedWidthLayout(DurationSizeBytes) }
+
 // Float16Type represents a floating point value encoded with a 16-bit precision.
 type Float16Type struct{}
 
@@ -124,6 +158,8 @@ func (t *Float16Type) String() string { return "float16" }
 // BitWidth returns the number of bits required to store a single element of this data type in memory.
 func (t *Float16Type) BitWidth() int { return 16 }
 
+func (t *Float16Type) Layout() DataTypeLayout { return fixedWidthLayout(Float16SizeBytes) }
+
 // Decimal128Type represents a fixed-size 128-bit decimal type.
 type Decimal128Type struct {
 	Precision int32
@@ -132,11 +168,38 @@ type Decimal128Type struct {
 
 func (*Decimal128Type) ID() Type      { return DECIMAL }
 func (*Decimal128Type) Name() string  { return "decimal" }
-func (*Decimal128Type) BitWidth() int { return 16 }
+func (*Decimal128Type) BitWidth() int { return 128 }
 func (t *Decimal128Type) String() string {
 	return fmt.Sprintf("%s(%d, %d)", t.Name(), t.Precision, t.Scale)
 }
 
+func (*Decimal128Type) Layout() DataTypeLayout { return fixedWidthLayout(Decimal128SizeBytes) }
+
+// Decimal256Type represents a fixed-size 256-bit decimal type.
+type Decimal256Type struct {
+	Precision int32
+	Scale     int32
+}
+
+// NewDecimal256Type returns a new 256-bit decimal type with the provided
+// precision and scale.
+// NewDecimal256Type returns an error if precision is not in the [1, 76] range.
+func NewDecimal256Type(precision, scale int32) (*Decimal256Type, error) {
+	if precision < 1 || precision > decimal256.MaxPrecision {
+		return nil, fmt.Errorf("arrow: invalid decimal256 precision %d (must be in [1, %d])", precision, decimal256.MaxPrecision)
+	}
+	return &Decimal256Type{Precision: precision, Scale: scale}, nil
+}
+
+func (*Decimal256Type) ID() Type      { return DECIMAL256 }
+func (*Decimal256Type) Name() string  { return "decimal256" }
+func (*Decimal256Type) BitWidth() int { return 256 }
+func (t *Decimal256Type) String() string {
+	return fmt.Sprintf("%s(%d, %d)", t.Name(), t.Precision, t.Scale)
+}
+
+func (*Decimal256Type) Layout() DataTypeLayout { return fixedWidthLayout(Decimal256SizeBytes) }
+
 // MonthInterval represents a number of months.
 type MonthInterval int32
 
@@ -151,6 +214,8 @@ func (*MonthIntervalType) String() string { return "month_interval" }
 // BitWidth returns the number of bits required to store a single element of this data type in memory.
 func (t *MonthIntervalType) BitWidth() int { return 32 }
 
+func (*MonthIntervalType) Layout() DataTypeLayout { return fixedWidthLayout(MonthIntervalSizeBytes) }
+
 // DayTimeInterval represents a number of days and milliseconds (fraction of day).
 type DayTimeInterval struct {
 	Days         int32 `json:"days"`
@@ -168,6 +233,8 @@ func (*DayTimeIntervalType) String() string { return "day_time_interval" }
 // BitWidth returns the number of bits required to store a single element of this data type in memory.
 func (t *DayTimeIntervalType) BitWidth() int { return 64 }
 
+func (*DayTimeIntervalType) Layout() DataTypeLayout { return fixedWidthLayout(DayTimeIntervalSizeBytes) }
+
 var (
 	FixedWidthTypes = struct {
 		Boolean         FixedWidthDataType
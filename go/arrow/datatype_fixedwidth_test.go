@@ -53,7 +53,7 @@ func TestDecimal128Type(t *testing.T) {
 	} {
 		t.Run(tc.want, func(t *testing.T) {
 			dt := arrow.Decimal128Type{Precision: tc.precision, Scale: tc.scale}
-			if got, want := dt.BitWidth(), 16; got != want {
+			if got, want := dt.BitWidth(), 128; got != want {
 				t.Fatalf("invalid bitwidth: got=%d, want=%d", got, want)
 			}
 
@@ -67,3 +67,71 @@ func TestDecimal128Type(t *testing.T) {
 		})
 	}
 }
+
+func TestDecimal256Type(t *testing.T) {
+	for _, tc := range []struct {
+		precision int32
+		scale     int32
+		want      string
+	}{
+		{1, 10, "decimal256(1, 10)"},
+		{40, 10, "decimal256(40, 10)"},
+		{76, -2, "decimal256(76, -2)"},
+	} {
+		t.Run(tc.want, func(t *testing.T) {
+			dt, err := arrow.NewDecimal256Type(tc.precision, tc.scale)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if got, want := dt.BitWidth(), 256; got != want {
+				t.Fatalf("invalid bitwidth: got=%d, want=%d", got, want)
+			}
+
+			if got, want := dt.ID(), arrow.DECIMAL256; got != want {
+				t.Fatalf("invalid type ID: got=%v, want=%v", got, want)
+			}
+
+			if got, want := dt.String(), tc.want; got != want {
+				t.Fatalf("invalid stringer: got=%q, want=%q", got, want)
+			}
+		})
+	}
+
+	for _, precision := range []int32{0, -1, 77} {
+		if _, err := arrow.NewDecimal256Type(precision, 0); err == nil {
+			t.Fatalf("expected an error for precision %d", precision)
+		}
+	}
+}
+
+func TestNewTimeType(t *testing.T) {
+	for _, unit := range []arrow.TimeUnit{arrow.Second, arrow.Millisecond} {
+		dt, err := arrow.NewTime32Type(unit)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if got, want := dt.Unit, unit; got != want {
+			t.Fatalf("invalid unit: got=%v, want=%v", got, want)
+		}
+	}
+	for _, unit := range []arrow.TimeUnit{arrow.Microsecond, arrow.Nanosecond} {
+		if _, err := arrow.NewTime32Type(unit); err == nil {
+			t.Fatalf("expected an error for time32 unit %v", unit)
+		}
+	}
+
+	for _, unit := range []arrow.TimeUnit{arrow.Microsecond, arrow.Nanosecond} {
+		dt, err := arrow.NewTime64Type(unit)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if got, want := dt.Unit, unit; got != want {
+			t.Fatalf("invalid unit: got=%v, want=%v", got, want)
+		}
+	}
+	for _, unit := range []arrow.TimeUnit{arrow.Second, arrow.Millisecond} {
+		if _, err := arrow.NewTime64Type(unit); err == nil {
+			t.Fatalf("expected an error for time64 unit %v", unit)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+// BufferKind describes the kind of a buffer of an array.
+type BufferKind int8
+
+const (
+	// KindFixedWidth is a buffer of fixed-width elements, such as values
+	// or offsets.
+	KindFixedWidth BufferKind = iota
+	// KindVarWidth is a buffer of variable-width values, addressed by offsets.
+	KindVarWidth
+	// KindBitmap is a buffer of bits, such as a validity bitmap.
+	KindBitmap
+	// KindAlwaysNull is a buffer which is always nil, such as the validity
+	// bitmap of a type without one.
+	KindAlwaysNull
+)
+
+// BufferSpec describes one buffer of an array.
+type BufferSpec struct {
+	Kind      BufferKind
+	ByteWidth int // width in bytes of the elements of a KindFixedWidth buffer, -1 otherwise.
+}
+
+// SpecFixedWidth returns the spec of a buffer of elements of w bytes.
+func SpecFixedWidth(w int) BufferSpec { return BufferSpec{Kind: KindFixedWidth, ByteWidth: w} }
+
+// SpecVariableWidth returns the spec of a buffer of variable-width values.
+func SpecVariableWidth() BufferSpec { return BufferSpec{Kind: KindVarWidth, ByteWidth: -1} }
+
+// SpecBitmap returns the spec of a bitmap buffer.
+func SpecBitmap() BufferSpec { return BufferSpec{Kind: KindBitmap, ByteWidth: -1} }
+
+// SpecAlwaysNull returns the spec of a buffer which is always nil.
+func SpecAlwaysNull() BufferSpec { return BufferSpec{Kind: KindAlwaysNull, ByteWidth: -1} }
+
+// DataTypeLayout describes the physical layout of the arrays of a data type:
+// their buffers, in the order they appear in array.Data, and the number of
+// their child arrays.
+//
+// The first buffer is the validity bitmap, or an always-null buffer for data
+// types without one.
+type DataTypeLayout struct {
+	Buffers     []BufferSpec
+	NumChildren int
+}
+
+// fixedWidthLayout returns the layout of a fixed-width type of w bytes.
+func fixedWidthLayout(w int) DataTypeLayout {
+	return DataTypeLayout{Buffers: []BufferSpec{SpecBitmap(), SpecFixedWidth(w)}}
+}
+
+// varWidthLayout returns the layout of a variable-width type with offsets
+// of w bytes.
+func varWidthLayout(w int) DataTypeLayout {
+	return DataTypeLayout{Buffers: []BufferSpec{SpecBitmap(), SpecFixedWidth(w), SpecVariableWidth()}}
+}
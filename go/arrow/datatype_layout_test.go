@@ -0,0 +1,82 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+func TestDataTypeLayout(t *testing.T) {
+	var (
+		bitmap = arrow.SpecBitmap()
+		null   = arrow.SpecAlwaysNull()
+		vars   = arrow.SpecVariableWidth()
+		fixed  = arrow.SpecFixedWidth
+	)
+
+	for _, tc := range []struct {
+		dt   arrow.DataType
+		bufs []arrow.BufferSpec
+		n    int
+	}{
+		{arrow.Null, []arrow.BufferSpec{null}, 0},
+		{arrow.FixedWidthTypes.Boolean, []arrow.BufferSpec{bitmap, bitmap}, 0},
+		{arrow.PrimitiveTypes.Int8, []arrow.BufferSpec{bitmap, fixed(1)}, 0},
+		{arrow.PrimitiveTypes.Uint16, []arrow.BufferSpec{bitmap, fixed(2)}, 0},
+		{arrow.PrimitiveTypes.Int32, []arrow.BufferSpec{bitmap, fixed(4)}, 0},
+		{arrow.PrimitiveTypes.Int64, []arrow.BufferSpec{bitmap, fixed(8)}, 0},
+		{arrow.PrimitiveTypes.Float64, []arrow.BufferSpec{bitmap, fixed(8)}, 0},
+		{arrow.PrimitiveTypes.Date32, []arrow.BufferSpec{bitmap, fixed(4)}, 0},
+		{arrow.FixedWidthTypes.Float16, []arrow.BufferSpec{bitmap, fixed(2)}, 0},
+		{arrow.FixedWidthTypes.Timestamp_ns, []arrow.BufferSpec{bitmap, fixed(8)}, 0},
+		{arrow.FixedWidthTypes.Time32ms, []arrow.BufferSpec{bitmap, fixed(4)}, 0},
+		{arrow.FixedWidthTypes.Time64us, []arrow.BufferSpec{bitmap, fixed(8)}, 0},
+		{arrow.FixedWidthTypes.Duration_s, []arrow.BufferSpec{bitmap, fixed(8)}, 0},
+		{arrow.FixedWidthTypes.MonthInterval, []arrow.BufferSpec{bitmap, fixed(4)}, 0},
+		{arrow.FixedWidthTypes.DayTimeInterval, []arrow.BufferSpec{bitmap, fixed(8)}, 0},
+		{&arrow.Decimal128Type{Precision: 10, Scale: 2}, []arrow.BufferSpec{bitmap, fixed(16)}, 0},
+		{&arrow.Decimal256Type{Precision: 40, Scale: 2}, []arrow.BufferSpec{bitmap, fixed(32)}, 0},
+		{&arrow.FixedSizeBinaryType{ByteWidth: 7}, []arrow.BufferSpec{bitmap, fixed(7)}, 0},
+		{arrow.BinaryTypes.String, []arrow.BufferSpec{bitmap, fixed(4), vars}, 0},
+		{arrow.BinaryTypes.Binary, []arrow.BufferSpec{bitmap, fixed(4), vars}, 0},
+		{arrow.BinaryTypes.LargeString, []arrow.BufferSpec{bitmap, fixed(8), vars}, 0},
+		{arrow.BinaryTypes.LargeBinary, []arrow.BufferSpec{bitmap, fixed(8), vars}, 0},
+		{arrow.ListOf(arrow.BinaryTypes.String), []arrow.BufferSpec{bitmap, fixed(4)}, 1},
+		{arrow.LargeListOf(arrow.PrimitiveTypes.Int8), []arrow.BufferSpec{bitmap, fixed(8)}, 1},
+		{arrow.FixedSizeListOf(3, arrow.PrimitiveTypes.Int8), []arrow.BufferSpec{bitmap}, 1},
+		{arrow.StructOf(
+			arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int8},
+			arrow.Field{Name: "b", Type: arrow.BinaryTypes.String},
+		), []arrow.BufferSpec{bitmap}, 2},
+		{arrow.RunEndEncodedOf(arrow.PrimitiveTypes.Int32, arrow.BinaryTypes.String), []arrow.BufferSpec{null}, 2},
+		{&arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int16, ValueType: arrow.BinaryTypes.String}, []arrow.BufferSpec{bitmap, fixed(2)}, 0},
+	} {
+		t.Run(fmt.Sprintf("%v", tc.dt), func(t *testing.T) {
+			layout := tc.dt.Layout()
+			if got, want := layout.Buffers, tc.bufs; !reflect.DeepEqual(got, want) {
+				t.Fatalf("invalid buffers: got=%+v, want=%+v", got, want)
+			}
+			if got, want := layout.NumChildren, tc.n; got != want {
+				t.Fatalf("invalid number of children: got=%d, want=%d", got, want)
+			}
+		})
+	}
+}
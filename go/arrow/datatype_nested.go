@@ -18,7 +18,6 @@ package arrow
 
 import (
 	"fmt"
-	"reflect"
 	"strings"
 )
 
@@ -46,6 +45,38 @@ func (t *ListType) String() string { return fmt.Sprintf("list<item: %v>", t.elem
 // Elem returns the ListType's element type.
 func (t *ListType) Elem() DataType { return t.elem }
 
+func (*ListType) Layout() DataTypeLayout {
+	return DataTypeLayout{Buffers: []BufferSpec{SpecBitmap(), SpecFixedWidth(Int32SizeBytes)}, NumChildren: 1}
+}
+
+// LargeListType describes a nested type in which each array slot contains
+// a variable-size sequence of values, all having the same relative type.
+// LargeListType uses 64-bit offsets to address its values.
+type LargeListType struct {
+	elem DataType // DataType of the list's elements
+}
+
+// LargeListOf returns the large list type with element type t.
+//
+// LargeListOf panics if t is nil or invalid.
+func LargeListOf(t DataType) *LargeListType {
+	if t == nil {
+		panic("arrow: nil DataType")
+	}
+	return &LargeListType{elem: t}
+}
+
+func (*LargeListType) ID() Type         { return LARGE_LIST }
+func (*LargeListType) Name() string     { return "large_list" }
+func (t *LargeListType) String() string { return fmt.Sprintf("large_list<item: %v>", t.elem) }
+
+// Elem returns the LargeListType's element type.
+func (t *LargeListType) Elem() DataType { return t.elem }
+
+func (*LargeListType) Layout() DataTypeLayout {
+	return DataTypeLayout{Buffers: []BufferSpec{SpecBitmap(), SpecFixedWidth(Int64SizeBytes)}, NumChildren: 1}
+}
+
 // FixedSizeListType describes a nested type in which each array slot contains
 // a fixed-size sequence of values, all having the same relative type.
 type FixedSizeListType struct {
@@ -80,6 +111,10 @@ func (t *FixedSizeListType) Elem() DataType { return t.elem }
 // Len returns the FixedSizeListType's size.
 func (t *FixedSizeListType) Len() int32 { return t.n }
 
+func (*FixedSizeListType) Layout() DataTypeLayout {
+	return DataTypeLayout{Buffers: []BufferSpec{SpecBitmap()}, NumChildren: 1}
+}
+
 // StructType describes a nested type parameterized by an ordered sequence
 // of relative types, called its fields.
 type StructType struct {
@@ -140,6 +175,10 @@ func (t *StructType) String() string {
 func (t *StructType) Fields() []Field   { return t.fields }
 func (t *StructType) Field(i int) Field { return t.fields[i] }
 
+func (t *StructType) Layout() DataTypeLayout {
+	return DataTypeLayout{Buffers: []BufferSpec{SpecBitmap()}, NumChildren: len(t.fields)}
+}
+
 func (t *StructType) FieldByName(name string) (Field, bool) {
 	i, ok := t.index[name]
 	if !ok {
@@ -157,8 +196,32 @@ type Field struct {
 
 func (f Field) HasMetadata() bool { return f.Metadata.Len() != 0 }
 
+// Equal returns whether two fields are equal, including their metadata
+// and the metadata of the fields of their nested struct types.
 func (f Field) Equal(o Field) bool {
-	return reflect.DeepEqual(f, o)
+	return f.equal(o, true)
+}
+
+// EqualIgnoringMetadata returns whether two fields are equal, regardless of
+// their metadata. The metadata of nested struct fields is ignored as well,
+// at any nesting level.
+func (f Field) EqualIgnoringMetadata(o Field) bool {
+	return f.equal(o, false)
+}
+
+func (f Field) equal(o Field, metadata bool) bool {
+	switch {
+	case f.Name != o.Name || f.Nullable != o.Nullable:
+		return false
+	case metadata && !f.Metadata.equal(o.Metadata):
+		return false
+	case f.Type == nil || o.Type == nil:
+		return f.Type == o.Type
+	case !metadata:
+		return TypeEquals(f.Type, o.Type)
+	default:
+		return TypeEquals(f.Type, o.Type, CheckMetadata())
+	}
 }
 
 func (f Field) String() string {
@@ -273,6 +273,11 @@ func TestFieldEqual(t *testing.T) {
 			b:    Field{Name: "a", Type: PrimitiveTypes.Int32, Metadata: MetadataFrom(map[string]string{"k": "v"})},
 			want: false,
 		},
+		{
+			a:    Field{Name: "a", Type: PrimitiveTypes.Int32, Metadata: NewMetadata([]string{"k1", "k2"}, []string{"v1", "v2"})},
+			b:    Field{Name: "a", Type: PrimitiveTypes.Int32, Metadata: NewMetadata([]string{"k2", "k1"}, []string{"v2", "v1"})},
+			want: true,
+		},
 		{
 			a:    Field{Name: "a", Type: PrimitiveTypes.Int32},
 			b:    Field{Name: "b", Type: PrimitiveTypes.Int32},
@@ -283,6 +288,11 @@ func TestFieldEqual(t *testing.T) {
 			b:    Field{Name: "a", Type: PrimitiveTypes.Uint32},
 			want: false,
 		},
+		{
+			a:    Field{Name: "a", Type: ListOf(StructOf(Field{Name: "x", Type: PrimitiveTypes.Int8, Metadata: MetadataFrom(map[string]string{"k": "k"})}))},
+			b:    Field{Name: "a", Type: ListOf(StructOf(Field{Name: "x", Type: PrimitiveTypes.Int8, Metadata: MetadataFrom(map[string]string{"k": "v"})}))},
+			want: false,
+		},
 	} {
 		t.Run("", func(t *testing.T) {
 			got := tc.a.Equal(tc.b)
@@ -293,6 +303,61 @@ func TestFieldEqual(t *testing.T) {
 	}
 }
 
+func TestFieldEqualIgnoringMetadata(t *testing.T) {
+	md := func(v string) Metadata { return MetadataFrom(map[string]string{"k": v}) }
+	for _, tc := range []struct {
+		a, b Field
+		want bool
+	}{
+		{
+			a:    Field{Name: "a", Type: PrimitiveTypes.Int32},
+			b:    Field{Name: "a", Type: PrimitiveTypes.Int32, Metadata: md("v")},
+			want: true,
+		},
+		{
+			a:    Field{Name: "a", Type: PrimitiveTypes.Int32, Metadata: md("k")},
+			b:    Field{Name: "a", Type: PrimitiveTypes.Int32, Metadata: md("v")},
+			want: true,
+		},
+		{
+			a:    Field{Name: "a", Type: StructOf(Field{Name: "x", Type: PrimitiveTypes.Int8, Metadata: md("k")})},
+			b:    Field{Name: "a", Type: StructOf(Field{Name: "x", Type: PrimitiveTypes.Int8, Metadata: md("v")})},
+			want: true,
+		},
+		{
+			a:    Field{Name: "a", Type: ListOf(StructOf(Field{Name: "x", Type: PrimitiveTypes.Int8, Metadata: md("k")}))},
+			b:    Field{Name: "a", Type: ListOf(StructOf(Field{Name: "x", Type: PrimitiveTypes.Int8, Metadata: md("v")}))},
+			want: true,
+		},
+		{
+			a:    Field{Name: "a", Type: FixedSizeListOf(2, StructOf(Field{Name: "x", Type: ListOf(PrimitiveTypes.Int8), Metadata: md("k")}))},
+			b:    Field{Name: "a", Type: FixedSizeListOf(2, StructOf(Field{Name: "x", Type: ListOf(PrimitiveTypes.Int8), Metadata: md("v")}))},
+			want: true,
+		},
+		{
+			a:    Field{Name: "a", Type: ListOf(StructOf(Field{Name: "x", Type: PrimitiveTypes.Int8}))},
+			b:    Field{Name: "a", Type: ListOf(StructOf(Field{Name: "y", Type: PrimitiveTypes.Int8}))},
+			want: false,
+		},
+		{
+			a:    Field{Name: "a", Type: PrimitiveTypes.Int32, Metadata: md("v")},
+			b:    Field{Name: "a", Type: PrimitiveTypes.Int32, Nullable: true, Metadata: md("v")},
+			want: false,
+		},
+		{
+			a:    Field{Name: "a", Type: PrimitiveTypes.Int32},
+			b:    Field{Name: "a", Type: PrimitiveTypes.Uint32},
+			want: false,
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			if got := tc.a.EqualIgnoringMetadata(tc.b); got != tc.want {
+				t.Fatalf("got=%v, want=%v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestFixedSizeListOf(t *testing.T) {
 	for _, tc := range []DataType{
 		FixedWidthTypes.Boolean,
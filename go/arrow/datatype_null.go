@@ -23,6 +23,10 @@ func (*NullType) ID() Type       { return NULL }
 func (*NullType) Name() string   { return "null" }
 func (*NullType) String() string { return "null" }
 
+func (*NullType) Layout() DataTypeLayout {
+	return DataTypeLayout{Buffers: []BufferSpec{SpecAlwaysNull()}}
+}
+
 var (
 	Null *NullType
 	_    DataType = Null
@@ -25,6 +25,8 @@ func (t *Int8Type) Name() string   { return "int8" }
 func (t *Int8Type) String() string { return "int8" }
 func (t *Int8Type) BitWidth() int  { return 8 }
 
+func (t *Int8Type) Layout() DataTypeLayout { return fixedWidthLayout(Int8SizeBytes) }
+
 type Int16Type struct{}
 
 func (t *Int16Type) ID() Type       { return INT16 }
@@ -32,6 +34,8 @@ func (t *Int16Type) Name() string   { return "int16" }
 func (t *Int16Type) String() string { return "int16" }
 func (t *Int16Type) BitWidth() int  { return 16 }
 
+func (t *Int16Type) Layout() DataTypeLayout { return fixedWidthLayout(Int16SizeBytes) }
+
 type Int32Type struct{}
 
 func (t *Int32Type) ID() Type       { return INT32 }
@@ -39,6 +43,8 @@ func (t *Int32Type) Name() string   { return "int32" }
 func (t *Int32Type) String() string { return "int32" }
 func (t *Int32Type) BitWidth() int  { return 32 }
 
+func (t *Int32Type) Layout() DataTypeLayout { return fixedWidthLayout(Int32SizeBytes) }
+
 type Int64Type struct{}
 
 func (t *Int64Type) ID() Type       { return INT64 }
@@ -46,6 +52,8 @@ func (t *Int64Type) Name() string   { return "int64" }
 func (t *Int64Type) String() string { return "int64" }
 func (t *Int64Type) BitWidth() int  { return 64 }
 
+func (t *Int64Type) Layout() DataTypeLayout { return fixedWidthLayout(Int64SizeBytes) }
+
 type Uint8Type struct{}
 
 func (t *Uint8Type) ID() Type       { return UINT8 }
@@ -53,6 +61,8 @@ func (t *Uint8Type) Name() string   { return "uint8" }
 func (t *Uint8Type) String() string { return "uint8" }
 func (t *Uint8Type) BitWidth() int  { return 8 }
 
+func (t *Uint8Type) Layout() DataTypeLayout { return fixedWidthLayout(Uint8SizeBytes) }
+
 type Uint16Type struct{}
 
 func (t *Uint16Type) ID() Type       { return UINT16 }
@@ -60,6 +70,8 @@ func (t *Uint16Type) Name() string   { return "uint16" }
 func (t *Uint16Type) String() string { return "uint16" }
 func (t *Uint16Type) BitWidth() int  { return 16 }
 
+func (t *Uint16Type) Layout() DataTypeLayout { return fixedWidthLayout(Uint16SizeBytes) }
+
 type Uint32Type struct{}
 
 func (t *Uint32Type) ID() Type       { return UINT32 }
@@ -67,6 +79,8 @@ func (t *Uint32Type) Name() string   { return "uint32" }
 func (t *Uint32Type) String() string { return "uint32" }
 func (t *Uint32Type) BitWidth() int  { return 32 }
 
+func (t *Uint32Type) Layout() DataTypeLayout { return fixedWidthLayout(Uint32SizeBytes) }
+
 type Uint64Type struct{}
 
 func (t *Uint64Type) ID() Type       { return UINT64 }
@@ -74,6 +88,8 @@ func (t *Uint64Type) Name() string   { return "uint64" }
 func (t *Uint64Type) String() string { return "uint64" }
 func (t *Uint64Type) BitWidth() int  { return 64 }
 
+func (t *Uint64Type) Layout() DataTypeLayout { return fixedWidthLayout(Uint64SizeBytes) }
+
 type Float32Type struct{}
 
 func (t *Float32Type) ID() Type       { return FLOAT32 }
@@ -81,6 +97,8 @@ func (t *Float32Type) Name() string   { return "float32" }
 func (t *Float32Type) String() string { return "float32" }
 func (t *Float32Type) BitWidth() int  { return 32 }
 
+func (t *Float32Type) Layout() DataTypeLayout { return fixedWidthLayout(Float32SizeBytes) }
+
 type Float64Type struct{}
 
 func (t *Float64Type) ID() Type       { return FLOAT64 }
@@ -88,6 +106,8 @@ func (t *Float64Type) Name() string   { return "float64" }
 func (t *Float64Type) String() string { return "float64" }
 func (t *Float64Type) BitWidth() int  { return 64 }
 
+func (t *Float64Type) Layout() DataTypeLayout { return fixedWidthLayout(Float64SizeBytes) }
+
 type Date32Type struct{}
 
 func (t *Date32Type) ID() Type       { return DATE32 }
@@ -95,6 +115,8 @@ func (t *Date32Type) Name() string   { return "date32" }
 func (t *Date32Type) String() string { return "date32" }
 func (t *Date32Type) BitWidth() int  { return 32 }
 
+func (t *Date32Type) Layout() DataTypeLayout { return fixedWidthLayout(Date32SizeBytes) }
+
 type Date64Type struct{}
 
 func (t *Date64Type) ID() Type       { return DATE64 }
@@ -102,6 +124,8 @@ func (t *Date64Type) Name() string   { return "date64" }
 func (t *Date64Type) String() string { return "date64" }
 func (t *Date64Type) BitWidth() int  { return 64 }
 
+func (t *Date64Type) Layout() DataTypeLayout { return fixedWidthLayout(Date64SizeBytes) }
+
 var (
 	PrimitiveTypes = struct {
 		Int8    DataType
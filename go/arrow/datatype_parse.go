@@ -0,0 +1,417 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TypeFromString returns the data type described by s, in the format
+// produced by the String method of data types.
+//
+// For example:
+//   int64
+//   list<item: int64>
+//   decimal(10, 2)
+//   timestamp[ms, tz=UTC]
+//   struct<a: int32, b: list<item: utf8>>
+//   dictionary<values=utf8, indices=int32, ordered=false>
+//
+// The "item: " element name of list types is optional.
+// Struct fields parsed from s are nullable and have no metadata, since
+// these are not part of the string representation.
+func TypeFromString(s string) (DataType, error) {
+	p := typeParser{s: s}
+	dt, err := p.parse()
+	if err == nil {
+		p.skipSpaces()
+		if p.pos != len(p.s) {
+			err = p.errorf("unexpected trailing characters")
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return dt, nil
+}
+
+var simpleTypesByName = map[string]DataType{
+	"null":              Null,
+	"bool":              FixedWidthTypes.Boolean,
+	"int8":              PrimitiveTypes.Int8,
+	"int16":             PrimitiveTypes.Int16,
+	"int32":             PrimitiveTypes.Int32,
+	"int64":             PrimitiveTypes.Int64,
+	"uint8":             PrimitiveTypes.Uint8,
+	"uint16":            PrimitiveTypes.Uint16,
+	"uint32":            PrimitiveTypes.Uint32,
+	"uint64":            PrimitiveTypes.Uint64,
+	"float16":           FixedWidthTypes.Float16,
+	"float32":           PrimitiveTypes.Float32,
+	"float64":           PrimitiveTypes.Float64,
+	"date32":            FixedWidthTypes.Date32,
+	"date64":            FixedWidthTypes.Date64,
+	"month_interval":    FixedWidthTypes.MonthInterval,
+	"day_time_interval": FixedWidthTypes.DayTimeInterval,
+	"binary":            BinaryTypes.Binary,
+	"utf8":              BinaryTypes.String,
+	"large_binary":      BinaryTypes.LargeBinary,
+	"large_utf8":        BinaryTypes.LargeString,
+}
+
+var timeUnitsByName = map[string]TimeUnit{
+	"s":  Second,
+	"ms": Millisecond,
+	"us": Microsecond,
+	"ns": Nanosecond,
+}
+
+// typeParser is a recursive descent parser for the string representation
+// of data types.
+type typeParser struct {
+	s   string
+	pos int
+}
+
+func (p *typeParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("arrow: could not parse data type %q at offset %d: %s", p.s, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *typeParser) skipSpaces() {
+	for p.pos < len(p.s) && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// consume skips spaces and then the token tok, if present.
+func (p *typeParser) consume(tok string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(p.s[p.pos:], tok) {
+		p.pos += len(tok)
+		return true
+	}
+	return false
+}
+
+func (p *typeParser) expect(tok string) error {
+	if !p.consume(tok) {
+		return p.errorf("expected %q", tok)
+	}
+	return nil
+}
+
+// ident returns the next run of lower-case letters, digits and underscores.
+func (p *typeParser) ident() string {
+	p.skipSpaces()
+	beg := p.pos
+	for p.pos < len(p.s) {
+		c := p.s[p.pos]
+		if !('a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '_') {
+			break
+		}
+		p.pos++
+	}
+	return p.s[beg:p.pos]
+}
+
+// until returns the text up to, but excluding, the next occurrence of one of chars.
+func (p *typeParser) until(chars string) (string, error) {
+	i := strings.IndexAny(p.s[p.pos:], chars)
+	if i < 0 {
+		return "", p.errorf("expected one of %q", chars)
+	}
+	v := p.s[p.pos : p.pos+i]
+	p.pos += i
+	return v, nil
+}
+
+func (p *typeParser) int() (int, error) {
+	p.skipSpaces()
+	beg := p.pos
+	for p.pos < len(p.s) && '0' <= p.s[p.pos] && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	if beg == p.pos {
+		return 0, p.errorf("expected an integer")
+	}
+	v, err := strconv.Atoi(p.s[beg:p.pos])
+	if err != nil {
+		return 0, p.errorf("invalid integer: %v", err)
+	}
+	return v, nil
+}
+
+func (p *typeParser) unit() (TimeUnit, error) {
+	name := p.ident()
+	unit, ok := timeUnitsByName[name]
+	if !ok {
+		return 0, p.errorf("invalid time unit %q", name)
+	}
+	return unit, nil
+}
+
+// bracketedUnit parses "[unit]".
+func (p *typeParser) bracketedUnit() (TimeUnit, error) {
+	if err := p.expect("["); err != nil {
+		return 0, err
+	}
+	unit, err := p.unit()
+	if err != nil {
+		return 0, err
+	}
+	return unit, p.expect("]")
+}
+
+// listElem parses "<item: type>", where the element name is optional.
+func (p *typeParser) listElem() (DataType, error) {
+	if err := p.expect("<"); err != nil {
+		return nil, err
+	}
+	save := p.pos
+	if p.ident() == "" || !p.consume(":") {
+		p.pos = save
+	}
+	elem, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	return elem, p.expect(">")
+}
+
+func (p *typeParser) parse() (DataType, error) {
+	name := p.ident()
+	if dt, ok := simpleTypesByName[name]; ok {
+		return dt, nil
+	}
+
+	switch name {
+	case "fixed_size_binary":
+		if err := p.expect("["); err != nil {
+			return nil, err
+		}
+		n, err := p.int()
+		if err != nil {
+			return nil, err
+		}
+		return &FixedSizeBinaryType{ByteWidth: n}, p.expect("]")
+
+	case "time32":
+		unit, err := p.bracketedUnit()
+		if err != nil {
+			return nil, err
+		}
+		if unit != Second && unit != Millisecond {
+			return nil, p.errorf("invalid time unit %v for time32", unit)
+		}
+		return &Time32Type{Unit: unit}, nil
+
+	case "time64":
+		unit, err := p.bracketedUnit()
+		if err != nil {
+			return nil, err
+		}
+		if unit != Microsecond && unit != Nanosecond {
+			return nil, p.errorf("invalid time unit %v for time64", unit)
+		}
+		return &Time64Type{Unit: unit}, nil
+
+	case "duration":
+		unit, err := p.bracketedUnit()
+		if err != nil {
+			return nil, err
+		}
+		return &DurationType{Unit: unit}, nil
+
+	case "timestamp":
+		if err := p.expect("["); err != nil {
+			return nil, err
+		}
+		unit, err := p.unit()
+		if err != nil {
+			return nil, err
+		}
+		dt := &TimestampType{Unit: unit}
+		if p.consume(",") {
+			if err := p.expect("tz="); err != nil {
+				return nil, err
+			}
+			if dt.TimeZone, err = p.until("]"); err != nil {
+				return nil, err
+			}
+		}
+		return dt, p.expect("]")
+
+	case "decimal", "decimal256":
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		prec, err := p.int()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		scale, err := p.int()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		if name == "decimal" {
+			return &Decimal128Type{Precision: int32(prec), Scale: int32(scale)}, nil
+		}
+		return NewDecimal256Type(int32(prec), int32(scale))
+
+	case "list":
+		elem, err := p.listElem()
+		if err != nil {
+			return nil, err
+		}
+		return ListOf(elem), nil
+
+	case "large_list":
+		elem, err := p.listElem()
+		if err != nil {
+			return nil, err
+		}
+		return LargeListOf(elem), nil
+
+	case "fixed_size_list":
+		elem, err := p.listElem()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("["); err != nil {
+			return nil, err
+		}
+		n, err := p.int()
+		if err != nil {
+			return nil, err
+		}
+		if n <= 0 {
+			return nil, p.errorf("invalid fixed size list size %d", n)
+		}
+		return FixedSizeListOf(int32(n), elem), p.expect("]")
+
+	case "run_end_encoded":
+		if err := p.expect("<"); err != nil {
+			return nil, err
+		}
+		if err := p.expect("run_ends:"); err != nil {
+			return nil, err
+		}
+		ends, err := p.parse()
+		if err != nil {
+			return nil, err
+		}
+		if !ValidRunEndsType(ends) {
+			return nil, p.errorf("invalid run ends data type %v", ends)
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		if err := p.expect("values:"); err != nil {
+			return nil, err
+		}
+		values, err := p.parse()
+		if err != nil {
+			return nil, err
+		}
+		return RunEndEncodedOf(ends, values), p.expect(">")
+
+	case "dictionary":
+		if err := p.expect("<"); err != nil {
+			return nil, err
+		}
+		if err := p.expect("values="); err != nil {
+			return nil, err
+		}
+		values, err := p.parse()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		if err := p.expect("indices="); err != nil {
+			return nil, err
+		}
+		indices, err := p.parse()
+		if err != nil {
+			return nil, err
+		}
+		if !ValidDictionaryIndexType(indices) {
+			return nil, p.errorf("invalid dictionary index type %v", indices)
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+		if err := p.expect("ordered="); err != nil {
+			return nil, err
+		}
+		var ordered bool
+		switch v := p.ident(); v {
+		case "true":
+			ordered = true
+		case "false":
+		default:
+			return nil, p.errorf("invalid boolean %q", v)
+		}
+		return &DictionaryType{IndexType: indices, ValueType: values, Ordered: ordered}, p.expect(">")
+
+	case "struct":
+		if err := p.expect("<"); err != nil {
+			return nil, err
+		}
+		var fields []Field
+		for !p.consume(">") {
+			if len(fields) > 0 {
+				if err := p.expect(","); err != nil {
+					return nil, err
+				}
+			}
+			p.skipSpaces()
+			name, err := p.until(":")
+			if err != nil {
+				return nil, err
+			}
+			p.pos++
+			dt, err := p.parse()
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, Field{Name: name, Type: dt, Nullable: true})
+		}
+		for i, f := range fields {
+			for _, o := range fields[:i] {
+				if o.Name == f.Name {
+					return nil, p.errorf("duplicate field with name %q", f.Name)
+				}
+			}
+		}
+		return StructOf(fields...), nil
+
+	case "":
+		return nil, p.errorf("expected a data type")
+	default:
+		return nil, p.errorf("unknown data type %q", name)
+	}
+}
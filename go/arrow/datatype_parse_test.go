@@ -0,0 +1,137 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+func TestTypeFromStringRoundTrip(t *testing.T) {
+	dec256, err := arrow.NewDecimal256Type(76, 38)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, dt := range []arrow.DataType{
+		arrow.Null,
+		arrow.FixedWidthTypes.Boolean,
+		arrow.PrimitiveTypes.Int8,
+		arrow.PrimitiveTypes.Int16,
+		arrow.PrimitiveTypes.Int32,
+		arrow.PrimitiveTypes.Int64,
+		arrow.PrimitiveTypes.Uint8,
+		arrow.PrimitiveTypes.Uint16,
+		arrow.PrimitiveTypes.Uint32,
+		arrow.PrimitiveTypes.Uint64,
+		arrow.FixedWidthTypes.Float16,
+		arrow.PrimitiveTypes.Float32,
+		arrow.PrimitiveTypes.Float64,
+		arrow.PrimitiveTypes.Date32,
+		arrow.PrimitiveTypes.Date64,
+		arrow.FixedWidthTypes.MonthInterval,
+		arrow.FixedWidthTypes.DayTimeInterval,
+		arrow.FixedWidthTypes.Time32s,
+		arrow.FixedWidthTypes.Time32ms,
+		arrow.FixedWidthTypes.Time64us,
+		arrow.FixedWidthTypes.Time64ns,
+		arrow.FixedWidthTypes.Duration_s,
+		arrow.FixedWidthTypes.Duration_ns,
+		arrow.FixedWidthTypes.Timestamp_ms,
+		&arrow.TimestampType{Unit: arrow.Microsecond},
+		&arrow.TimestampType{Unit: arrow.Second, TimeZone: "America/New_York"},
+		&arrow.FixedSizeBinaryType{ByteWidth: 7},
+		&arrow.Decimal128Type{Precision: 10, Scale: 2},
+		dec256,
+		arrow.BinaryTypes.Binary,
+		arrow.BinaryTypes.String,
+		arrow.BinaryTypes.LargeBinary,
+		arrow.BinaryTypes.LargeString,
+		arrow.ListOf(arrow.PrimitiveTypes.Int64),
+		arrow.ListOf(arrow.ListOf(arrow.BinaryTypes.String)),
+		arrow.LargeListOf(arrow.PrimitiveTypes.Float32),
+		arrow.FixedSizeListOf(3, arrow.FixedWidthTypes.Boolean),
+		arrow.RunEndEncodedOf(arrow.PrimitiveTypes.Int32, arrow.BinaryTypes.String),
+		arrow.RunEndEncodedOf(arrow.PrimitiveTypes.Int16, arrow.ListOf(arrow.PrimitiveTypes.Int8)),
+		&arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: arrow.BinaryTypes.String},
+		&arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Uint8, ValueType: arrow.ListOf(arrow.PrimitiveTypes.Int64), Ordered: true},
+		arrow.StructOf(),
+		arrow.StructOf(
+			arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+			arrow.Field{Name: "b c", Type: arrow.ListOf(&arrow.Decimal128Type{Precision: 5, Scale: 1}), Nullable: true},
+			arrow.Field{Name: "d", Type: arrow.StructOf(
+				arrow.Field{Name: "e", Type: arrow.FixedWidthTypes.Timestamp_ns, Nullable: true},
+			), Nullable: true},
+		),
+	} {
+		s := fmt.Sprintf("%v", dt)
+		t.Run(s, func(t *testing.T) {
+			got, err := arrow.TypeFromString(s)
+			if err != nil {
+				t.Fatalf("could not parse type: %+v", err)
+			}
+			if !arrow.TypeEquals(got, dt) {
+				t.Fatalf("invalid type: got=%v, want=%v", got, dt)
+			}
+		})
+	}
+}
+
+func TestTypeFromString(t *testing.T) {
+	for _, tc := range []struct {
+		s    string
+		want arrow.DataType
+		err  string
+	}{
+		{s: "list<int64>", want: arrow.ListOf(arrow.PrimitiveTypes.Int64)},
+		{s: " decimal(10,2) ", want: &arrow.Decimal128Type{Precision: 10, Scale: 2}},
+		{s: "timestamp[ms, tz=UTC]", want: arrow.FixedWidthTypes.Timestamp_ms},
+		{s: "", err: "expected a data type"},
+		{s: "int65", err: `unknown data type "int65"`},
+		{s: "int64 int64", err: "unexpected trailing characters"},
+		{s: "list<int64", err: `expected ">"`},
+		{s: "time32[us]", err: "invalid time unit us for time32"},
+		{s: "time64[s]", err: "invalid time unit s for time64"},
+		{s: "timestamp[h]", err: `invalid time unit "h"`},
+		{s: "fixed_size_binary[]", err: "expected an integer"},
+		{s: "fixed_size_list<item: int32>[0]", err: "invalid fixed size list size 0"},
+		{s: "fixed_size_list<item: int32>[-1]", err: "expected an integer"},
+		{s: "decimal256(77, 2)", err: "invalid decimal256 precision 77"},
+		{s: "run_end_encoded<run_ends: uint32, values: int8>", err: "invalid run ends data type uint32"},
+		{s: "struct<a: int8, a: int8>", err: `duplicate field with name "a"`},
+		{s: "struct<a int8>", err: `expected one of ":"`},
+		{s: "dictionary<values=utf8, indices=float32, ordered=false>", err: "invalid dictionary index type float32"},
+		{s: "dictionary<values=utf8, indices=int8, ordered=maybe>", err: `invalid boolean "maybe"`},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+			got, err := arrow.TypeFromString(tc.s)
+			switch {
+			case tc.err != "":
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+				}
+			case err != nil:
+				t.Fatalf("could not parse type: %+v", err)
+			case !arrow.TypeEquals(got, tc.want):
+				t.Fatalf("invalid type: got=%v, want=%v", got, tc.want)
+			}
+		})
+	}
+}
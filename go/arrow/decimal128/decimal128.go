@@ -16,10 +16,18 @@
 
 package decimal128 // import "github.com/apache/arrow/go/arrow/decimal128"
 
+import (
+	"fmt"
+	"math/big"
+)
+
 var (
 	MaxDecimal128 = New(542101086242752217, 687399551400673280-1)
 )
 
+// MaxPrecision is the maximum number of decimal digits a Num can hold.
+const MaxPrecision = 38
+
 // Num represents a signed 128-bit integer in two's complement.
 // Calculations wrap around and overflow is ignored.
 //
@@ -71,3 +79,37 @@ func (n Num) Sign() int {
 	}
 	return int(1 | (n.hi >> 63))
 }
+
+// BigInt returns the value of n as a big.Int.
+func (n Num) BigInt() *big.Int {
+	v := new(big.Int).SetUint64(uint64(n.hi))
+	v.Lsh(v, 64)
+	v.Or(v, new(big.Int).SetUint64(n.lo))
+	if n.hi < 0 {
+		v.Sub(v, modBig)
+	}
+	return v
+}
+
+var (
+	modBig = new(big.Int).Lsh(big.NewInt(1), 128)
+	maxBig = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	minBig = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+	wordN  = new(big.Int).Lsh(big.NewInt(1), 64)
+)
+
+// FromBigInt returns the value of v as a signed 128-bit integer.
+// FromBigInt returns an error if v does not fit in 128 bits.
+func FromBigInt(v *big.Int) (Num, error) {
+	if v.Cmp(maxBig) > 0 || v.Cmp(minBig) < 0 {
+		return Num{}, fmt.Errorf("arrow/decimal128: value %v overflows 128 bits", v)
+	}
+
+	u := new(big.Int).Set(v)
+	if v.Sign() < 0 {
+		u.Add(u, modBig)
+	}
+	lo := new(big.Int)
+	u.DivMod(u, wordN, lo)
+	return New(int64(u.Uint64()), lo.Uint64()), nil
+}
@@ -92,3 +92,28 @@ func TestFromI64(t *testing.T) {
 }
 
 func u64Cnv(i int64) uint64 { return uint64(i) }
+
+func TestFromBigInt(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	min := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+
+	for _, v := range []*big.Int{max, min, big.NewInt(-3), big.NewInt(0), new(big.Int).Lsh(big.NewInt(5), 70)} {
+		n, err := FromBigInt(v)
+		if err != nil {
+			t.Fatalf("unexpected error for %v: %+v", v, err)
+		}
+		if got := n.BigInt(); got.Cmp(v) != 0 {
+			t.Fatalf("invalid round-trip: got=%v, want=%v", got, v)
+		}
+	}
+
+	if got, want := FromI64(-3).BigInt(), big.NewInt(-3); got.Cmp(want) != 0 {
+		t.Fatalf("invalid value: got=%v, want=%v", got, want)
+	}
+
+	for _, v := range []*big.Int{new(big.Int).Add(max, big.NewInt(1)), new(big.Int).Sub(min, big.NewInt(1))} {
+		if _, err := FromBigInt(v); err == nil {
+			t.Fatalf("expected an overflow error for %v", v)
+		}
+	}
+}
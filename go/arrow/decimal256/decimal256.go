@@ -0,0 +1,230 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package decimal256 provides a signed 256-bit integer type, used to
+// represent the unscaled values of Decimal256 arrays.
+package decimal256 // import "github.com/apache/arrow/go/arrow/decimal256"
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// MaxPrecision is the maximum number of decimal digits a Num can hold.
+const MaxPrecision = 76
+
+// Num represents a signed 256-bit integer in two's complement.
+// Calculations wrap around and overflow is ignored.
+type Num struct {
+	arr [4]uint64 // words, from the least to the most significant.
+}
+
+// New returns a new signed 256-bit integer value from the provided words,
+// given from the most to the least significant.
+func New(w3, w2, w1, w0 uint64) Num {
+	return Num{[4]uint64{w0, w1, w2, w3}}
+}
+
+// FromU64 returns a new signed 256-bit integer value from the provided uint64 one.
+func FromU64(v uint64) Num {
+	return Num{[4]uint64{v, 0, 0, 0}}
+}
+
+// FromI64 returns a new signed 256-bit integer value from the provided int64 one.
+func FromI64(v int64) Num {
+	if v < 0 {
+		return Num{[4]uint64{uint64(v), ^uint64(0), ^uint64(0), ^uint64(0)}}
+	}
+	return FromU64(uint64(v))
+}
+
+// Array returns the words of the two's complement representation of the
+// number, from the least to the most significant.
+func (n Num) Array() [4]uint64 { return n.arr }
+
+// Sign returns:
+//
+// -1 if x <  0
+//  0 if x == 0
+// +1 if x >  0
+func (n Num) Sign() int {
+	if n == (Num{}) {
+		return 0
+	}
+	return int(1 | (int64(n.arr[3]) >> 63))
+}
+
+// Negate returns the opposite of n.
+func (n Num) Negate() Num {
+	for i := range n.arr {
+		n.arr[i] = ^n.arr[i]
+	}
+	return n.Add(FromU64(1))
+}
+
+// Add returns the sum of n and rhs.
+func (n Num) Add(rhs Num) Num {
+	var carry uint64
+	for i := range n.arr {
+		sum := n.arr[i] + rhs.arr[i] + carry
+		switch {
+		case sum < n.arr[i], sum == n.arr[i] && carry == 1:
+			carry = 1
+		default:
+			carry = 0
+		}
+		n.arr[i] = sum
+	}
+	return n
+}
+
+// Sub returns the difference of n and rhs.
+func (n Num) Sub(rhs Num) Num {
+	return n.Add(rhs.Negate())
+}
+
+// BigInt returns the value of n as a big.Int.
+func (n Num) BigInt() *big.Int {
+	v := new(big.Int)
+	for i := len(n.arr) - 1; i >= 0; i-- {
+		v.Lsh(v, 64)
+		v.Or(v, new(big.Int).SetUint64(n.arr[i]))
+	}
+	if n.Sign() < 0 {
+		v.Sub(v, modBig)
+	}
+	return v
+}
+
+var (
+	modBig = new(big.Int).Lsh(big.NewInt(1), 256)
+	maxBig = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+	minBig = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+	wordN  = new(big.Int).Lsh(big.NewInt(1), 64)
+)
+
+// FromBigInt returns the value of v as a signed 256-bit integer.
+// FromBigInt returns an error if v does not fit in 256 bits.
+func FromBigInt(v *big.Int) (Num, error) {
+	if v.Cmp(maxBig) > 0 || v.Cmp(minBig) < 0 {
+		return Num{}, fmt.Errorf("arrow/decimal256: value %v overflows 256 bits", v)
+	}
+
+	var n Num
+	u := new(big.Int).Set(v)
+	if v.Sign() < 0 {
+		u.Add(u, modBig)
+	}
+	word := new(big.Int)
+	for i := range n.arr {
+		u.DivMod(u, wordN, word)
+		n.arr[i] = word.Uint64()
+	}
+	return n, nil
+}
+
+// FromString parses the decimal representation s, such as "-123.45",
+// into the unscaled value of a decimal with the provided precision and scale,
+// i.e. the value of s multiplied by 10^scale.
+//
+// FromString returns an error if s is not a valid decimal number, if s has
+// more fractional digits than scale, or if the unscaled value has more than
+// precision digits.
+func FromString(s string, precision, scale int32) (Num, error) {
+	if precision < 1 || precision > MaxPrecision {
+		return Num{}, fmt.Errorf("arrow/decimal256: invalid precision %d", precision)
+	}
+
+	str := s
+	neg := false
+	switch {
+	case strings.HasPrefix(str, "-"):
+		neg = true
+		str = str[1:]
+	case strings.HasPrefix(str, "+"):
+		str = str[1:]
+	}
+
+	ipart, fpart := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		ipart, fpart = str[:i], str[i+1:]
+	}
+	if ipart == "" && fpart == "" || !isDigits(ipart) || !isDigits(fpart) {
+		return Num{}, fmt.Errorf("arrow/decimal256: invalid decimal string %q", s)
+	}
+
+	if scale >= 0 {
+		if int32(len(fpart)) > scale {
+			return Num{}, fmt.Errorf("arrow/decimal256: value %q has more than %d fractional digits", s, scale)
+		}
+		fpart += strings.Repeat("0", int(scale)-len(fpart))
+	} else {
+		// negative scales drop trailing integer digits, which must be zeros.
+		drop := int(-scale)
+		if strings.Trim(fpart, "0") != "" || len(ipart) < drop || strings.Trim(ipart[len(ipart)-drop:], "0") != "" {
+			return Num{}, fmt.Errorf("arrow/decimal256: value %q is not representable with scale %d", s, scale)
+		}
+		ipart, fpart = ipart[:len(ipart)-drop], ""
+	}
+
+	digits := strings.TrimLeft(ipart+fpart, "0")
+	if int32(len(digits)) > precision {
+		return Num{}, fmt.Errorf("arrow/decimal256: value %q overflows precision %d", s, precision)
+	}
+	if digits == "" {
+		return Num{}, nil
+	}
+
+	v, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		v.Neg(v)
+	}
+	return FromBigInt(v)
+}
+
+// ToString returns the decimal representation of n, interpreted as the
+// unscaled value of a decimal with the provided scale.
+func (n Num) ToString(scale int32) string {
+	v := n.BigInt()
+	neg := v.Sign() < 0
+	digits := v.Abs(v).String()
+
+	switch {
+	case scale < 0:
+		digits += strings.Repeat("0", int(-scale))
+	case scale > 0:
+		if pad := int(scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		i := len(digits) - int(scale)
+		digits = digits[:i] + "." + digits[i:]
+	}
+
+	if neg {
+		return "-" + digits
+	}
+	return digits
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,161 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package decimal256 // import "github.com/apache/arrow/go/arrow/decimal256"
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestFromI64(t *testing.T) {
+	for _, tc := range []struct {
+		v    int64
+		want Num
+		sign int
+	}{
+		{0, Num{}, 0},
+		{1, New(0, 0, 0, 1), 1},
+		{math.MaxInt64, New(0, 0, 0, math.MaxInt64), 1},
+		{-1, New(math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64), -1},
+		{math.MinInt64, New(math.MaxUint64, math.MaxUint64, math.MaxUint64, 1<<63), -1},
+	} {
+		v := FromI64(tc.v)
+		if got, want := v, tc.want; got != want {
+			t.Fatalf("invalid value for %d: got=%#x, want=%#x", tc.v, got.Array(), want.Array())
+		}
+		if got, want := v.Sign(), tc.sign; got != want {
+			t.Fatalf("invalid sign for %d: got=%d, want=%d", tc.v, got, want)
+		}
+		if got, want := v.BigInt(), big.NewInt(tc.v); got.Cmp(want) != 0 {
+			t.Fatalf("invalid big-int for %d: got=%v, want=%v", tc.v, got, want)
+		}
+	}
+}
+
+func TestAddNegate(t *testing.T) {
+	max := FromU64(math.MaxUint64)
+	if got, want := max.Add(FromU64(1)), New(0, 0, 1, 0); got != want {
+		t.Fatalf("invalid carry: got=%#x, want=%#x", got.Array(), want.Array())
+	}
+
+	for _, tc := range []struct{ a, b int64 }{
+		{1, 2}, {-1, 1}, {-5, -7}, {math.MaxInt64, math.MaxInt64}, {math.MinInt64, -1},
+	} {
+		got := FromI64(tc.a).Add(FromI64(tc.b)).BigInt()
+		want := new(big.Int).Add(big.NewInt(tc.a), big.NewInt(tc.b))
+		if got.Cmp(want) != 0 {
+			t.Fatalf("%d+%d: got=%v, want=%v", tc.a, tc.b, got, want)
+		}
+
+		got = FromI64(tc.a).Sub(FromI64(tc.b)).BigInt()
+		want = new(big.Int).Sub(big.NewInt(tc.a), big.NewInt(tc.b))
+		if got.Cmp(want) != 0 {
+			t.Fatalf("%d-%d: got=%v, want=%v", tc.a, tc.b, got, want)
+		}
+
+		if got, want := FromI64(tc.a).Negate().Negate(), FromI64(tc.a); got != want {
+			t.Fatalf("invalid double negation of %d", tc.a)
+		}
+	}
+
+	if got, want := FromI64(42).Negate(), FromI64(-42); got != want {
+		t.Fatalf("invalid negation: got=%#x, want=%#x", got.Array(), want.Array())
+	}
+}
+
+func TestFromString(t *testing.T) {
+	for _, tc := range []struct {
+		s     string
+		prec  int32
+		scale int32
+		want  string // unscaled value
+		str   string
+		err   bool
+	}{
+		{s: "123.45", prec: 5, scale: 2, want: "12345", str: "123.45"},
+		{s: "-123.45", prec: 10, scale: 3, want: "-123450", str: "-123.450"},
+		{s: "+0.5", prec: 3, scale: 2, want: "50", str: "0.50"},
+		{s: ".5", prec: 3, scale: 1, want: "5", str: "0.5"},
+		{s: "-0.05", prec: 3, scale: 2, want: "-5", str: "-0.05"},
+		{s: "7", prec: 1, scale: 0, want: "7", str: "7"},
+		{s: "0", prec: 1, scale: 4, want: "0", str: "0.0000"},
+		{s: "1200", prec: 2, scale: -2, want: "12", str: "1200"},
+		{
+			s:     "9999999999999999999999999999999999999999999999999999999999999999999999999999",
+			prec:  76,
+			scale: 0,
+			want:  "9999999999999999999999999999999999999999999999999999999999999999999999999999",
+			str:   "9999999999999999999999999999999999999999999999999999999999999999999999999999",
+		},
+		{
+			s:     "-99999999999999999999999999999999999999.99999999999999999999999999999999999999",
+			prec:  76,
+			scale: 38,
+			want:  "-9999999999999999999999999999999999999999999999999999999999999999999999999999",
+			str:   "-99999999999999999999999999999999999999.99999999999999999999999999999999999999",
+		},
+		{s: "123.456", prec: 6, scale: 2, err: true},
+		{s: "123.45", prec: 4, scale: 2, err: true},
+		{s: "1230", prec: 4, scale: -2, err: true},
+		{s: "12a", prec: 4, scale: 0, err: true},
+		{s: "", prec: 4, scale: 0, err: true},
+		{s: "-", prec: 4, scale: 0, err: true},
+		{s: ".", prec: 4, scale: 0, err: true},
+		{s: "1", prec: 77, scale: 0, err: true},
+	} {
+		t.Run(tc.s, func(t *testing.T) {
+			v, err := FromString(tc.s, tc.prec, tc.scale)
+			switch {
+			case tc.err && err == nil:
+				t.Fatalf("expected an error")
+			case tc.err:
+				return
+			case err != nil:
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if got, want := v.BigInt().String(), tc.want; got != want {
+				t.Fatalf("invalid unscaled value: got=%s, want=%s", got, want)
+			}
+			if got, want := v.ToString(tc.scale), tc.str; got != want {
+				t.Fatalf("invalid string: got=%s, want=%s", got, want)
+			}
+		})
+	}
+}
+
+func TestFromBigInt(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+	min := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+
+	for _, v := range []*big.Int{max, min, big.NewInt(-3)} {
+		n, err := FromBigInt(v)
+		if err != nil {
+			t.Fatalf("unexpected error for %v: %+v", v, err)
+		}
+		if got := n.BigInt(); got.Cmp(v) != 0 {
+			t.Fatalf("invalid round-trip: got=%v, want=%v", got, v)
+		}
+	}
+
+	for _, v := range []*big.Int{new(big.Int).Add(max, big.NewInt(1)), new(big.Int).Sub(min, big.NewInt(1))} {
+		if _, err := FromBigInt(v); err == nil {
+			t.Fatalf("expected an overflow error for %v", v)
+		}
+	}
+}
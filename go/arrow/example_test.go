@@ -72,6 +72,36 @@ func Example_minimal() {
 	// ints = [1 2 3 (null) 5 6 7 8]
 }
 
+// This example demonstrates how to fill a builder in a tight loop, reserving
+// the needed capacity once and then appending with UnsafeAppend, which skips
+// the capacity checks performed by Append.
+func Example_unsafeAppend() {
+	pool := memory.NewGoAllocator()
+
+	builder := array.NewInt64Builder(pool)
+	defer builder.Release()
+
+	const n = 8
+
+	// Reserve room for all the elements: UnsafeAppend must never go past it.
+	builder.Reserve(n)
+	for i := 0; i < n; i++ {
+		if i%4 == 3 {
+			builder.UnsafeAppendBoolToBitmap(false)
+			continue
+		}
+		builder.UnsafeAppend(int64(i))
+	}
+
+	ints := builder.NewInt64Array()
+	defer ints.Release()
+
+	fmt.Printf("ints = %v\n", ints)
+
+	// Output:
+	// ints = [0 1 2 (null) 4 5 6 (null)]
+}
+
 // This example demonstrates creating an array, sourcing the values and
 // null bitmaps directly from byte slices. The null count is set to
 // UnknownNullCount, instructing the array to calculate the
@@ -204,6 +234,100 @@ func Example_listArray() {
 	// List      = [[0 1 2] (null) [3] [4 5] [6 7 8] (null) [9]]
 }
 
+// This example shows how to flatten the List array of Example_listArray,
+// and how to retrieve the list element of each flattened value.
+func Example_listFlatten() {
+	pool := memory.NewGoAllocator()
+
+	lb := array.NewListBuilder(pool, arrow.PrimitiveTypes.Int64)
+	defer lb.Release()
+
+	vb := lb.ValueBuilder().(*array.Int64Builder)
+	for _, vs := range [][]int64{{0, 1, 2}, nil, {3}, {4, 5}, {6, 7, 8}, nil, {9}} {
+		if vs == nil {
+			lb.AppendNull()
+			continue
+		}
+		lb.Append(true)
+		vb.AppendValues(vs, nil)
+	}
+
+	arr := lb.NewArray().(*array.List)
+	defer arr.Release()
+
+	flat, err := array.ListFlatten(arr, pool)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer flat.Release()
+
+	parents := array.ListParentIndices(arr)
+	defer parents.Release()
+
+	fmt.Printf("List    = %v\n", arr)
+	fmt.Printf("Flatten = %v\n", flat)
+	fmt.Printf("Parents = %v\n", parents)
+
+	// Output:
+	// List    = [[0 1 2] (null) [3] [4 5] [6 7 8] (null) [9]]
+	// Flatten = [0 1 2 3 4 5 6 7 8 9]
+	// Parents = [0 0 0 2 3 3 4 4 4 6]
+}
+
+// This example shows how to access the elements of a sliced List array.
+// The sliced array should be:
+//  [[3], [4, 5], [6, 7, 8], (null)]
+func Example_listArraySlice() {
+	pool := memory.NewGoAllocator()
+
+	lb := array.NewListBuilder(pool, arrow.PrimitiveTypes.Int64)
+	defer lb.Release()
+
+	vb := lb.ValueBuilder().(*array.Int64Builder)
+	vb.Reserve(10)
+
+	lb.Append(true)
+	vb.AppendValues([]int64{0, 1, 2}, nil)
+	lb.AppendNull()
+	lb.Append(true)
+	vb.Append(3)
+	lb.Append(true)
+	vb.AppendValues([]int64{4, 5}, nil)
+	lb.Append(true)
+	vb.AppendValues([]int64{6, 7, 8}, nil)
+	lb.AppendNull()
+	lb.Append(true)
+	vb.Append(9)
+
+	arr := lb.NewArray().(*array.List)
+	defer arr.Release()
+
+	slice := array.NewSlice(arr, 2, 6).(*array.List)
+	defer slice.Release()
+
+	fmt.Printf("Len()        = %d\n", slice.Len())
+	fmt.Printf("ListValues() = %v\n", slice.ListValues())
+	for i := 0; i < slice.Len(); i++ {
+		if !slice.IsValid(i) {
+			fmt.Printf("List[%d]      = (null)\n", i)
+			continue
+		}
+		v := slice.ValueSlice(i)
+		fmt.Printf("List[%d]      = %v\n", i, v)
+		v.Release()
+	}
+	fmt.Printf("List         = %v\n", slice)
+
+	// Output:
+	// Len()        = 4
+	// ListValues() = [0 1 2 3 4 5 6 7 8 9]
+	// List[0]      = [3]
+	// List[1]      = [4 5]
+	// List[2]      = [6 7 8]
+	// List[3]      = (null)
+	// List         = [[3] [4 5] [6 7 8] (null)]
+}
+
 // This example shows how to create a FixedSizeList array.
 // The resulting array should be:
 //  [[0, 1, 2], (null), [3, 4, 5], [6, 7, 8], (null)]
@@ -224,7 +348,6 @@ func Example_fixedSizeListArray() {
 	vb.Append(2)
 
 	lb.AppendNull()
-	vb.AppendValues([]int64{-1, -1, -1}, nil)
 
 	lb.Append(true)
 	vb.Append(3)
@@ -21,6 +21,8 @@ go 1.12
 require (
 	github.com/davecgh/go-spew v1.1.0 // indirect
 	github.com/google/flatbuffers v1.11.0
+	github.com/klauspost/compress v1.13.1
+	github.com/pierrec/lz4/v4 v4.1.8
 	github.com/pkg/errors v0.8.1
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/stretchr/testify v1.2.0
@@ -0,0 +1,78 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"fmt"
+	"hash/crc32"
+	"strconv"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/pkg/errors"
+)
+
+// checksumKey is the message custom metadata key holding the CRC32C of the
+// body of a record batch, as written with WithValidation.
+const checksumKey = "arrow-go:crc32c"
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// bodyChecksum returns the CRC32C of the provided buffers, concatenated in
+// order and padded exactly like they are written by writeIPCPayload.
+func bodyChecksum(body []*memory.Buffer) uint32 {
+	var crc uint32
+	for _, buf := range body {
+		if buf == nil || buf.Len() == 0 {
+			continue
+		}
+		size := int64(buf.Len())
+		crc = crc32.Update(crc, castagnoli, buf.Bytes())
+		crc = crc32.Update(crc, castagnoli, paddingBytes[:bitutil.CeilByte64(size)-size])
+	}
+	return crc
+}
+
+func checksumMetadata(crc uint32) arrow.Metadata {
+	return arrow.NewMetadata([]string{checksumKey}, []string{fmt.Sprintf("%08x", crc)})
+}
+
+// verifyChecksum checks the body of the record batch message msg against the
+// checksum stored in its custom metadata.
+func verifyChecksum(msg *Message) error {
+	md, err := metadataFromFB(msg.msg)
+	if err != nil {
+		return err
+	}
+
+	i := md.FindKey(checksumKey)
+	if i < 0 {
+		return errors.Errorf("arrow/ipc: missing record batch checksum")
+	}
+
+	want, err := strconv.ParseUint(md.Values()[i], 16, 32)
+	if err != nil {
+		return errors.Wrapf(err, "arrow/ipc: invalid record batch checksum %q", md.Values()[i])
+	}
+
+	got := crc32.Checksum(msg.body.Bytes(), castagnoli)
+	if got != uint32(want) {
+		return errors.Errorf("arrow/ipc: record batch checksum mismatch (got=%08x, want=%08x): body corrupted", got, want)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc // import "github.com/apache/arrow/go/arrow/ipc"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/apache/arrow/go/arrow/internal/flatbuf"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/pkg/errors"
+)
+
+// CompressionCodec is the codec compressing the buffers of the bodies of the
+// record batches written with WithCompression.
+type CompressionCodec int
+
+const (
+	// Uncompressed writes the buffers as they are. This is the default.
+	Uncompressed CompressionCodec = iota
+	// LZ4Frame compresses each buffer as an LZ4 frame.
+	LZ4Frame
+	// Zstd compresses each buffer with Zstandard.
+	Zstd
+)
+
+func (c CompressionCodec) String() string {
+	switch c {
+	case Uncompressed:
+		return "Uncompressed"
+	case LZ4Frame:
+		return "LZ4Frame"
+	case Zstd:
+		return "Zstd"
+	}
+	return fmt.Sprintf("CompressionCodec(%d)", int(c))
+}
+
+// kUncompressedLen is the uncompressed length prefix of a buffer written as
+// is, which compression did not shrink.
+const kUncompressedLen = -1
+
+// bufferCodec compresses and decompresses the buffers of a message body.
+type bufferCodec interface {
+	// kind returns the compression type recorded in the message metadata.
+	kind() flatbuf.CompressionType
+	// compress returns the compressed bytes of src.
+	compress(src []byte) ([]byte, error)
+	// decompress decompresses src into dst, which has the uncompressed length.
+	decompress(dst, src []byte) error
+}
+
+func newBufferCodec(c CompressionCodec) bufferCodec {
+	switch c {
+	case LZ4Frame:
+		return lz4Codec{}
+	case Zstd:
+		return zstdCodec{}
+	}
+	return nil
+}
+
+// codecFromFB returns the codec of the compressed message body described by
+// md, or nil when the body is not compressed.
+func codecFromFB(md *flatbuf.RecordBatch) (bufferCodec, error) {
+	var body flatbuf.BodyCompression
+	if md.Compression(&body) == nil {
+		return nil, nil
+	}
+	if m := body.Method(); m != flatbuf.BodyCompressionMethodBUFFER {
+		return nil, errors.Errorf("arrow/ipc: unsupported body compression method %d", m)
+	}
+	switch body.Codec() {
+	case flatbuf.CompressionTypeLZ4_FRAME:
+		return lz4Codec{}, nil
+	case flatbuf.CompressionTypeZSTD:
+		return zstdCodec{}, nil
+	}
+	return nil, errors.Errorf("arrow/ipc: unsupported body compression codec %d", body.Codec())
+}
+
+type lz4Codec struct{}
+
+func (lz4Codec) kind() flatbuf.CompressionType { return flatbuf.CompressionTypeLZ4_FRAME }
+
+func (lz4Codec) compress(src []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := lz4.NewWriter(&buf)
+	if _, err := w.Write(src); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (lz4Codec) decompress(dst, src []byte) error {
+	_, err := io.ReadFull(lz4.NewReader(bytes.NewReader(src)), dst)
+	return err
+}
+
+// the zstd encoder is safe for concurrent use by EncodeAll, and is shared by
+// all the writers.
+var zstdEncoder struct {
+	once sync.Once
+	enc  *zstd.Encoder
+	err  error
+}
+
+type zstdCodec struct{}
+
+func (zstdCodec) kind() flatbuf.CompressionType { return flatbuf.CompressionTypeZSTD }
+
+func (zstdCodec) compress(src []byte) ([]byte, error) {
+	zstdEncoder.once.Do(func() {
+		zstdEncoder.enc, zstdEncoder.err = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	})
+	if zstdEncoder.err != nil {
+		return nil, zstdEncoder.err
+	}
+	return zstdEncoder.enc.EncodeAll(src, nil), nil
+}
+
+func (zstdCodec) decompress(dst, src []byte) error {
+	// src may be followed by the padding of the buffer, which DecodeAll would
+	// try to decode as another frame: only read the uncompressed length.
+	dec, err := zstd.NewReader(bytes.NewReader(src), zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return err
+	}
+	defer dec.Close()
+	_, err = io.ReadFull(dec, dst)
+	return err
+}
+
+// compressBody replaces the buffers of body with their compressed bytes,
+// prefixed with their uncompressed length as a 64-bit little-endian integer.
+// Empty buffers are left as they are.
+func compressBody(codec bufferCodec, body []*memory.Buffer) error {
+	for i, buf := range body {
+		if buf == nil || buf.Len() == 0 {
+			continue
+		}
+		raw := buf.Bytes()
+		data, err := codec.compress(raw)
+		if err != nil {
+			return errors.Wrapf(err, "arrow/ipc: could not compress buffer %d", i)
+		}
+
+		size := int64(len(raw))
+		if len(data) >= len(raw) {
+			// not worth it: store the buffer as is.
+			data, size = raw, kUncompressedLen
+		}
+		out := make([]byte, 8+len(data))
+		binary.LittleEndian.PutUint64(out, uint64(size))
+		copy(out[8:], data)
+
+		buf.Release()
+		body[i] = memory.NewBufferBytes(out)
+	}
+	return nil
+}
+
+// decompressBuffer returns the uncompressed bytes of the compressed buffer
+// buf, as written by compressBody.
+func decompressBuffer(codec bufferCodec, buf []byte) ([]byte, error) {
+	if len(buf) < 8 {
+		return nil, errors.Errorf("arrow/ipc: compressed buffer too short (%d bytes)", len(buf))
+	}
+	size := int64(binary.LittleEndian.Uint64(buf))
+	switch {
+	case size == kUncompressedLen:
+		return buf[8:], nil
+	case size < 0:
+		return nil, errors.Errorf("arrow/ipc: invalid uncompressed buffer length %d", size)
+	case size == 0:
+		return nil, nil
+	}
+	out := make([]byte, size)
+	if err := codec.decompress(out, buf[8:]); err != nil {
+		return nil, errors.Wrap(err, "arrow/ipc: could not decompress buffer")
+	}
+	return out, nil
+}
@@ -0,0 +1,217 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+var codecs = []ipc.CompressionCodec{ipc.LZ4Frame, ipc.Zstd}
+
+func writeStream(t *testing.T, schema *arrow.Schema, recs []array.Record, opts ...ipc.Option) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, append(opts, ipc.WithSchema(schema))...)
+	for i, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("could not write record[%d]: %v", i, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func writeFile(t *testing.T, schema *arrow.Schema, recs []array.Record, opts ...ipc.Option) []byte {
+	t.Helper()
+	f, err := ioutil.TempFile("", "arrow-ipc-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	defer os.Remove(f.Name())
+
+	w, err := ipc.NewFileWriter(f, append(opts, ipc.WithSchema(schema))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("could not write record[%d]: %v", i, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func checkStream(t *testing.T, raw []byte, mem memory.Allocator, recs []array.Record) {
+	t.Helper()
+	r, err := ipc.NewReader(bytes.NewReader(raw), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	n := 0
+	for r.Next() {
+		if n >= len(recs) {
+			t.Fatalf("too many records: got=%d, want=%d", n+1, len(recs))
+		}
+		if rec := r.Record(); !array.RecordEqual(rec, recs[n]) {
+			t.Fatalf("invalid record[%d]:\ngot= %v\nwant=%v", n, rec, recs[n])
+		}
+		n++
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("could not read stream: %v", err)
+	}
+	if n != len(recs) {
+		t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+	}
+}
+
+func checkFile(t *testing.T, raw []byte, mem memory.Allocator, recs []array.Record) {
+	t.Helper()
+	r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	if got, want := r.NumRecords(), len(recs); got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+	for i := range recs {
+		rec, err := r.Record(i)
+		if err != nil {
+			t.Fatalf("could not read record[%d]: %v", i, err)
+		}
+		if !array.RecordEqual(rec, recs[i]) {
+			t.Fatalf("invalid record[%d]:\ngot= %v\nwant=%v", i, rec, recs[i])
+		}
+	}
+}
+
+func TestCompressionRoundTrip(t *testing.T) {
+	for _, codec := range codecs {
+		t.Run(codec.String(), func(t *testing.T) {
+			for name, recs := range arrdata.Records {
+				t.Run(name, func(t *testing.T) {
+					mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+					defer mem.AssertSize(t, 0)
+
+					schema := recs[0].Schema()
+					opts := []ipc.Option{ipc.WithAllocator(mem), ipc.WithCompression(codec)}
+					checkStream(t, writeStream(t, schema, recs, opts...), mem, recs)
+					checkFile(t, writeFile(t, schema, recs, opts...), mem, recs)
+				})
+			}
+
+			t.Run("dictionaries", func(t *testing.T) {
+				mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+				defer mem.AssertSize(t, 0)
+
+				schema, recs := makeDictRecords(t, mem)
+				defer func() {
+					for _, rec := range recs {
+						rec.Release()
+					}
+				}()
+
+				opts := []ipc.Option{ipc.WithAllocator(mem), ipc.WithCompression(codec)}
+				checkStream(t, writeStream(t, schema, recs, opts...), mem, recs)
+				checkFile(t, writeFile(t, schema, recs[:1], opts...), mem, recs[:1])
+			})
+		})
+	}
+}
+
+func TestCompressedFileEqualsUncompressed(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	// a column compressing well, so that its buffers are stored compressed.
+	schema := arrow.NewSchema([]arrow.Field{{Name: "i64", Type: arrow.PrimitiveTypes.Int64, Nullable: true}}, nil)
+	bldr := array.NewRecordBuilder(mem, schema)
+	defer bldr.Release()
+
+	ib := bldr.Field(0).(*array.Int64Builder)
+	for i := 0; i < 10000; i++ {
+		if i%7 == 0 {
+			ib.AppendNull()
+			continue
+		}
+		ib.Append(int64(i % 100))
+	}
+	rec := bldr.NewRecord()
+	defer rec.Release()
+
+	recs := []array.Record{rec}
+	want := writeFile(t, schema, recs, ipc.WithAllocator(mem))
+	if got := writeFile(t, schema, recs, ipc.WithAllocator(mem), ipc.WithCompression(ipc.Uncompressed)); !bytes.Equal(got, want) {
+		t.Fatalf("uncompressed file differs from the default output")
+	}
+
+	read := func(raw []byte) array.Record {
+		r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer r.Close()
+		rec, err := r.Record(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec.Retain()
+		return rec
+	}
+
+	plain := read(want)
+	defer plain.Release()
+
+	for _, codec := range codecs {
+		t.Run(codec.String(), func(t *testing.T) {
+			raw := writeFile(t, schema, recs, ipc.WithAllocator(mem), ipc.WithCompression(codec))
+			if got, max := len(raw), len(want); got >= max {
+				t.Fatalf("compressed file not smaller: got=%d bytes, want<%d", got, max)
+			}
+
+			rec := read(raw)
+			defer rec.Release()
+			if !array.RecordEqual(rec, plain) {
+				t.Fatalf("invalid record:\ngot= %v\nwant=%v", rec, plain)
+			}
+		})
+	}
+}
@@ -28,6 +28,11 @@ type dictTypeMap map[int64]arrow.Field
 type dictMemo struct {
 	dict2id map[array.Interface]int64
 	id2dict dictMap // map of dictionary ID to dictionary array
+
+	// fields holds the dictionary IDs of the dictionary-encoded fields of a
+	// schema, in depth-first order: the order in which their arrays are
+	// written in record batches.
+	fields []int64
 }
 
 func newMemo() dictMemo {
@@ -83,3 +88,31 @@ func (memo *dictMemo) Add(id int64, v array.Interface) {
 	memo.id2dict[id] = v
 	memo.dict2id[v] = id
 }
+
+// Set sets the dictionary with the provided id to v, replacing and
+// releasing the previous one, if any.
+func (memo *dictMemo) Set(id int64, v array.Interface) {
+	if old, ok := memo.id2dict[id]; ok {
+		delete(memo.dict2id, old)
+		old.Release()
+	}
+	v.Retain()
+	memo.id2dict[id] = v
+	memo.dict2id[v] = id
+}
+
+// addField assigns the next dictionary ID to a dictionary-encoded field
+// and returns it.
+func (memo *dictMemo) addField() int64 {
+	id := int64(len(memo.fields))
+	memo.fields = append(memo.fields, id)
+	return id
+}
+
+// fieldID returns the dictionary ID of the i-th dictionary-encoded field.
+func (memo *dictMemo) fieldID(i int) (int64, error) {
+	if i >= len(memo.fields) {
+		return 0, errors.Errorf("arrow/ipc: no dictionary ID for dictionary-encoded field %d", i)
+	}
+	return memo.fields[i], nil
+}
@@ -0,0 +1,233 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// makeDictRecords returns records with a dictionary-encoded column and a list
+// of dictionary-encoded values. The first two records have equal dictionaries,
+// the dictionaries of the third one differ.
+func makeDictRecords(t *testing.T, mem memory.Allocator) (*arrow.Schema, []array.Record) {
+	colorType := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Uint8, ValueType: arrow.BinaryTypes.String}
+	codeType := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: arrow.PrimitiveTypes.Int64}
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "color", Type: colorType, Nullable: true},
+		{Name: "codes", Type: arrow.ListOf(codeType), Nullable: true},
+	}, nil)
+
+	colors := [][]interface{}{
+		{"red", "green", nil, "red"},
+		{"red", "green", "red", nil},
+		{"blue", nil, "red", "blue"},
+	}
+	codes := [][][]interface{}{
+		{{int64(1), int64(2)}, nil, {}, {int64(2)}},
+		{{int64(1)}, {int64(2), nil}, nil, {int64(1)}},
+		{{int64(2)}, {int64(1), int64(2)}, {}, nil},
+	}
+
+	bldr := array.NewRecordBuilder(mem, schema)
+	defer bldr.Release()
+
+	cb := bldr.Field(0).(*array.DictionaryBuilder)
+	lb := bldr.Field(1).(*array.ListBuilder)
+	vb := lb.ValueBuilder().(*array.DictionaryBuilder)
+
+	recs := make([]array.Record, len(colors))
+	for i := range recs {
+		for _, v := range colors[i] {
+			if v == nil {
+				cb.AppendNull()
+				continue
+			}
+			if err := cb.AppendValue(v); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, vs := range codes[i] {
+			if vs == nil {
+				lb.AppendNull()
+				continue
+			}
+			lb.Append(true)
+			for _, v := range vs {
+				if v == nil {
+					vb.AppendNull()
+					continue
+				}
+				if err := vb.AppendValue(v); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		recs[i] = bldr.NewRecord()
+	}
+	return schema, recs
+}
+
+func TestStreamDictionary(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema, recs := makeDictRecords(t, mem)
+	defer func() {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}()
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	for i, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("could not write record[%d]: %v", i, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// dictionaries are only written when they change.
+	var (
+		msgs []ipc.MessageType
+		mr   = ipc.NewMessageReader(bytes.NewReader(buf.Bytes()))
+	)
+	defer mr.Release()
+	for {
+		msg, err := mr.Message()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		msgs = append(msgs, msg.Type())
+	}
+	want := []ipc.MessageType{
+		ipc.MessageSchema,
+		ipc.MessageDictionaryBatch, ipc.MessageDictionaryBatch, ipc.MessageRecordBatch,
+		ipc.MessageRecordBatch,
+		ipc.MessageDictionaryBatch, ipc.MessageDictionaryBatch, ipc.MessageRecordBatch,
+	}
+	if got := msgs; !equalMessageTypes(got, want) {
+		t.Fatalf("invalid messages:\ngot= %v\nwant=%v", got, want)
+	}
+
+	r, err := ipc.NewReader(bytes.NewReader(buf.Bytes()), ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	n := 0
+	for r.Next() {
+		if n >= len(recs) {
+			t.Fatalf("too many records")
+		}
+		if got, want := r.Record(), recs[n]; !array.RecordEqual(got, want) {
+			t.Fatalf("invalid record[%d]:\ngot= %v\nwant=%v", n, got, want)
+		}
+		n++
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != len(recs) {
+		t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+	}
+}
+
+func TestFileDictionary(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema, recs := makeDictRecords(t, mem)
+	defer func() {
+		for _, rec := range recs {
+			rec.Release()
+		}
+	}()
+
+	f, err := ioutil.TempFile("", "arrow-ipc-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	defer os.Remove(f.Name())
+
+	w, err := ipc.NewFileWriter(f, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, rec := range recs[:2] {
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("could not write record[%d]: %v", i, err)
+		}
+	}
+
+	// the file format does not allow replacing a dictionary.
+	err = w.Write(recs[2])
+	if err == nil || !strings.Contains(err.Error(), "dictionary replacement not supported") {
+		t.Fatalf("invalid error: got=%v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewFileReader(f, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	if got, want := r.NumRecords(), 2; got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+	for i := 0; i < r.NumRecords(); i++ {
+		rec, err := r.Record(i)
+		if err != nil {
+			t.Fatalf("could not read record[%d]: %v", i, err)
+		}
+		if !array.RecordEqual(rec, recs[i]) {
+			t.Fatalf("invalid record[%d]:\ngot= %v\nwant=%v", i, rec, recs[i])
+		}
+	}
+}
+
+func equalMessageTypes(a, b []ipc.MessageType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -36,6 +36,7 @@ type FileReader struct {
 
 	footer struct {
 		offset int64
+		start  int64 // offset of the footer data, where the blocks section ends.
 		buffer *memory.Buffer
 		data   *flatbuf.Footer
 	}
@@ -48,6 +49,8 @@ type FileReader struct {
 
 	irec int   // current record index. used for the arrio.Reader interface
 	err  error // last error
+
+	validate bool // whether to verify the checksum of records
 }
 
 // NewFileReader opens an Arrow file using the provided reader r.
@@ -57,9 +60,10 @@ func NewFileReader(r ReadAtSeeker, opts ...Option) (*FileReader, error) {
 		err error
 
 		f = FileReader{
-			r:      r,
-			fields: make(dictTypeMap),
-			memo:   newMemo(),
+			r:        r,
+			fields:   make(dictTypeMap),
+			memo:     newMemo(),
+			validate: cfg.validate,
 		}
 	)
 
@@ -88,6 +92,23 @@ func NewFileReader(r ReadAtSeeker, opts ...Option) (*FileReader, error) {
 	return &f, err
 }
 
+// NewMappedFileReader opens an Arrow file whose content is held in memory,
+// such as a memory-mapped file.
+//
+// Records and dictionaries read from the returned reader reference data
+// directly instead of copying it: data must not be modified or unmapped
+// while they are in use.
+func NewMappedFileReader(data []byte, opts ...Option) (*FileReader, error) {
+	return NewFileReader(&mappedFile{Reader: bytes.NewReader(data), data: data}, opts...)
+}
+
+// mappedFile is an in-memory Arrow file, whose sections are sliced
+// instead of read.
+type mappedFile struct {
+	*bytes.Reader
+	data []byte
+}
+
 func (f *FileReader) readFooter() error {
 	var err error
 
@@ -95,9 +116,18 @@ func (f *FileReader) readFooter() error {
 		return fmt.Errorf("arrow/ipc: file too small (size=%d)", f.footer.offset)
 	}
 
+	buf := make([]byte, len(Magic))
+	n, err := f.r.ReadAt(buf, 0)
+	if err != nil {
+		return errors.Wrap(err, "arrow/ipc: could not read file header")
+	}
+	if n != len(buf) || !bytes.Equal(buf, Magic) {
+		return errNotArrowFile
+	}
+
 	eof := int64(len(Magic) + 4)
-	buf := make([]byte, eof)
-	n, err := f.r.ReadAt(buf, f.footer.offset-eof)
+	buf = make([]byte, eof)
+	n, err = f.r.ReadAt(buf, f.footer.offset-eof)
 	if err != nil {
 		return errors.Wrap(err, "arrow/ipc: could not read footer")
 	}
@@ -114,8 +144,9 @@ func (f *FileReader) readFooter() error {
 		return errInconsistentFileMetadata
 	}
 
+	f.footer.start = f.footer.offset - size - eof
 	buf = make([]byte, size)
-	n, err = f.r.ReadAt(buf, f.footer.offset-size-eof)
+	n, err = f.r.ReadAt(buf, f.footer.start)
 	if err != nil {
 		return errors.Wrap(err, "arrow/ipc: could not read footer data")
 	}
@@ -140,13 +171,8 @@ func (f *FileReader) readSchema() error {
 		if err != nil {
 			return errors.Wrapf(err, "arrow/ipc: could read dictionary[%d]", i)
 		}
-		switch {
-		case !bitutil.IsMultipleOf8(blk.Offset):
-			return errors.Errorf("arrow/ipc: invalid file offset=%d for dictionary %d", blk.Offset, i)
-		case !bitutil.IsMultipleOf8(int64(blk.Meta)):
-			return errors.Errorf("arrow/ipc: invalid file metadata=%d position for dictionary %d", blk.Meta, i)
-		case !bitutil.IsMultipleOf8(blk.Body):
-			return errors.Errorf("arrow/ipc: invalid file body=%d position for dictionary %d", blk.Body, i)
+		if err := f.checkBlock(blk, "dictionary", i); err != nil {
+			return err
 		}
 
 		msg, err := blk.NewMessage()
@@ -155,7 +181,7 @@ func (f *FileReader) readSchema() error {
 		}
 		defer msg.Release()
 
-		id, dict, err := readDictionary(msg.meta, f.fields, f.r)
+		id, dict, err := readDictionary(msg.meta, f.fields, msg.body)
 		if err != nil {
 			return errors.Wrapf(err, "arrow/ipc: could not read dictionary %d from file", i)
 		}
@@ -175,6 +201,28 @@ func (f *FileReader) readSchema() error {
 	return err
 }
 
+// checkBlock validates the alignment of the i-th block of the provided kind,
+// and that it lies between the leading magic and the footer of the file,
+// so truncated or corrupted files are reported instead of misread.
+func (f *FileReader) checkBlock(blk fileBlock, kind string, i int) error {
+	switch {
+	case !bitutil.IsMultipleOf8(blk.Offset):
+		return errors.Errorf("arrow/ipc: invalid file offset=%d for %s %d", blk.Offset, kind, i)
+	case !bitutil.IsMultipleOf8(int64(blk.Meta)):
+		return errors.Errorf("arrow/ipc: invalid file metadata=%d position for %s %d", blk.Meta, kind, i)
+	case !bitutil.IsMultipleOf8(blk.Body):
+		return errors.Errorf("arrow/ipc: invalid file body=%d position for %s %d", blk.Body, kind, i)
+	case blk.Meta <= 0 || blk.Body < 0:
+		return errors.Errorf("arrow/ipc: invalid metadata=%d or body=%d size for %s %d", blk.Meta, blk.Body, kind, i)
+	case blk.Offset < int64(len(Magic)) || blk.Offset+int64(blk.Meta)+blk.Body > f.footer.start:
+		return errors.Errorf(
+			"arrow/ipc: %s %d at [%d, %d) lies outside of the file data [%d, %d): file truncated or corrupted",
+			kind, i, blk.Offset, blk.Offset+int64(blk.Meta)+blk.Body, len(Magic), f.footer.start,
+		)
+	}
+	return nil
+}
+
 func (f *FileReader) block(i int) (fileBlock, error) {
 	var blk flatbuf.Block
 	if !f.footer.data.RecordBatches(&blk, i) {
@@ -238,6 +286,8 @@ func (f *FileReader) Close() error {
 		f.record.Release()
 		f.record = nil
 	}
+
+	f.memo.delete()
 	return nil
 }
 
@@ -245,7 +295,7 @@ func (f *FileReader) Close() error {
 // The returned value is valid until the next call to Record.
 // Users need to call Retain on that Record to keep it valid for longer.
 func (f *FileReader) Record(i int) (array.Record, error) {
-	if i < 0 || i > f.NumRecords() {
+	if i < 0 || i >= f.NumRecords() {
 		panic("arrow/ipc: record index out of bounds")
 	}
 
@@ -253,13 +303,8 @@ func (f *FileReader) Record(i int) (array.Record, error) {
 	if err != nil {
 		return nil, err
 	}
-	switch {
-	case !bitutil.IsMultipleOf8(blk.Offset):
-		return nil, errors.Errorf("arrow/ipc: invalid file offset=%d for record %d", blk.Offset, i)
-	case !bitutil.IsMultipleOf8(int64(blk.Meta)):
-		return nil, errors.Errorf("arrow/ipc: invalid file metadata=%d position for record %d", blk.Meta, i)
-	case !bitutil.IsMultipleOf8(blk.Body):
-		return nil, errors.Errorf("arrow/ipc: invalid file body=%d position for record %d", blk.Body, i)
+	if err := f.checkBlock(blk, "record", i); err != nil {
+		return nil, err
 	}
 
 	msg, err := blk.NewMessage()
@@ -272,11 +317,20 @@ func (f *FileReader) Record(i int) (array.Record, error) {
 		return nil, errors.Errorf("arrow/ipc: message %d is not a Record", i)
 	}
 
+	if f.validate {
+		if err := verifyChecksum(msg); err != nil {
+			return nil, errors.Wrapf(err, "arrow/ipc: could not validate record %d", i)
+		}
+	}
+
 	if f.record != nil {
 		f.record.Release()
 	}
 
-	f.record = newRecord(f.schema, msg.meta, bytes.NewReader(msg.body.Bytes()))
+	f.record, err = newRecord(f.schema, &f.memo, msg.meta, msg.body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "arrow/ipc: could not read record %d", i)
+	}
 	return f.record, nil
 }
 
@@ -299,7 +353,7 @@ func (f *FileReader) ReadAt(i int64) (array.Record, error) {
 	return f.Record(int(i))
 }
 
-func newRecord(schema *arrow.Schema, meta *memory.Buffer, body ReadAtSeeker) array.Record {
+func newRecord(schema *arrow.Schema, memo *dictMemo, meta *memory.Buffer, body *memory.Buffer) (array.Record, error) {
 	var (
 		msg = flatbuf.GetRootAsMessage(meta.Bytes(), 0)
 		md  flatbuf.RecordBatch
@@ -307,12 +361,19 @@ func newRecord(schema *arrow.Schema, meta *memory.Buffer, body ReadAtSeeker) arr
 	initFB(&md, msg.Header)
 	rows := md.Length()
 
+	codec, err := codecFromFB(&md)
+	if err != nil {
+		return nil, err
+	}
+
 	ctx := &arrayLoaderContext{
 		src: ipcSource{
-			meta: &md,
-			r:    body,
+			meta:  &md,
+			body:  body,
+			codec: codec,
 		},
-		max: kMaxNestingDepth,
+		memo: memo,
+		max:  kMaxNestingDepth,
 	}
 
 	cols := make([]array.Interface, len(schema.Fields()))
@@ -320,12 +381,13 @@ func newRecord(schema *arrow.Schema, meta *memory.Buffer, body ReadAtSeeker) arr
 		cols[i] = ctx.loadArray(field.Type)
 	}
 
-	return array.NewRecord(schema, cols, rows)
+	return array.NewRecord(schema, cols, rows), nil
 }
 
 type ipcSource struct {
-	meta *flatbuf.RecordBatch
-	r    ReadAtSeeker
+	meta  *flatbuf.RecordBatch
+	body  *memory.Buffer
+	codec bufferCodec // decompresses the buffers of the body, if not nil
 }
 
 func (src *ipcSource) buffer(i int) *memory.Buffer {
@@ -337,12 +399,20 @@ func (src *ipcSource) buffer(i int) *memory.Buffer {
 		return memory.NewBufferBytes(nil)
 	}
 
-	raw := make([]byte, buf.Length())
-	_, err := src.r.ReadAt(raw, buf.Offset())
-	if err != nil {
-		panic(err)
+	// buffers reference the message body instead of copying it.
+	beg, end := buf.Offset(), buf.Offset()+buf.Length()
+	if beg < 0 || end > int64(src.body.Len()) {
+		panic(errors.Errorf("arrow/ipc: buffer %d at [%d, %d) out of message body bounds (size=%d)", i, beg, end, src.body.Len()))
 	}
 
+	raw := src.body.Bytes()[beg:end:end]
+	if src.codec != nil {
+		var err error
+		raw, err = decompressBuffer(src.codec, raw)
+		if err != nil {
+			panic(errors.Wrapf(err, "arrow/ipc: could not read buffer %d", i))
+		}
+	}
 	return memory.NewBufferBytes(raw)
 }
 
@@ -356,8 +426,10 @@ func (src *ipcSource) fieldMetadata(i int) *flatbuf.FieldNode {
 
 type arrayLoaderContext struct {
 	src     ipcSource
+	memo    *dictMemo // dictionaries of the dictionary-encoded fields.
 	ifield  int
 	ibuffer int
+	idict   int // index of the next dictionary-encoded field.
 	max     int
 }
 
@@ -405,6 +477,9 @@ func (ctx *arrayLoaderContext) loadArray(dt arrow.DataType) array.Interface {
 	case *arrow.StructType:
 		return ctx.loadStruct(dt)
 
+	case *arrow.DictionaryType:
+		return ctx.loadDictionary(dt)
+
 	default:
 		panic(errors.Errorf("array type %T not handled yet", dt))
 	}
@@ -527,45 +602,54 @@ func (ctx *arrayLoaderContext) loadStruct(dt *arrow.StructType) array.Interface
 	return array.NewStructData(data)
 }
 
-func readDictionary(meta *memory.Buffer, types dictTypeMap, r ReadAtSeeker) (int64, array.Interface, error) {
-	//	msg := flatbuf.GetRootAsMessage(meta.Bytes(), 0)
-	//	var dictBatch flatbuf.DictionaryBatch
-	//	initFB(&dictBatch, msg.Header)
-	//
-	//	id := dictBatch.Id()
-	//	v, ok := types[id]
-	//	if !ok {
-	//		return id, nil, errors.Errorf("arrow/ipc: no type metadata for dictionary with ID=%d", id)
-	//	}
-	//
-	//	fields := []arrow.Field{v}
-	//
-	//	// we need a schema for the record batch.
-	//	schema := arrow.NewSchema(fields, nil)
-	//
-	//	// the dictionary is embedded in a record batch with a single column.
-	//	recBatch := dictBatch.Data(nil)
-	//
-	//	var (
-	//		batchMeta *memory.Buffer
-	//		body      *memory.Buffer
-	//	)
-	//
-	//
-	//	ctx := &arrayLoaderContext{
-	//		src: ipcSource{
-	//			meta: &md,
-	//			r:    bytes.NewReader(body.Bytes()),
-	//		},
-	//		max: kMaxNestingDepth,
-	//	}
-	//
-	//	cols := make([]array.Interface, len(schema.Fields()))
-	//	for i, field := range schema.Fields() {
-	//		cols[i] = ctx.loadArray(field.Type)
-	//	}
-	//
-	//	batch := array.NewRecord(schema, cols, rows)
-
-	panic("not implemented")
+func (ctx *arrayLoaderContext) loadDictionary(dt *arrow.DictionaryType) array.Interface {
+	id, err := ctx.memo.fieldID(ctx.idict)
+	if err != nil {
+		panic(err)
+	}
+	ctx.idict++
+
+	dict, ok := ctx.memo.Dict(id)
+	if !ok {
+		panic(errors.Errorf("arrow/ipc: no dictionary with ID=%d", id))
+	}
+
+	indices := ctx.loadPrimitive(dt.IndexType)
+	defer indices.Release()
+
+	return array.NewDictionaryArray(dt, indices, dict)
+}
+
+// readDictionary reads the dictionary batch described by meta, with the
+// provided body, and returns its ID and dictionary values.
+func readDictionary(meta *memory.Buffer, types dictTypeMap, body *memory.Buffer) (int64, array.Interface, error) {
+	msg := flatbuf.GetRootAsMessage(meta.Bytes(), 0)
+	var dictBatch flatbuf.DictionaryBatch
+	initFB(&dictBatch, msg.Header)
+
+	id := dictBatch.Id()
+	v, ok := types[id]
+	if !ok {
+		return id, nil, errors.Errorf("arrow/ipc: no type metadata for dictionary with ID=%d", id)
+	}
+	if dictBatch.IsDelta() {
+		return id, nil, errors.Errorf("arrow/ipc: delta dictionary batches not supported (ID=%d)", id)
+	}
+
+	// the dictionary is embedded in a record batch with a single column.
+	md := dictBatch.Data(nil)
+	codec, err := codecFromFB(md)
+	if err != nil {
+		return id, nil, err
+	}
+
+	ctx := &arrayLoaderContext{
+		src: ipcSource{
+			meta:  md,
+			body:  body,
+			codec: codec,
+		},
+		max: kMaxNestingDepth,
+	}
+	return id, ctx.loadArray(v.Type), nil
 }
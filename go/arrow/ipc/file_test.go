@@ -17,11 +17,17 @@
 package ipc_test
 
 import (
+	"bytes"
+	"encoding/binary"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
+	"unsafe"
 
+	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
 	"github.com/apache/arrow/go/arrow/memory"
 )
 
@@ -43,3 +49,202 @@ func TestFile(t *testing.T) {
 		})
 	}
 }
+
+func TestMappedFile(t *testing.T) {
+	for name, recs := range arrdata.Records {
+		t.Run(name, func(t *testing.T) {
+			mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer mem.AssertSize(t, 0)
+
+			f, err := ioutil.TempFile("", "arrow-ipc-")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			defer os.Remove(f.Name())
+
+			arrdata.WriteFile(t, f, mem, recs[0].Schema(), recs)
+
+			data, err := ioutil.ReadFile(f.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r, err := ipc.NewMappedFileReader(data, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+
+			if got, want := r.NumRecords(), len(recs); got != want {
+				t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+			}
+
+			// records are accessed in any order, without copying their data.
+			for i := r.NumRecords() - 1; i >= 0; i-- {
+				rec, err := r.Record(i)
+				if err != nil {
+					t.Fatalf("could not read record[%d]: %v", i, err)
+				}
+				if !array.RecordEqual(rec, recs[i]) {
+					t.Fatalf("invalid record[%d]:\ngot= %v\nwant=%v", i, rec, recs[i])
+				}
+				for j, col := range rec.Columns() {
+					for k, buf := range col.Data().Buffers() {
+						if buf == nil || buf.Len() == 0 {
+							continue
+						}
+						if !contains(data, buf.Bytes()) {
+							t.Fatalf("record[%d] column %d buffer %d: data was copied", i, j, k)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+// contains reports whether sub is a sub-slice of the memory of buf.
+func contains(buf, sub []byte) bool {
+	beg := uintptr(unsafe.Pointer(&buf[0]))
+	ptr := uintptr(unsafe.Pointer(&sub[0]))
+	return beg <= ptr && ptr+uintptr(len(sub)) <= beg+uintptr(len(buf))
+}
+
+func TestFileCorrupted(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+
+	f, err := ioutil.TempFile("", "arrow-ipc-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	defer os.Remove(f.Name())
+
+	arrdata.WriteFile(t, f, mem, recs[0].Schema(), recs)
+	raw, err := ioutil.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem))
+	if err != nil {
+		t.Fatalf("could not open valid file: %v", err)
+	}
+	if got, want := r.NumRecords(), len(recs); got != want {
+		t.Fatalf("invalid number of records: got=%d, want=%d", got, want)
+	}
+	for i := r.NumRecords() - 1; i >= 0; i-- {
+		if _, err := r.Record(i); err != nil {
+			t.Fatalf("could not read record %d: %v", i, err)
+		}
+	}
+	r.Close()
+
+	modified := func(i int, v byte) []byte {
+		o := append([]byte(nil), raw...)
+		o[i] = v
+		return o
+	}
+
+	// a file missing its record batches, but with a consistent footer.
+	eof := len(ipc.Magic) + 4
+	size := int(binary.LittleEndian.Uint32(raw[len(raw)-eof:]))
+	headerless := append(append([]byte(nil), raw[:8]...), raw[len(raw)-eof-size:]...)
+
+	for _, tc := range []struct {
+		name string
+		raw  []byte
+		err  string // expected error, when opening the file.
+		rec  string // expected error, when reading the first record.
+	}{
+		{name: "empty", raw: nil, err: "file too small"},
+		{name: "truncated", raw: raw[:len(raw)-3], err: "not an Arrow file"},
+		{name: "bad-header", raw: modified(0, 'X'), err: "not an Arrow file"},
+		{name: "bad-trailer", raw: modified(len(raw)-1, 'X'), err: "not an Arrow file"},
+		{name: "bad-footer-size", raw: modified(len(raw)-eof+3, 0x7f), err: "file is smaller than indicated metadata size"},
+		{name: "missing-records", raw: headerless, rec: "file truncated or corrupted"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := ipc.NewFileReader(bytes.NewReader(tc.raw), ipc.WithAllocator(mem))
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("could not open file: %v", err)
+			}
+			defer r.Close()
+
+			_, err = r.Record(0)
+			if err == nil || !strings.Contains(err.Error(), tc.rec) {
+				t.Fatalf("invalid error: got=%v, want=%q", err, tc.rec)
+			}
+		})
+	}
+}
+
+func TestFileValidation(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+
+	f, err := ioutil.TempFile("", "arrow-ipc-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	defer os.Remove(f.Name())
+
+	w, err := ipc.NewFileWriter(f, ipc.WithSchema(recs[0].Schema()), ipc.WithAllocator(mem), ipc.WithValidation(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("could not write record[%d]: %v", i, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem), ipc.WithValidation(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < r.NumRecords(); i++ {
+		if _, err := r.Record(i); err != nil {
+			t.Fatalf("could not read record %d: %v", i, err)
+		}
+	}
+	r.Close()
+
+	// flip the last byte of the body of the last record batch, just before the footer.
+	eof := len(ipc.Magic) + 4
+	size := int(binary.LittleEndian.Uint32(raw[len(raw)-eof:]))
+	pos := len(raw) - eof - size - 1
+	raw[pos] = ^raw[pos]
+
+	r, err = ipc.NewFileReader(bytes.NewReader(raw), ipc.WithAllocator(mem), ipc.WithValidation(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	_, err = r.Record(r.NumRecords() - 1)
+	if want := "record batch checksum mismatch"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("invalid error: got=%v, want=%q", err, want)
+	}
+}
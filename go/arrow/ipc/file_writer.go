@@ -235,10 +235,18 @@ type FileWriter struct {
 
 	pw payloadWriter
 
-	schema *arrow.Schema
+	schema   *arrow.Schema
+	checksum bool
+	codec    bufferCodec
+
+	dicts dictMemo // dictionaries written, by ID.
 }
 
 // NewFileWriter opens an Arrow file using the provided writer w.
+//
+// The dictionaries of the dictionary-encoded columns of the first record are
+// written along with it. Arrow files do not support replacing dictionaries:
+// writing a record with different dictionaries returns an error.
 func NewFileWriter(w io.WriteSeeker, opts ...Option) (*FileWriter, error) {
 	var (
 		cfg = newConfig(opts...)
@@ -246,10 +254,13 @@ func NewFileWriter(w io.WriteSeeker, opts ...Option) (*FileWriter, error) {
 	)
 
 	f := FileWriter{
-		w:      w,
-		pw:     &pwriter{w: w, schema: cfg.schema, pos: -1},
-		mem:    cfg.alloc,
-		schema: cfg.schema,
+		w:        w,
+		pw:       &pwriter{w: w, schema: cfg.schema, pos: -1},
+		mem:      cfg.alloc,
+		schema:   cfg.schema,
+		checksum: cfg.validate,
+		codec:    newBufferCodec(cfg.codec),
+		dicts:    newMemo(),
 	}
 
 	pos, err := f.w.Seek(0, io.SeekCurrent)
@@ -276,6 +287,7 @@ func (f *FileWriter) Close() error {
 		return errors.Wrap(err, "arrow/ipc: could not close payload writer")
 	}
 	f.footer.written = true
+	f.dicts.delete()
 
 	return nil
 }
@@ -290,6 +302,11 @@ func (f *FileWriter) Write(rec array.Record) error {
 		return errors.Wrap(err, "arrow/ipc: could not write header")
 	}
 
+	const replace = false
+	if err := writeDictionaries(f.pw, f.mem, f.codec, &f.dicts, rec, replace); err != nil {
+		return err
+	}
+
 	const allow64b = true
 	var (
 		data = payload{msg: MessageRecordBatch}
@@ -297,6 +314,9 @@ func (f *FileWriter) Write(rec array.Record) error {
 	)
 	defer data.Release()
 
+	enc.checksum = f.checksum
+	enc.codec = f.codec
+
 	if err := enc.Encode(&data, rec); err != nil {
 		return errors.Wrap(err, "arrow/ipc: could not encode record to payload")
 	}
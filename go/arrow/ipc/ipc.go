@@ -60,9 +60,11 @@ type ReadAtSeeker interface {
 }
 
 type config struct {
-	alloc  memory.Allocator
-	schema *arrow.Schema
-	footer struct {
+	alloc    memory.Allocator
+	schema   *arrow.Schema
+	validate bool
+	codec    CompressionCodec
+	footer   struct {
 		offset int64
 	}
 }
@@ -104,6 +106,32 @@ func WithSchema(schema *arrow.Schema) Option {
 	}
 }
 
+// WithValidation enables checksums of record batch bodies.
+//
+// When writing, a CRC32C of the body of each record batch is stored in the
+// custom metadata of its message.
+// When reading, that checksum is verified against the body of each record
+// batch and a mismatch, or a missing checksum, is reported as an error.
+//
+// Files and streams written without this option are compatible with other
+// Arrow implementations, which ignore the extra message metadata anyway.
+func WithValidation(v bool) Option {
+	return func(cfg *config) {
+		cfg.validate = v
+	}
+}
+
+// WithCompression specifies the codec compressing the buffers of the bodies
+// of the record batches and dictionary batches written.
+//
+// Readers decompress such bodies transparently, whatever the option.
+// The default, Uncompressed, writes the bodies as they are.
+func WithCompression(codec CompressionCodec) Option {
+	return func(cfg *config) {
+		cfg.codec = codec
+	}
+}
+
 var (
 	_ arrio.Reader = (*Reader)(nil)
 	_ arrio.Writer = (*Writer)(nil)
@@ -83,10 +83,20 @@ func (blk fileBlock) NewMessage() (*Message, error) {
 		r   = blk.section()
 	)
 
-	buf = make([]byte, blk.Meta)
-	_, err = io.ReadFull(r, buf)
-	if err != nil {
-		return nil, errors.Wrap(err, "arrow/ipc: could not read message metadata")
+	mapped, isMapped := blk.r.(*mappedFile)
+	switch {
+	case isMapped:
+		end := blk.Offset + int64(blk.Meta) + blk.Body
+		if blk.Offset < 0 || end > int64(len(mapped.data)) {
+			return nil, errors.Errorf("arrow/ipc: message at [%d, %d) out of file bounds (size=%d)", blk.Offset, end, len(mapped.data))
+		}
+		buf = mapped.data[blk.Offset : blk.Offset+int64(blk.Meta)]
+	default:
+		buf = make([]byte, blk.Meta)
+		_, err = io.ReadFull(r, buf)
+		if err != nil {
+			return nil, errors.Wrap(err, "arrow/ipc: could not read message metadata")
+		}
 	}
 
 	prefix := 0
@@ -102,10 +112,16 @@ func (blk fileBlock) NewMessage() (*Message, error) {
 
 	meta := memory.NewBufferBytes(buf[prefix:]) // drop buf-size already known from blk.Meta
 
-	buf = make([]byte, blk.Body)
-	_, err = io.ReadFull(r, buf)
-	if err != nil {
-		return nil, errors.Wrap(err, "arrow/ipc: could not read message body")
+	switch {
+	case isMapped:
+		beg := blk.Offset + int64(blk.Meta)
+		buf = mapped.data[beg : beg+blk.Body : beg+blk.Body]
+	default:
+		buf = make([]byte, blk.Body)
+		_, err = io.ReadFull(r, buf)
+		if err != nil {
+			return nil, errors.Wrap(err, "arrow/ipc: could not read message body")
+		}
 	}
 	body := memory.NewBufferBytes(buf)
 
@@ -172,33 +188,70 @@ func fieldFromFB(field *flatbuf.Field, memo *dictMemo) (arrow.Field, error) {
 	}
 
 	encoding := field.Dictionary(nil)
-	switch encoding {
-	case nil:
-		n := field.ChildrenLength()
-		children := make([]arrow.Field, n)
-		for i := range children {
-			var childFB flatbuf.Field
-			if !field.Children(&childFB, i) {
-				return o, errors.Errorf("arrow/ipc: could not load field child %d", i)
-			}
-			child, err := fieldFromFB(&childFB, memo)
-			if err != nil {
-				return o, errors.Wrapf(err, "arrow/ipc: could not convert field child %d", i)
-			}
-			children[i] = child
+	if encoding != nil && memo != nil {
+		// record the ID before visiting the children, in depth-first order.
+		memo.fields = append(memo.fields, encoding.Id())
+	}
+
+	n := field.ChildrenLength()
+	children := make([]arrow.Field, n)
+	for i := range children {
+		var childFB flatbuf.Field
+		if !field.Children(&childFB, i) {
+			return o, errors.Errorf("arrow/ipc: could not load field child %d", i)
+		}
+		child, err := fieldFromFB(&childFB, memo)
+		if err != nil {
+			return o, errors.Wrapf(err, "arrow/ipc: could not convert field child %d", i)
 		}
+		children[i] = child
+	}
 
-		o.Type, err = typeFromFB(field, children, o.Metadata)
+	o.Type, err = typeFromFB(field, children, o.Metadata)
+	if err != nil {
+		return o, errors.Wrapf(err, "arrow/ipc: could not convert field type")
+	}
+
+	if encoding != nil {
+		// the type of a dictionary-encoded field is the type of its values.
+		o.Type, err = dictTypeFromFB(encoding, o.Type)
 		if err != nil {
-			return o, errors.Wrapf(err, "arrow/ipc: could not convert field type")
+			return o, errors.Wrapf(err, "arrow/ipc: could not convert field dictionary encoding")
 		}
-	default:
-		panic("not implemented") // FIXME(sbinet)
 	}
 
 	return o, nil
 }
 
+func dictTypeFromFB(encoding *flatbuf.DictionaryEncoding, values arrow.DataType) (arrow.DataType, error) {
+	var (
+		index arrow.DataType = arrow.PrimitiveTypes.Int32 // default index type.
+		err   error
+	)
+	if indexFB := encoding.IndexType(nil); indexFB != nil {
+		index, err = intFromFB(*indexFB)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &arrow.DictionaryType{IndexType: index, ValueType: values, Ordered: encoding.IsOrdered()}, nil
+}
+
+func dictEncodingToFB(b *flatbuffers.Builder, dt *arrow.DictionaryType, id int64) flatbuffers.UOffsetT {
+	var signed bool
+	switch dt.IndexType.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64:
+		signed = true
+	}
+	indexFB := intToFB(b, int32(dt.IndexType.(arrow.FixedWidthDataType).BitWidth()), signed)
+
+	flatbuf.DictionaryEncodingStart(b)
+	flatbuf.DictionaryEncodingAddId(b, id)
+	flatbuf.DictionaryEncodingAddIndexType(b, indexFB)
+	flatbuf.DictionaryEncodingAddIsOrdered(b, dt.Ordered)
+	return flatbuf.DictionaryEncodingEnd(b)
+}
+
 func fieldToFB(b *flatbuffers.Builder, field arrow.Field, memo *dictMemo) flatbuffers.UOffsetT {
 	var visitor = fieldVisitor{b: b, memo: memo, meta: make(map[string]string)}
 	return visitor.result(field)
@@ -385,6 +438,17 @@ func (fv *fieldVisitor) visit(field arrow.Field) {
 func (fv *fieldVisitor) result(field arrow.Field) flatbuffers.UOffsetT {
 	nameFB := fv.b.CreateString(field.Name)
 
+	var dictFB flatbuffers.UOffsetT
+	if dt, ok := field.Type.(*arrow.DictionaryType); ok {
+		if hasDictionary(dt.ValueType) {
+			panic(errors.Errorf("arrow/ipc: nested dictionary-encoded types not supported (type=%v)", dt))
+		}
+		// a dictionary-encoded field is described by the type of its values
+		// and by its dictionary encoding.
+		dictFB = dictEncodingToFB(fv.b, dt, fv.memo.addField())
+		field.Type = dt.ValueType
+	}
+
 	fv.visit(field)
 
 	flatbuf.FieldStartChildrenVector(fv.b, len(fv.kids))
@@ -393,11 +457,6 @@ func (fv *fieldVisitor) result(field arrow.Field) flatbuffers.UOffsetT {
 	}
 	kidsFB := fv.b.EndVector(len(fv.kids))
 
-	var dictFB flatbuffers.UOffsetT
-	if field.Type.ID() == arrow.DICTIONARY {
-		panic("not implemented") // FIXME(sbinet)
-	}
-
 	var (
 		metaFB flatbuffers.UOffsetT
 		kvs    []flatbuffers.UOffsetT
@@ -449,6 +508,25 @@ func (fv *fieldVisitor) result(field arrow.Field) flatbuffers.UOffsetT {
 	return offset
 }
 
+// hasDictionary reports whether dt is or contains a dictionary type.
+func hasDictionary(dt arrow.DataType) bool {
+	switch dt := dt.(type) {
+	case *arrow.DictionaryType:
+		return true
+	case *arrow.StructType:
+		for _, f := range dt.Fields() {
+			if hasDictionary(f.Type) {
+				return true
+			}
+		}
+	case *arrow.ListType:
+		return hasDictionary(dt.Elem())
+	case *arrow.FixedSizeListType:
+		return hasDictionary(dt.Elem())
+	}
+	return false
+}
+
 func fieldFromFBDict(field *flatbuf.Field) (arrow.Field, error) {
 	var (
 		o = arrow.Field{
@@ -925,13 +1003,15 @@ func writeFBBuilder(b *flatbuffers.Builder, mem memory.Allocator) *memory.Buffer
 	return buf
 }
 
-func writeMessageFB(b *flatbuffers.Builder, mem memory.Allocator, hdrType flatbuf.MessageHeader, hdr flatbuffers.UOffsetT, bodyLen int64) *memory.Buffer {
+func writeMessageFB(b *flatbuffers.Builder, mem memory.Allocator, hdrType flatbuf.MessageHeader, hdr flatbuffers.UOffsetT, bodyLen int64, custom arrow.Metadata) *memory.Buffer {
+	metaFB := metadataToFB(b, custom, flatbuf.MessageStartCustomMetadataVector)
 
 	flatbuf.MessageStart(b)
 	flatbuf.MessageAddVersion(b, int16(currentMetadataVersion))
 	flatbuf.MessageAddHeaderType(b, hdrType)
 	flatbuf.MessageAddHeader(b, hdr)
 	flatbuf.MessageAddBodyLength(b, bodyLen)
+	flatbuf.MessageAddCustomMetadata(b, metaFB)
 	msg := flatbuf.MessageEnd(b)
 	b.Finish(msg)
 
@@ -941,7 +1021,7 @@ func writeMessageFB(b *flatbuffers.Builder, mem memory.Allocator, hdrType flatbu
 func writeSchemaMessage(schema *arrow.Schema, mem memory.Allocator, dict *dictMemo) *memory.Buffer {
 	b := flatbuffers.NewBuilder(1024)
 	schemaFB := schemaToFB(b, schema, dict)
-	return writeMessageFB(b, mem, flatbuf.MessageHeaderSchema, schemaFB, 0)
+	return writeMessageFB(b, mem, flatbuf.MessageHeaderSchema, schemaFB, 0, arrow.Metadata{})
 }
 
 func writeFileFooter(schema *arrow.Schema, dicts, recs []fileBlock, w io.Writer) error {
@@ -967,20 +1047,44 @@ func writeFileFooter(schema *arrow.Schema, dicts, recs []fileBlock, w io.Writer)
 	return err
 }
 
-func writeRecordMessage(mem memory.Allocator, size, bodyLength int64, fields []fieldMetadata, meta []bufferMetadata) *memory.Buffer {
+func writeRecordMessage(mem memory.Allocator, size, bodyLength int64, fields []fieldMetadata, meta []bufferMetadata, codec bufferCodec, custom arrow.Metadata) *memory.Buffer {
 	b := flatbuffers.NewBuilder(0)
-	recFB := recordToFB(b, size, bodyLength, fields, meta)
-	return writeMessageFB(b, mem, flatbuf.MessageHeaderRecordBatch, recFB, bodyLength)
+	recFB := recordToFB(b, size, bodyLength, fields, meta, codec)
+	return writeMessageFB(b, mem, flatbuf.MessageHeaderRecordBatch, recFB, bodyLength, custom)
 }
 
-func recordToFB(b *flatbuffers.Builder, size, bodyLength int64, fields []fieldMetadata, meta []bufferMetadata) flatbuffers.UOffsetT {
+func writeDictionaryMessage(mem memory.Allocator, id, size, bodyLength int64, fields []fieldMetadata, meta []bufferMetadata, codec bufferCodec) *memory.Buffer {
+	b := flatbuffers.NewBuilder(0)
+	recFB := recordToFB(b, size, bodyLength, fields, meta, codec)
+
+	flatbuf.DictionaryBatchStart(b)
+	flatbuf.DictionaryBatchAddId(b, id)
+	flatbuf.DictionaryBatchAddData(b, recFB)
+	flatbuf.DictionaryBatchAddIsDelta(b, false)
+	dictFB := flatbuf.DictionaryBatchEnd(b)
+
+	return writeMessageFB(b, mem, flatbuf.MessageHeaderDictionaryBatch, dictFB, bodyLength, arrow.Metadata{})
+}
+
+func recordToFB(b *flatbuffers.Builder, size, bodyLength int64, fields []fieldMetadata, meta []bufferMetadata, codec bufferCodec) flatbuffers.UOffsetT {
 	fieldsFB := writeFieldNodes(b, fields, flatbuf.RecordBatchStartNodesVector)
 	metaFB := writeBuffers(b, meta, flatbuf.RecordBatchStartBuffersVector)
 
+	var bodyCompressionFB flatbuffers.UOffsetT
+	if codec != nil {
+		flatbuf.BodyCompressionStart(b)
+		flatbuf.BodyCompressionAddCodec(b, codec.kind())
+		flatbuf.BodyCompressionAddMethod(b, flatbuf.BodyCompressionMethodBUFFER)
+		bodyCompressionFB = flatbuf.BodyCompressionEnd(b)
+	}
+
 	flatbuf.RecordBatchStart(b)
 	flatbuf.RecordBatchAddLength(b, size)
 	flatbuf.RecordBatchAddNodes(b, fieldsFB)
 	flatbuf.RecordBatchAddBuffers(b, metaFB)
+	if codec != nil {
+		flatbuf.RecordBatchAddCompression(b, bodyCompressionFB)
+	}
 	return flatbuf.RecordBatchEnd(b)
 }
 
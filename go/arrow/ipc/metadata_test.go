@@ -40,6 +40,13 @@ func TestRWSchema(t *testing.T) {
 			}, &meta),
 			memo: newMemo(),
 		},
+		{
+			schema: arrow.NewSchema([]arrow.Field{
+				{Name: "f1", Type: arrow.PrimitiveTypes.Int64, Metadata: arrow.MetadataFrom(map[string]string{"unit": "m"})},
+				{Name: "f2", Type: arrow.PrimitiveTypes.Float64, Nullable: true, Metadata: arrow.MetadataFrom(map[string]string{"desc": "speed", "unit": "m/s"})},
+			}, &meta),
+			memo: newMemo(),
+		},
 	} {
 		t.Run("", func(t *testing.T) {
 			b := flatbuffers.NewBuilder(0)
@@ -55,7 +62,7 @@ func TestRWSchema(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			if !got.Equal(tc.schema) {
+			if !got.EqualWithMetadata(tc.schema) {
 				t.Fatalf("r/w schema failed:\ngot = %#v\nwant= %#v\n", got, tc.schema)
 			}
 
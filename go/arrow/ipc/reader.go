@@ -17,7 +17,6 @@
 package ipc // import "github.com/apache/arrow/go/arrow/ipc"
 
 import (
-	"bytes"
 	"io"
 	"sync/atomic"
 
@@ -45,7 +44,8 @@ type Reader struct {
 
 	mem memory.Allocator
 
-	done bool
+	validate bool
+	done     bool
 }
 
 // NewReader returns a reader that reads records from an input stream.
@@ -56,10 +56,11 @@ func NewReader(r io.Reader, opts ...Option) (*Reader, error) {
 	}
 
 	rr := &Reader{
-		r:     NewMessageReader(r),
-		types: make(dictTypeMap),
-		memo:  newMemo(),
-		mem:   cfg.alloc,
+		r:        NewMessageReader(r),
+		types:    make(dictTypeMap),
+		memo:     newMemo(),
+		mem:      cfg.alloc,
+		validate: cfg.validate,
 	}
 
 	err := rr.readSchema(cfg.schema)
@@ -95,12 +96,6 @@ func (r *Reader) readSchema(schema *arrow.Schema) error {
 		return errors.Wrap(err, "arrow/ipc: could read dictionary types from message schema")
 	}
 
-	// TODO(sbinet): in the future, we may want to reconcile IDs in the stream with
-	// those found in the schema.
-	for range r.types {
-		panic("not implemented") // FIXME(sbinet): ReadNextDictionary
-	}
-
 	r.schema, err = schemaFromFB(&schemaFB, &r.memo)
 	if err != nil {
 		return errors.Wrap(err, "arrow/ipc: could not decode schema from message schema")
@@ -135,6 +130,7 @@ func (r *Reader) Release() {
 			r.r.Release()
 			r.r = nil
 		}
+		r.memo.delete()
 	}
 }
 
@@ -154,13 +150,23 @@ func (r *Reader) Next() bool {
 
 func (r *Reader) next() bool {
 	var msg *Message
-	msg, r.err = r.r.Message()
-	if r.err != nil {
-		r.done = true
-		if r.err == io.EOF {
-			r.err = nil
+	for {
+		msg, r.err = r.r.Message()
+		if r.err != nil {
+			r.done = true
+			if r.err == io.EOF {
+				r.err = nil
+			}
+			return false
+		}
+
+		// dictionary batches precede the records using them.
+		if msg.Type() != MessageDictionaryBatch {
+			break
+		}
+		if r.err = r.readDictionary(msg); r.err != nil {
+			return false
 		}
-		return false
 	}
 
 	if got, want := msg.Type(), MessageRecordBatch; got != want {
@@ -168,8 +174,26 @@ func (r *Reader) next() bool {
 		return false
 	}
 
-	r.rec = newRecord(r.schema, msg.meta, bytes.NewReader(msg.body.Bytes()))
-	return true
+	if r.validate {
+		if r.err = verifyChecksum(msg); r.err != nil {
+			return false
+		}
+	}
+
+	r.rec, r.err = newRecord(r.schema, &r.memo, msg.meta, msg.body)
+	return r.err == nil
+}
+
+// readDictionary reads the dictionary batch msg, which replaces any previous
+// dictionary with the same ID.
+func (r *Reader) readDictionary(msg *Message) error {
+	id, dict, err := readDictionary(msg.meta, r.types, msg.body)
+	if err != nil {
+		return errors.Wrap(err, "arrow/ipc: could not read dictionary")
+	}
+	r.memo.Set(id, dict)
+	dict.Release() // memo.Set increases ref-count of dict.
+	return nil
 }
 
 // Record returns the current record that has been extracted from the
@@ -17,12 +17,16 @@
 package ipc_test
 
 import (
+	"bytes"
 	"io"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/apache/arrow/go/arrow/array"
 	"github.com/apache/arrow/go/arrow/internal/arrdata"
+	"github.com/apache/arrow/go/arrow/ipc"
 	"github.com/apache/arrow/go/arrow/memory"
 )
 
@@ -55,3 +59,80 @@ func TestStream(t *testing.T) {
 		})
 	}
 }
+
+func TestStreamValidation(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	recs := arrdata.Records["primitives"]
+	schema := recs[0].Schema()
+
+	write := func(opts ...ipc.Option) []byte {
+		var buf bytes.Buffer
+		w := ipc.NewWriter(&buf, append(opts, ipc.WithSchema(schema), ipc.WithAllocator(mem))...)
+		for i, rec := range recs {
+			if err := w.Write(rec); err != nil {
+				t.Fatalf("could not write record[%d]: %v", i, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+
+	read := func(raw []byte, opts ...ipc.Option) error {
+		r, err := ipc.NewReader(bytes.NewReader(raw), append(opts, ipc.WithAllocator(mem))...)
+		if err != nil {
+			return err
+		}
+		defer r.Release()
+
+		n := 0
+		for r.Next() {
+			if !array.RecordEqual(r.Record(), recs[n]) {
+				t.Fatalf("records[%d] differ", n)
+			}
+			n++
+		}
+		if r.Err() != nil {
+			return r.Err()
+		}
+		if n != len(recs) {
+			t.Fatalf("invalid number of records: got=%d, want=%d", n, len(recs))
+		}
+		return nil
+	}
+
+	plain := write()
+	checked := write(ipc.WithValidation(true))
+
+	for _, tc := range []struct {
+		name     string
+		raw      []byte
+		validate bool
+		err      string
+	}{
+		{name: "plain", raw: plain},
+		{name: "checked", raw: checked, validate: true},
+		{name: "checked-no-validation", raw: checked},
+		{name: "plain-validation", raw: plain, validate: true, err: "missing record batch checksum"},
+		{
+			// flip the last byte of the last record batch body, before the end-of-stream marker.
+			name:     "corrupted",
+			raw:      append(append([]byte(nil), checked[:len(checked)-9]...), ^checked[len(checked)-9], 0xFF, 0xFF, 0xFF, 0xFF, 0, 0, 0, 0),
+			validate: true,
+			err:      "record batch checksum mismatch",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := read(tc.raw, ipc.WithValidation(tc.validate))
+			switch {
+			case tc.err == "" && err != nil:
+				t.Fatalf("could not read stream: %v", err)
+			case tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)):
+				t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+			}
+		})
+	}
+}
@@ -60,18 +60,28 @@ type Writer struct {
 	mem memory.Allocator
 	pw  payloadWriter
 
-	started bool
-	schema  *arrow.Schema
+	started  bool
+	schema   *arrow.Schema
+	checksum bool
+	codec    bufferCodec
+
+	dicts dictMemo // last dictionaries written, by ID.
 }
 
 // NewWriter returns a writer that writes records to the provided output stream.
+//
+// The dictionaries of the dictionary-encoded columns of a record are written
+// before the record, if they differ from the ones written last, replacing them.
 func NewWriter(w io.Writer, opts ...Option) *Writer {
 	cfg := newConfig(opts...)
 	return &Writer{
-		w:      w,
-		mem:    cfg.alloc,
-		pw:     &swriter{w: w},
-		schema: cfg.schema,
+		w:        w,
+		mem:      cfg.alloc,
+		pw:       &swriter{w: w},
+		schema:   cfg.schema,
+		checksum: cfg.validate,
+		codec:    newBufferCodec(cfg.codec),
+		dicts:    newMemo(),
 	}
 }
 
@@ -92,6 +102,7 @@ func (w *Writer) Close() error {
 		return errors.Wrap(err, "arrow/ipc: could not close payload writer")
 	}
 	w.pw = nil
+	w.dicts.delete()
 
 	return nil
 }
@@ -109,6 +120,11 @@ func (w *Writer) Write(rec array.Record) error {
 		return errInconsistentSchema
 	}
 
+	const replace = true
+	if err := writeDictionaries(w.pw, w.mem, w.codec, &w.dicts, rec, replace); err != nil {
+		return err
+	}
+
 	const allow64b = true
 	var (
 		data = payload{msg: MessageRecordBatch}
@@ -116,6 +132,9 @@ func (w *Writer) Write(rec array.Record) error {
 	)
 	defer data.Release()
 
+	enc.checksum = w.checksum
+	enc.codec = w.codec
+
 	if err := enc.Encode(&data, rec); err != nil {
 		return errors.Wrap(err, "arrow/ipc: could not encode record to payload")
 	}
@@ -123,6 +142,69 @@ func (w *Writer) Write(rec array.Record) error {
 	return w.pw.write(data)
 }
 
+// writeDictionaries writes a dictionary batch for each dictionary of rec
+// which differs from the last one written with the same ID, recorded in memo.
+// Their bodies are compressed with codec, if not nil.
+// writeDictionaries returns an error if such a dictionary was already
+// written and replace is false.
+func writeDictionaries(pw payloadWriter, mem memory.Allocator, codec bufferCodec, memo *dictMemo, rec array.Record, replace bool) error {
+	for i, dict := range recordDictionaries(rec) {
+		id := int64(i)
+		if prev, ok := memo.Dict(id); ok {
+			if array.ArrayEqual(prev, dict) {
+				continue
+			}
+			if !replace {
+				return errors.Errorf("arrow/ipc: dictionary %d changed: dictionary replacement not supported", id)
+			}
+		}
+
+		const allow64b = true
+		var (
+			data = payload{msg: MessageDictionaryBatch}
+			enc  = newRecordEncoder(mem, 0, kMaxNestingDepth, allow64b)
+		)
+		enc.codec = codec
+		err := enc.EncodeDictionary(&data, id, dict)
+		if err == nil {
+			err = pw.write(data)
+		}
+		data.Release()
+		if err != nil {
+			return errors.Wrapf(err, "arrow/ipc: could not write dictionary %d", id)
+		}
+		memo.Set(id, dict)
+	}
+	return nil
+}
+
+// recordDictionaries returns the dictionaries of the dictionary-encoded
+// arrays of rec, in depth-first order: the order of their IDs.
+func recordDictionaries(rec array.Record) []array.Interface {
+	var (
+		dicts []array.Interface
+		visit func(arr array.Interface)
+	)
+	visit = func(arr array.Interface) {
+		switch arr := arr.(type) {
+		case *array.Dictionary:
+			dicts = append(dicts, arr.Dictionary())
+		case *array.Struct:
+			for i := 0; i < arr.NumField(); i++ {
+				visit(arr.Field(i))
+			}
+		case *array.List:
+			visit(arr.ListValues())
+		case *array.FixedSizeList:
+			visit(arr.ListValues())
+		}
+	}
+	for _, col := range rec.Columns() {
+		visit(col)
+	}
+	return dicts
+}
+
 func (w *Writer) start() error {
 	w.started = true
 
@@ -149,6 +231,8 @@ type recordEncoder struct {
 	depth    int64
 	start    int64
 	allow64b bool
+	checksum bool        // whether to store a checksum of the body in the message metadata
+	codec    bufferCodec // compresses the buffers of the body, if not nil
 }
 
 func newRecordEncoder(mem memory.Allocator, startOffset, maxDepth int64, allow64b bool) *recordEncoder {
@@ -170,6 +254,39 @@ func (w *recordEncoder) Encode(p *payload, rec array.Record) error {
 		}
 	}
 
+	if err := w.compressBody(p); err != nil {
+		return err
+	}
+	w.encodeBody(p)
+	return w.encodeMetadata(p, rec.NumRows())
+}
+
+// EncodeDictionary encodes the dictionary values dict, with the provided
+// dictionary ID, as a dictionary batch.
+func (w *recordEncoder) EncodeDictionary(p *payload, id int64, dict array.Interface) error {
+	if err := w.visit(p, dict); err != nil {
+		return errors.Wrapf(err, "arrow/ipc: could not encode dictionary %d", id)
+	}
+
+	if err := w.compressBody(p); err != nil {
+		return err
+	}
+	w.encodeBody(p)
+	p.meta = writeDictionaryMessage(w.mem, id, int64(dict.Len()), p.size, w.fields, w.meta, w.codec)
+	return nil
+}
+
+// compressBody compresses the buffers of the body of p with the codec of the
+// encoder, if any.
+func (w *recordEncoder) compressBody(p *payload) error {
+	if w.codec == nil {
+		return nil
+	}
+	return compressBody(w.codec, p.body)
+}
+
+// encodeBody computes the metadata of the buffers of the body of p.
+func (w *recordEncoder) encodeBody(p *payload) {
 	// position for the start of a buffer relative to the passed frame of reference.
 	// may be 0 or some other position in an address space.
 	offset := w.start
@@ -197,8 +314,6 @@ func (w *recordEncoder) Encode(p *payload, rec array.Record) error {
 	if !bitutil.IsMultipleOf8(p.size) {
 		panic("not aligned")
 	}
-
-	return w.encodeMetadata(p, rec.NumRows())
 }
 
 func (w *recordEncoder) visit(p *payload, arr array.Interface) error {
@@ -210,6 +325,12 @@ func (w *recordEncoder) visit(p *payload, arr array.Interface) error {
 		return errBigArray
 	}
 
+	if dict, ok := arr.(*array.Dictionary); ok {
+		// dictionary-encoded arrays are written as their indices.
+		// their dictionaries are written as dictionary batches.
+		arr = dict.Indices()
+	}
+
 	// add all common elements
 	w.fields = append(w.fields, fieldMetadata{
 		Len:    int64(arr.Len()),
@@ -292,10 +413,9 @@ func (w *recordEncoder) visit(p *payload, arr array.Interface) error {
 			data = array.NewSliceData(data, beg, beg+len)
 			defer data.Release()
 			values = data.Buffers()[2]
-		default:
-			if values != nil {
-				values.Retain()
-			}
+		}
+		if values != nil {
+			values.Retain()
 		}
 		p.body = append(p.body, voffsets)
 		p.body = append(p.body, values)
@@ -324,10 +444,9 @@ func (w *recordEncoder) visit(p *payload, arr array.Interface) error {
 			data = array.NewSliceData(data, beg, beg+len)
 			defer data.Release()
 			values = data.Buffers()[2]
-		default:
-			if values != nil {
-				values.Retain()
-			}
+		}
+		if values != nil {
+			values.Retain()
 		}
 		p.body = append(p.body, voffsets)
 		p.body = append(p.body, values)
@@ -423,7 +542,11 @@ func (w *recordEncoder) getZeroBasedValueOffsets(arr array.Interface) (*memory.B
 }
 
 func (w *recordEncoder) encodeMetadata(p *payload, nrows int64) error {
-	p.meta = writeRecordMessage(w.mem, nrows, p.size, w.fields, w.meta)
+	var custom arrow.Metadata
+	if w.checksum {
+		custom = checksumMetadata(bodyChecksum(p.body))
+	}
+	p.meta = writeRecordMessage(w.mem, nrows, p.size, w.fields, w.meta, w.codec, custom)
 	return nil
 }
 
@@ -0,0 +1,88 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package json reads newline-delimited JSON files and presents the extracted
+// data as records, also writes data as records into newline-delimited JSON files.
+package json
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Option configures a JSON reader/writer.
+type Option func(config)
+type config interface{}
+
+// WithAllocator specifies the Arrow memory allocator used while building records.
+func WithAllocator(mem memory.Allocator) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.mem = mem
+		default:
+			panic(fmt.Errorf("arrow/json: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithChunk specifies the chunk size used while parsing JSON files.
+//
+// If n is zero or 1, no chunking will take place and the reader will create
+// one record per line.
+// If n is greater than 1, chunks of n lines will be read.
+// If n is negative, the reader will load the whole JSON file into memory and
+// create one big record with all the lines.
+func WithChunk(n int) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Reader:
+			cfg.chunk = n
+		default:
+			panic(fmt.Errorf("arrow/json: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithOmitNulls specifies whether null values are omitted from the JSON
+// objects, rather than written as JSON null values.
+// The default value is false.
+func WithOmitNulls(omit bool) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Writer:
+			cfg.omitNulls = omit
+		default:
+			panic(fmt.Errorf("arrow/json: unknown config type %T", cfg))
+		}
+	}
+}
+
+// WithFloatPrecision specifies the number of significant digits used while
+// writing floating-point values.
+// The default value is -1, which uses the smallest number of digits necessary
+// to represent the values exactly.
+func WithFloatPrecision(prec int) Option {
+	return func(cfg config) {
+		switch cfg := cfg.(type) {
+		case *Writer:
+			cfg.prec = prec
+		default:
+			panic(fmt.Errorf("arrow/json: unknown config type %T", cfg))
+		}
+	}
+}
@@ -0,0 +1,375 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/internal/debug"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// Reader reads newline-delimited JSON data, one JSON object per line, and
+// creates array.Records from a schema.
+//
+// The keys of each JSON object are matched against the names of the schema
+// fields. Missing keys and JSON null values produce null elements, and are
+// an error for non-nullable fields. Keys not in the schema are ignored.
+//
+// Reader supports boolean, integer, floating-point, string, list and
+// struct fields. JSON numbers that do not fit in the type of their field
+// are an error.
+type Reader struct {
+	r      *bufio.Reader
+	schema *arrow.Schema
+
+	refs int64
+	bld  *array.RecordBuilder
+	cur  array.Record
+	err  error
+
+	chunk int
+	done  bool
+	line  int // number of the last line read.
+
+	mem memory.Allocator
+}
+
+// NewReader returns a reader that reads from the JSON file and creates
+// array.Records from the given schema.
+func NewReader(r io.Reader, schema *arrow.Schema, opts ...Option) *Reader {
+	rr := &Reader{r: bufio.NewReader(r), schema: schema, refs: 1, chunk: 1}
+	for _, opt := range opts {
+		opt(rr)
+	}
+
+	if rr.mem == nil {
+		rr.mem = memory.DefaultAllocator
+	}
+
+	rr.bld = array.NewRecordBuilder(rr.mem, rr.schema)
+	return rr
+}
+
+// Err returns the last error encountered during the iteration over the
+// underlying JSON file.
+func (r *Reader) Err() error { return r.err }
+
+func (r *Reader) Schema() *arrow.Schema { return r.schema }
+
+// Record returns the current record that has been extracted from the
+// underlying JSON file.
+// It is valid until the next call to Next.
+func (r *Reader) Record() array.Record { return r.cur }
+
+// Next returns whether a Record could be extracted from the underlying JSON file.
+//
+// When an invalid line is encountered, Next returns false and the rows
+// read so far for the current record are discarded. Err then reports the
+// invalid line.
+func (r *Reader) Next() bool {
+	if r.cur != nil {
+		r.cur.Release()
+		r.cur = nil
+	}
+
+	if r.err != nil || r.done {
+		return false
+	}
+
+	limit := r.chunk
+	if limit == 0 {
+		limit = 1
+	}
+
+	n := 0
+	for limit < 0 || n < limit {
+		ok := r.readLine()
+		if r.err != nil {
+			// discard the partially built record.
+			r.bld.Release()
+			r.bld = array.NewRecordBuilder(r.mem, r.schema)
+			r.done = true
+			return false
+		}
+		if !ok {
+			r.done = true
+			break
+		}
+		n++
+	}
+
+	if n == 0 {
+		return false
+	}
+
+	r.cur = r.bld.NewRecord()
+	return true
+}
+
+// readLine reads the next non-empty line and appends its values to the
+// record builder. readLine returns false at the end of the file.
+func (r *Reader) readLine() bool {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			r.err = fmt.Errorf("arrow/json: could not read line %d: %v", r.line+1, err)
+			return false
+		}
+		if len(line) > 0 {
+			r.line++
+		}
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			if err == io.EOF {
+				return false
+			}
+			continue
+		}
+
+		if err := r.read(line); err != nil {
+			r.err = fmt.Errorf("arrow/json: line %d: %v", r.line, err)
+			return false
+		}
+		return true
+	}
+}
+
+func (r *Reader) read(line []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+
+	var obj map[string]interface{}
+	if err := dec.Decode(&obj); err != nil {
+		return fmt.Errorf("could not decode JSON object: %v", err)
+	}
+	if obj == nil {
+		return fmt.Errorf("invalid JSON object %q", line)
+	}
+
+	for i, field := range r.schema.Fields() {
+		if err := appendValue(r.bld.Field(i), field, obj[field.Name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendValue appends the decoded JSON value v to the builder b of the
+// provided field.
+func appendValue(b array.Builder, field arrow.Field, v interface{}) error {
+	if v == nil {
+		if !field.Nullable {
+			return fmt.Errorf("missing value for non-nullable field %q", field.Name)
+		}
+		b.AppendNull()
+		return nil
+	}
+
+	invalid := func() error {
+		return fmt.Errorf("field %q: invalid value %v for type %v", field.Name, v, field.Type)
+	}
+
+	switch b := b.(type) {
+	case *array.BooleanBuilder:
+		v, ok := v.(bool)
+		if !ok {
+			return invalid()
+		}
+		b.Append(v)
+
+	case *array.Int8Builder:
+		v, err := parseInt(field, v, 8)
+		if err != nil {
+			return err
+		}
+		b.Append(int8(v))
+	case *array.Int16Builder:
+		v, err := parseInt(field, v, 16)
+		if err != nil {
+			return err
+		}
+		b.Append(int16(v))
+	case *array.Int32Builder:
+		v, err := parseInt(field, v, 32)
+		if err != nil {
+			return err
+		}
+		b.Append(int32(v))
+	case *array.Int64Builder:
+		v, err := parseInt(field, v, 64)
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+
+	case *array.Uint8Builder:
+		v, err := parseUint(field, v, 8)
+		if err != nil {
+			return err
+		}
+		b.Append(uint8(v))
+	case *array.Uint16Builder:
+		v, err := parseUint(field, v, 16)
+		if err != nil {
+			return err
+		}
+		b.Append(uint16(v))
+	case *array.Uint32Builder:
+		v, err := parseUint(field, v, 32)
+		if err != nil {
+			return err
+		}
+		b.Append(uint32(v))
+	case *array.Uint64Builder:
+		v, err := parseUint(field, v, 64)
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+
+	case *array.Float32Builder:
+		v, err := parseFloat(field, v, 32)
+		if err != nil {
+			return err
+		}
+		b.Append(float32(v))
+	case *array.Float64Builder:
+		v, err := parseFloat(field, v, 64)
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+
+	case *array.StringBuilder:
+		v, ok := v.(string)
+		if !ok {
+			return invalid()
+		}
+		b.Append(v)
+
+	case *array.ListBuilder:
+		vs, ok := v.([]interface{})
+		if !ok {
+			return invalid()
+		}
+		elem := arrow.Field{Name: field.Name, Type: field.Type.(*arrow.ListType).Elem(), Nullable: true}
+		b.Append(true)
+		for i, v := range vs {
+			if err := appendValue(b.ValueBuilder(), elem, v); err != nil {
+				return fmt.Errorf("element %d: %v", i, err)
+			}
+		}
+
+	case *array.StructBuilder:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return invalid()
+		}
+		b.Append(true)
+		for i, f := range field.Type.(*arrow.StructType).Fields() {
+			if err := appendValue(b.FieldBuilder(i), f, obj[f.Name]); err != nil {
+				return fmt.Errorf("field %q: %v", field.Name, err)
+			}
+		}
+
+	default:
+		return fmt.Errorf("field %q: unsupported data type %v", field.Name, field.Type)
+	}
+
+	return nil
+}
+
+func parseInt(field arrow.Field, v interface{}, bitSize int) (int64, error) {
+	num, ok := v.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("field %q: invalid value %v for type %v", field.Name, v, field.Type)
+	}
+	i, err := strconv.ParseInt(string(num), 10, bitSize)
+	if err != nil {
+		if e, ok := err.(*strconv.NumError); ok && e.Err == strconv.ErrRange {
+			return 0, fmt.Errorf("field %q: value %v overflows type %v", field.Name, num, field.Type)
+		}
+		return 0, fmt.Errorf("field %q: invalid value %v for type %v", field.Name, num, field.Type)
+	}
+	return i, nil
+}
+
+func parseUint(field arrow.Field, v interface{}, bitSize int) (uint64, error) {
+	num, ok := v.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("field %q: invalid value %v for type %v", field.Name, v, field.Type)
+	}
+	u, err := strconv.ParseUint(string(num), 10, bitSize)
+	if err != nil {
+		if e, ok := err.(*strconv.NumError); ok && e.Err == strconv.ErrRange {
+			return 0, fmt.Errorf("field %q: value %v overflows type %v", field.Name, num, field.Type)
+		}
+		return 0, fmt.Errorf("field %q: invalid value %v for type %v", field.Name, num, field.Type)
+	}
+	return u, nil
+}
+
+func parseFloat(field arrow.Field, v interface{}, bitSize int) (float64, error) {
+	num, ok := v.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("field %q: invalid value %v for type %v", field.Name, v, field.Type)
+	}
+	f, err := strconv.ParseFloat(string(num), bitSize)
+	if err != nil || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("field %q: value %v overflows type %v", field.Name, num, field.Type)
+	}
+	return f, nil
+}
+
+// Retain increases the reference count by 1.
+// Retain may be called simultaneously from multiple goroutines.
+func (r *Reader) Retain() {
+	atomic.AddInt64(&r.refs, 1)
+}
+
+// Release decreases the reference count by 1.
+// When the reference count goes to zero, the memory is freed.
+// Release may be called simultaneously from multiple goroutines.
+func (r *Reader) Release() {
+	debug.Assert(atomic.LoadInt64(&r.refs) > 0, "too many releases")
+
+	if atomic.AddInt64(&r.refs, -1) == 0 {
+		if r.cur != nil {
+			r.cur.Release()
+			r.cur = nil
+		}
+		if r.bld != nil {
+			r.bld.Release()
+			r.bld = nil
+		}
+	}
+}
+
+var (
+	_ array.RecordReader = (*Reader)(nil)
+)
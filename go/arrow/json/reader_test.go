@@ -0,0 +1,215 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/json"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func Example() {
+	f := strings.NewReader(`{"i64": 1, "f64": 1.5, "str": "one", "tags": ["a", "b"]}
+{"i64": 2, "f64": null, "str": "two", "tags": []}
+{"i64": 3, "str": "three", "extra": true}
+`)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i64", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "str", Type: arrow.BinaryTypes.String},
+			{Name: "tags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+		},
+		nil,
+	)
+	r := json.NewReader(f, schema, json.WithChunk(2))
+	defer r.Release()
+
+	n := 0
+	for r.Next() {
+		rec := r.Record()
+		for i, col := range rec.Columns() {
+			fmt.Printf("rec[%d][%q]: %v\n", n, rec.ColumnName(i), col)
+		}
+		n++
+	}
+	if err := r.Err(); err != nil {
+		fmt.Printf("error: %v\n", err)
+	}
+
+	// Output:
+	// rec[0]["i64"]: [1 2]
+	// rec[0]["f64"]: [1.5 (null)]
+	// rec[0]["str"]: ["one" "two"]
+	// rec[0]["tags"]: [["a" "b"] []]
+	// rec[1]["i64"]: [3]
+	// rec[1]["f64"]: [(null)]
+	// rec[1]["str"]: ["three"]
+	// rec[1]["tags"]: [(null)]
+}
+
+func TestReader(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "b", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+			{Name: "i8", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+			{Name: "u32", Type: arrow.PrimitiveTypes.Uint32, Nullable: true},
+			{Name: "f32", Type: arrow.PrimitiveTypes.Float32, Nullable: true},
+			{Name: "s", Type: arrow.StructOf(
+				arrow.Field{Name: "x", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+				arrow.Field{Name: "y", Type: arrow.BinaryTypes.String, Nullable: true},
+			), Nullable: true},
+		},
+		nil,
+	)
+
+	const data = `{"b": true, "i8": -128, "u32": 4294967295, "f32": 0.5, "s": {"x": 1, "y": "a"}}
+
+{"b": false, "i8": 127, "s": {"y": "b"}}
+{"s": null}
+{"b": null, "i8": null, "u32": 0, "f32": -2, "s": {"x": -1}}`
+
+	for _, tc := range []struct {
+		name  string
+		chunk int
+		want  []string
+	}{
+		{
+			name:  "chunk-all",
+			chunk: -1,
+			want: []string{
+				`[true false (null) (null)]|[-128 127 (null) (null)]|[4294967295 (null) (null) 0]|[0.5 (null) (null) -2]|{[1 (null) (null) -1] ["a" "b" (null) (null)]}`,
+			},
+		},
+		{
+			name:  "chunk-3",
+			chunk: 3,
+			want: []string{
+				`[true false (null)]|[-128 127 (null)]|[4294967295 (null) (null)]|[0.5 (null) (null)]|{[1 (null) (null)] ["a" "b" (null)]}`,
+				`[(null)]|[(null)]|[0]|[-2]|{[-1] [(null)]}`,
+			},
+		},
+		{
+			name:  "chunk-1",
+			chunk: 0,
+			want: []string{
+				`[true]|[-128]|[4294967295]|[0.5]|{[1] ["a"]}`,
+				`[false]|[127]|[(null)]|[(null)]|{[(null)] ["b"]}`,
+				`[(null)]|[(null)]|[(null)]|[(null)]|{[(null)] [(null)]}`,
+				`[(null)]|[(null)]|[0]|[-2]|{[-1] [(null)]}`,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := json.NewReader(strings.NewReader(data), schema, json.WithChunk(tc.chunk), json.WithAllocator(mem))
+			defer r.Release()
+
+			var got []string
+			for r.Next() {
+				rec := r.Record()
+				cols := make([]string, rec.NumCols())
+				for i, col := range rec.Columns() {
+					cols[i] = fmt.Sprintf("%v", col)
+				}
+				got = append(got, strings.Join(cols, "|"))
+			}
+			if err := r.Err(); err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("invalid number of records: got=%d, want=%d\n%v", len(got), len(tc.want), got)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Fatalf("invalid record %d:\ngot= %s\nwant=%s", i, got[i], tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "i8", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+			{Name: "s", Type: arrow.BinaryTypes.String},
+		},
+		nil,
+	)
+
+	for _, tc := range []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "overflow",
+			data: "{\"i8\": 1, \"s\": \"a\"}\n{\"i8\": 128, \"s\": \"b\"}\n",
+			want: `arrow/json: line 2: field "i8": value 128 overflows type int8`,
+		},
+		{
+			name: "missing-non-nullable",
+			data: "{\"i8\": 1, \"s\": \"a\"}\n\n{\"i8\": 2}\n",
+			want: `arrow/json: line 3: missing value for non-nullable field "s"`,
+		},
+		{
+			name: "invalid-type",
+			data: `{"i8": "1", "s": "a"}`,
+			want: `arrow/json: line 1: field "i8": invalid value 1 for type int8`,
+		},
+		{
+			name: "fractional-int",
+			data: `{"i8": 1.5, "s": "a"}`,
+			want: `arrow/json: line 1: field "i8": invalid value 1.5 for type int8`,
+		},
+		{
+			name: "invalid-json",
+			data: `{"i8": 1, "s": "a"`,
+			want: `arrow/json: line 1: could not decode JSON object: unexpected EOF`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := json.NewReader(strings.NewReader(tc.data), schema, json.WithChunk(-1), json.WithAllocator(mem))
+			defer r.Release()
+
+			if r.Next() {
+				t.Fatalf("expected no record")
+			}
+			if r.Err() == nil {
+				t.Fatalf("expected an error")
+			}
+			if got, want := r.Err().Error(), tc.want; got != want {
+				t.Fatalf("invalid error:\ngot= %s\nwant=%s", got, want)
+			}
+			if r.Next() {
+				t.Fatalf("expected no more records after an error")
+			}
+		})
+	}
+}
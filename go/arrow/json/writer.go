@@ -0,0 +1,182 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// Writer writes array.Records as newline-delimited JSON data, one JSON
+// object per row, keyed by the names of the schema fields.
+//
+// List and struct values are written as JSON arrays and objects.
+type Writer struct {
+	w      *bufio.Writer
+	schema *arrow.Schema
+
+	omitNulls bool
+	prec      int
+	buf       []byte
+}
+
+// NewWriter returns a writer that writes array.Records to the JSON file
+// with the given schema.
+func NewWriter(w io.Writer, schema *arrow.Schema, opts ...Option) *Writer {
+	ww := &Writer{w: bufio.NewWriter(w), schema: schema, prec: -1}
+	for _, opt := range opts {
+		opt(ww)
+	}
+
+	return ww
+}
+
+func (w *Writer) Schema() *arrow.Schema { return w.schema }
+
+// Write writes all the rows of a single Record to the JSON file.
+//
+// Write returns an error if the record schema does not match the writer
+// schema, if a column has an unsupported data type or if a floating-point
+// value is NaN or infinite.
+func (w *Writer) Write(record array.Record) error {
+	if !record.Schema().Equal(w.schema) {
+		return fmt.Errorf("arrow/json: record schema does not match writer schema")
+	}
+
+	for i := 0; i < int(record.NumRows()); i++ {
+		w.buf = w.buf[:0]
+		if err := w.appendObject(record.Schema().Fields(), record.Columns(), i); err != nil {
+			return fmt.Errorf("arrow/json: row %d: %v", i, err)
+		}
+		w.buf = append(w.buf, '\n')
+		if _, err := w.w.Write(w.buf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// appendObject appends the i-th elements of cols as a JSON object, keyed by
+// the names of the fields.
+func (w *Writer) appendObject(fields []arrow.Field, cols []array.Interface, i int) error {
+	w.buf = append(w.buf, '{')
+	n := 0
+	for j, col := range cols {
+		if w.omitNulls && col.IsNull(i) {
+			continue
+		}
+		if n > 0 {
+			w.buf = append(w.buf, ',')
+		}
+		w.buf = appendString(w.buf, fields[j].Name)
+		w.buf = append(w.buf, ':')
+		if err := w.appendValue(col, i); err != nil {
+			return fmt.Errorf("field %q: %v", fields[j].Name, err)
+		}
+		n++
+	}
+	w.buf = append(w.buf, '}')
+	return nil
+}
+
+func (w *Writer) appendValue(arr array.Interface, i int) error {
+	if arr.IsNull(i) {
+		w.buf = append(w.buf, "null"...)
+		return nil
+	}
+
+	switch arr := arr.(type) {
+	case *array.Boolean:
+		w.buf = strconv.AppendBool(w.buf, arr.Value(i))
+	case *array.Int8:
+		w.buf = strconv.AppendInt(w.buf, int64(arr.Value(i)), 10)
+	case *array.Int16:
+		w.buf = strconv.AppendInt(w.buf, int64(arr.Value(i)), 10)
+	case *array.Int32:
+		w.buf = strconv.AppendInt(w.buf, int64(arr.Value(i)), 10)
+	case *array.Int64:
+		w.buf = strconv.AppendInt(w.buf, arr.Value(i), 10)
+	case *array.Uint8:
+		w.buf = strconv.AppendUint(w.buf, uint64(arr.Value(i)), 10)
+	case *array.Uint16:
+		w.buf = strconv.AppendUint(w.buf, uint64(arr.Value(i)), 10)
+	case *array.Uint32:
+		w.buf = strconv.AppendUint(w.buf, uint64(arr.Value(i)), 10)
+	case *array.Uint64:
+		w.buf = strconv.AppendUint(w.buf, arr.Value(i), 10)
+	case *array.Float32:
+		return w.appendFloat(float64(arr.Value(i)), 32)
+	case *array.Float64:
+		return w.appendFloat(arr.Value(i), 64)
+	case *array.String:
+		w.buf = appendString(w.buf, arr.Value(i))
+	case *array.List:
+		offsets := arr.Offsets()[arr.Offset()+i:]
+		return w.appendList(arr.ListValues(), int(offsets[0]), int(offsets[1]))
+	case *array.Struct:
+		dtype := arr.DataType().(*arrow.StructType)
+		cols := make([]array.Interface, arr.NumField())
+		for j := range cols {
+			cols[j] = arr.Field(j)
+		}
+		return w.appendObject(dtype.Fields(), cols, i)
+	default:
+		return fmt.Errorf("unsupported data type %v", arr.DataType())
+	}
+	return nil
+}
+
+func (w *Writer) appendFloat(v float64, bitSize int) error {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Errorf("unsupported value %v", v)
+	}
+	w.buf = strconv.AppendFloat(w.buf, v, 'g', w.prec, bitSize)
+	return nil
+}
+
+func (w *Writer) appendList(values array.Interface, beg, end int) error {
+	w.buf = append(w.buf, '[')
+	for j := beg; j < end; j++ {
+		if j > beg {
+			w.buf = append(w.buf, ',')
+		}
+		if err := w.appendValue(values, j); err != nil {
+			return err
+		}
+	}
+	w.buf = append(w.buf, ']')
+	return nil
+}
+
+func appendString(buf []byte, s string) []byte {
+	// json.Marshal can not fail on strings.
+	v, _ := json.Marshal(s)
+	return append(buf, v...)
+}
@@ -0,0 +1,187 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/json"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func writerTestRecord(mem memory.Allocator) array.Record {
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "b", Type: arrow.FixedWidthTypes.Boolean},
+			{Name: "i64", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			{Name: "u8", Type: arrow.PrimitiveTypes.Uint8},
+			{Name: "f64", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+			{Name: "str", Type: arrow.BinaryTypes.String, Nullable: true},
+			{Name: "list", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32), Nullable: true},
+			{Name: "struct", Type: arrow.StructOf(
+				arrow.Field{Name: "x", Type: arrow.PrimitiveTypes.Float32, Nullable: true},
+				arrow.Field{Name: "y", Type: arrow.BinaryTypes.String, Nullable: true},
+			), Nullable: true},
+		},
+		nil,
+	)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	b.Field(0).(*array.BooleanBuilder).AppendValues([]bool{true, false, true}, nil)
+	b.Field(1).(*array.Int64Builder).AppendValues([]int64{-1, 0, math.MaxInt64}, []bool{true, false, true})
+	b.Field(2).(*array.Uint8Builder).AppendValues([]uint8{0, 1, 255}, nil)
+	b.Field(3).(*array.Float64Builder).AppendValues([]float64{1.5, -0.1, 0}, []bool{true, true, false})
+	b.Field(4).(*array.StringBuilder).AppendValues([]string{"a \"quoted\" string", "", "é\n"}, []bool{true, true, false})
+
+	lb := b.Field(5).(*array.ListBuilder)
+	lvb := lb.ValueBuilder().(*array.Int32Builder)
+	lb.Append(true)
+	lvb.AppendValues([]int32{1, 2}, []bool{true, false})
+	lb.AppendNull()
+	lb.Append(true)
+
+	sb := b.Field(6).(*array.StructBuilder)
+	sb.Append(true)
+	sb.FieldBuilder(0).(*array.Float32Builder).Append(0.25)
+	sb.FieldBuilder(1).(*array.StringBuilder).AppendNull()
+	sb.AppendNull()
+	sb.Append(true)
+	sb.FieldBuilder(0).(*array.Float32Builder).Append(-3)
+	sb.FieldBuilder(1).(*array.StringBuilder).Append("z")
+
+	return b.NewRecord()
+}
+
+func TestWriter(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	rec := writerTestRecord(mem)
+	defer rec.Release()
+
+	for _, tc := range []struct {
+		name string
+		opts []json.Option
+		want string
+	}{
+		{
+			name: "default",
+			want: `{"b":true,"i64":-1,"u8":0,"f64":1.5,"str":"a \"quoted\" string","list":[1,null],"struct":{"x":0.25,"y":null}}
+{"b":false,"i64":null,"u8":1,"f64":-0.1,"str":"","list":null,"struct":null}
+{"b":true,"i64":9223372036854775807,"u8":255,"f64":null,"str":null,"list":[],"struct":{"x":-3,"y":"z"}}
+`,
+		},
+		{
+			name: "omit-nulls",
+			opts: []json.Option{json.WithOmitNulls(true)},
+			want: `{"b":true,"i64":-1,"u8":0,"f64":1.5,"str":"a \"quoted\" string","list":[1,null],"struct":{"x":0.25}}
+{"b":false,"u8":1,"f64":-0.1,"str":""}
+{"b":true,"i64":9223372036854775807,"u8":255,"list":[],"struct":{"x":-3,"y":"z"}}
+`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o := new(bytes.Buffer)
+			w := json.NewWriter(o, rec.Schema(), tc.opts...)
+			if err := w.Write(rec); err != nil {
+				t.Fatalf("could not write record: %+v", err)
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatalf("could not flush: %+v", err)
+			}
+
+			if got, want := o.String(), tc.want; got != want {
+				t.Fatalf("invalid output:\ngot:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestWriterFloatPrecision(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "f", Type: arrow.PrimitiveTypes.Float64}}, nil)
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+	b.Field(0).(*array.Float64Builder).AppendValues([]float64{math.Pi, 1e21, 0.5}, nil)
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	o := new(bytes.Buffer)
+	w := json.NewWriter(o, schema, json.WithFloatPrecision(3))
+	if err := w.Write(rec); err != nil {
+		t.Fatalf("could not write record: %+v", err)
+	}
+	w.Flush()
+
+	want := "{\"f\":3.14}\n{\"f\":1e+21}\n{\"f\":0.5}\n"
+	if got := o.String(); got != want {
+		t.Fatalf("invalid output:\ngot= %q\nwant=%q", got, want)
+	}
+
+	b.Field(0).(*array.Float64Builder).Append(math.NaN())
+	nan := b.NewRecord()
+	defer nan.Release()
+
+	if err := w.Write(nan); err == nil {
+		t.Fatalf("expected an error on NaN values")
+	}
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	rec := writerTestRecord(mem)
+	defer rec.Release()
+
+	for _, omit := range []bool{false, true} {
+		o := new(bytes.Buffer)
+		w := json.NewWriter(o, rec.Schema(), json.WithOmitNulls(omit))
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("could not write record: %+v", err)
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatalf("could not flush: %+v", err)
+		}
+
+		r := json.NewReader(o, rec.Schema(), json.WithChunk(-1), json.WithAllocator(mem))
+		defer r.Release()
+
+		if !r.Next() {
+			t.Fatalf("could not read back record: %+v", r.Err())
+		}
+		got := r.Record()
+
+		if got, want := got.NumRows(), rec.NumRows(); got != want {
+			t.Fatalf("invalid number of rows: got=%d, want=%d", got, want)
+		}
+		for i, col := range rec.Columns() {
+			if !array.ArrayEqual(got.Column(i), col) {
+				t.Fatalf("omit=%v: column %q differs:\ngot= %v\nwant=%v", omit, rec.ColumnName(i), got.Column(i), col)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package math
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// SumChunked returns the summation of all the non-null elements of the
+// chunks of c, as a float64.
+//
+// SumChunked supports integer and floating-point element types, and returns
+// an error for other element types.
+func SumChunked(c *array.Chunked) (float64, error) {
+	acc := float64(0)
+	for _, chunk := range c.Chunks() {
+		v, err := sumValid(chunk)
+		if err != nil {
+			return 0, err
+		}
+		acc += v
+	}
+	return acc, nil
+}
+
+// sumValid returns the summation of the non-null elements of arr.
+func sumValid(arr array.Interface) (float64, error) {
+	acc := float64(0)
+	switch arr := arr.(type) {
+	case *array.Int8:
+		for i, v := range arr.Int8Values() {
+			if arr.IsValid(i) {
+				acc += float64(v)
+			}
+		}
+	case *array.Int16:
+		for i, v := range arr.Int16Values() {
+			if arr.IsValid(i) {
+				acc += float64(v)
+			}
+		}
+	case *array.Int32:
+		for i, v := range arr.Int32Values() {
+			if arr.IsValid(i) {
+				acc += float64(v)
+			}
+		}
+	case *array.Int64:
+		for i, v := range arr.Int64Values() {
+			if arr.IsValid(i) {
+				acc += float64(v)
+			}
+		}
+	case *array.Uint8:
+		for i, v := range arr.Uint8Values() {
+			if arr.IsValid(i) {
+				acc += float64(v)
+			}
+		}
+	case *array.Uint16:
+		for i, v := range arr.Uint16Values() {
+			if arr.IsValid(i) {
+				acc += float64(v)
+			}
+		}
+	case *array.Uint32:
+		for i, v := range arr.Uint32Values() {
+			if arr.IsValid(i) {
+				acc += float64(v)
+			}
+		}
+	case *array.Uint64:
+		for i, v := range arr.Uint64Values() {
+			if arr.IsValid(i) {
+				acc += float64(v)
+			}
+		}
+	case *array.Float32:
+		for i, v := range arr.Float32Values() {
+			if arr.IsValid(i) {
+				acc += float64(v)
+			}
+		}
+	case *array.Float64:
+		if arr.NullN() == 0 {
+			return Float64.Sum(arr), nil
+		}
+		for i, v := range arr.Float64Values() {
+			if arr.IsValid(i) {
+				acc += v
+			}
+		}
+	default:
+		return 0, fmt.Errorf("arrow/math: unsupported data type %v", arr.DataType())
+	}
+	return acc, nil
+}
@@ -0,0 +1,143 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package math_test
+
+import (
+	stdmath "math"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/math"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSumChunked(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	for _, tc := range []struct {
+		dtype  arrow.DataType
+		chunks func(b array.Builder)
+	}{
+		{
+			dtype: arrow.PrimitiveTypes.Int64,
+			chunks: func(b array.Builder) {
+				b.(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+			},
+		},
+		{
+			dtype: arrow.PrimitiveTypes.Int8,
+			chunks: func(b array.Builder) {
+				b.(*array.Int8Builder).AppendValues([]int8{1, 2, 3}, nil)
+			},
+		},
+		{
+			dtype: arrow.PrimitiveTypes.Uint32,
+			chunks: func(b array.Builder) {
+				b.(*array.Uint32Builder).AppendValues([]uint32{1, 2, 3}, nil)
+			},
+		},
+		{
+			dtype: arrow.PrimitiveTypes.Float64,
+			chunks: func(b array.Builder) {
+				b.(*array.Float64Builder).AppendValues([]float64{1, 2, 3}, nil)
+			},
+		},
+	} {
+		t.Run(tc.dtype.Name(), func(t *testing.T) {
+			b := array.NewBuilder(mem, tc.dtype)
+			defer b.Release()
+
+			// first chunk: 1+2+3, with a null hiding a value.
+			tc.chunks(b)
+			b.AppendNull()
+			first := b.NewArray()
+			defer first.Release()
+
+			// middle chunk: all nulls.
+			for i := 0; i < 5; i++ {
+				b.AppendNull()
+			}
+			middle := b.NewArray()
+			defer middle.Release()
+
+			// last chunk: 1+2+3, sliced to 2+3.
+			tc.chunks(b)
+			full := b.NewArray()
+			defer full.Release()
+			last := array.NewSlice(full, 1, 3)
+			defer last.Release()
+
+			chunked := array.NewChunked(tc.dtype, []array.Interface{first, middle, last})
+			defer chunked.Release()
+
+			got, err := math.SumChunked(chunked)
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			assert.Equal(t, float64(11), got)
+		})
+	}
+
+	t.Run("overflow", func(t *testing.T) {
+		i64 := array.NewInt64Builder(mem)
+		defer i64.Release()
+		i64.AppendValues([]int64{stdmath.MaxInt64, stdmath.MaxInt64}, nil)
+		i64s := i64.NewArray()
+		defer i64s.Release()
+
+		u64 := array.NewUint64Builder(mem)
+		defer u64.Release()
+		u64.AppendValues([]uint64{stdmath.MaxUint64, stdmath.MaxUint64}, nil)
+		u64s := u64.NewArray()
+		defer u64s.Release()
+
+		for _, arr := range []array.Interface{i64s, u64s} {
+			chunked := array.NewChunked(arr.DataType(), []array.Interface{arr, arr})
+			defer chunked.Release()
+
+			got, err := math.SumChunked(chunked)
+			assert.NoError(t, err)
+			assert.True(t, got > 0, "sum of %v wrapped around: %v", arr.DataType(), got)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		chunked := array.NewChunked(arrow.PrimitiveTypes.Float32, nil)
+		defer chunked.Release()
+
+		got, err := math.SumChunked(chunked)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(0), got)
+	})
+
+	t.Run("non-numeric", func(t *testing.T) {
+		b := array.NewStringBuilder(mem)
+		defer b.Release()
+		b.Append("a")
+		arr := b.NewArray()
+		defer arr.Release()
+
+		chunked := array.NewChunked(arrow.BinaryTypes.String, []array.Interface{arr})
+		defer chunked.Release()
+
+		_, err := math.SumChunked(chunked)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,111 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package math
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// Quantile returns the q-quantile of the non-null elements of arr, with q
+// in [0, 1], linearly interpolating between the two closest ranks.
+// NaN elements are ignored, as null elements are.
+//
+// Quantile sorts a copy of the elements: it is exact, and runs in
+// O(n log n) time and O(n) space.
+// Quantile returns an error if q is out of range or if arr holds no
+// element to rank.
+func Quantile(arr *array.Float64, q float64) (float64, error) {
+	if !(q >= 0 && q <= 1) {
+		return 0, fmt.Errorf("arrow/math: quantile %v out of range [0, 1]", q)
+	}
+
+	vs := validValues(arr, func(v float64) bool { return !math.IsNaN(v) })
+	if len(vs) == 0 {
+		return 0, errors.New("arrow/math: quantile of empty array")
+	}
+	sort.Float64s(vs)
+
+	pos := q * float64(len(vs)-1)
+	lo := math.Floor(pos)
+	i := int(lo)
+	if i == len(vs)-1 {
+		return vs[i], nil
+	}
+	return vs[i] + (vs[i+1]-vs[i])*(pos-lo), nil
+}
+
+// Histogram counts the non-null finite elements of arr into bins
+// equal-width bins spanning their minimum and maximum.
+// The i-th bin holds the elements in [edges[i], edges[i+1]), except for the
+// last bin which also holds the maximum.
+// When all the counted elements are equal, they are all counted in the
+// first bin, and all the edges are equal.
+//
+// Histogram returns empty results if arr holds no element to count.
+// Histogram panics if bins is not strictly positive.
+func Histogram(arr *array.Float64, bins int) (edges []float64, counts []int64) {
+	if bins <= 0 {
+		panic(fmt.Errorf("arrow/math: invalid number of bins %d", bins))
+	}
+
+	vs := validValues(arr, func(v float64) bool { return !math.IsNaN(v) && !math.IsInf(v, 0) })
+	if len(vs) == 0 {
+		return nil, nil
+	}
+
+	min, max := vs[0], vs[0]
+	for _, v := range vs[1:] {
+		min = math.Min(min, v)
+		max = math.Max(max, v)
+	}
+
+	width := (max - min) / float64(bins)
+	edges = make([]float64, bins+1)
+	for i := range edges {
+		edges[i] = min + float64(i)*width
+	}
+	edges[bins] = max
+
+	counts = make([]int64, bins)
+	for _, v := range vs {
+		i := 0
+		if width > 0 {
+			i = int((v - min) / width)
+		}
+		if i >= bins {
+			i = bins - 1
+		}
+		counts[i]++
+	}
+	return edges, counts
+}
+
+// validValues returns a copy of the non-null elements of arr accepted by keep.
+func validValues(arr *array.Float64, keep func(v float64) bool) []float64 {
+	vs := make([]float64, 0, arr.Len()-arr.NullN())
+	for i, v := range arr.Float64Values() {
+		if arr.IsValid(i) && keep(v) {
+			vs = append(vs, v)
+		}
+	}
+	return vs
+}
@@ -0,0 +1,114 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package math_test
+
+import (
+	stdmath "math"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/math"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+func newFloat64(mem memory.Allocator, vs []float64, valid []bool) *array.Float64 {
+	b := array.NewFloat64Builder(mem)
+	defer b.Release()
+	b.AppendValues(vs, valid)
+	return b.NewFloat64Array()
+}
+
+func TestQuantile(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	// valid elements, sorted: 1 2 3 4 10.
+	arr := newFloat64(mem,
+		[]float64{4, 99, 1, 10, stdmath.NaN(), 3, 2},
+		[]bool{true, false, true, true, true, true, true},
+	)
+	defer arr.Release()
+
+	for _, tc := range []struct {
+		q    float64
+		want float64
+	}{
+		{0, 1},
+		{0.25, 2},
+		{0.5, 3},
+		{0.6, 3.4},
+		{0.9, 7.6},
+		{1, 10},
+	} {
+		got, err := math.Quantile(arr, tc.q)
+		if err != nil {
+			t.Fatalf("q=%v: %+v", tc.q, err)
+		}
+		if stdmath.Abs(got-tc.want) > 1e-12 {
+			t.Fatalf("q=%v: got=%v, want=%v", tc.q, got, tc.want)
+		}
+	}
+
+	for _, q := range []float64{-0.1, 1.1, stdmath.NaN()} {
+		if _, err := math.Quantile(arr, q); err == nil {
+			t.Fatalf("q=%v: expected an error", q)
+		}
+	}
+
+	nulls := newFloat64(mem, []float64{1, 2}, []bool{false, false})
+	defer nulls.Release()
+	if _, err := math.Quantile(nulls, 0.5); err == nil {
+		t.Fatalf("expected an error for an all-null array")
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	arr := newFloat64(mem,
+		[]float64{0, 1, 2, 2.5, 99, 5, 7.5, 10, stdmath.Inf(1), stdmath.NaN()},
+		[]bool{true, true, true, true, false, true, true, true, true, true},
+	)
+	defer arr.Release()
+
+	edges, counts := math.Histogram(arr, 4)
+	if got, want := edges, []float64{0, 2.5, 5, 7.5, 10}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid edges: got=%v, want=%v", got, want)
+	}
+	if got, want := counts, []int64{3, 1, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid counts: got=%v, want=%v", got, want)
+	}
+
+	same := newFloat64(mem, []float64{3, 3, 3}, nil)
+	defer same.Release()
+	edges, counts = math.Histogram(same, 2)
+	if got, want := edges, []float64{3, 3, 3}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid edges: got=%v, want=%v", got, want)
+	}
+	if got, want := counts, []int64{3, 0}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid counts: got=%v, want=%v", got, want)
+	}
+
+	empty := newFloat64(mem, nil, nil)
+	defer empty.Release()
+	edges, counts = math.Histogram(empty, 3)
+	if len(edges) != 0 || len(counts) != 0 {
+		t.Fatalf("invalid histogram of empty array: edges=%v, counts=%v", edges, counts)
+	}
+}
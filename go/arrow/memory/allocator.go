@@ -30,4 +30,23 @@ type Allocator interface {
 // an Allocator is required.
 //
 // DefaultAllocator is safe to use from multiple goroutines.
+//
+// DefaultAllocator is used by the functions and constructors which do not
+// take an Allocator argument. It may be replaced with SetDefaultAllocator.
 var DefaultAllocator Allocator = NewGoAllocator()
+
+// SetDefaultAllocator replaces DefaultAllocator with mem and returns the
+// previous default allocator, so that tests may swap in a CheckedAllocator
+// and restore the previous one afterwards:
+//  defer memory.SetDefaultAllocator(memory.SetDefaultAllocator(mem))
+//
+// SetDefaultAllocator is not safe to call concurrently with any use of
+// DefaultAllocator: it must be called before starting the goroutines which
+// allocate memory, such as at the beginning of a test or of main.
+// Memory allocated with an allocator is freed with that same allocator,
+// even after the default allocator is replaced.
+func SetDefaultAllocator(mem Allocator) Allocator {
+	prev := DefaultAllocator
+	DefaultAllocator = mem
+	return prev
+}
@@ -28,6 +28,7 @@ type Buffer struct {
 	length   int
 	mutable  bool
 	mem      Allocator
+	parent   *Buffer // buffer sliced by this buffer, if any.
 }
 
 // NewBufferBytes creates a fixed-size buffer from the specified data.
@@ -35,6 +36,28 @@ func NewBufferBytes(data []byte) *Buffer {
 	return &Buffer{refCount: 0, buf: data, length: len(data)}
 }
 
+// NewBufferWithAllocator creates a fixed-size buffer from the specified data,
+// which is freed with mem once the buffer is released.
+func NewBufferWithAllocator(data []byte, mem Allocator) *Buffer {
+	return &Buffer{refCount: 1, buf: data, length: len(data), mem: mem}
+}
+
+// SliceBuffer returns an immutable buffer holding the length bytes of buf
+// starting at offset, sharing the memory of buf: no data is copied.
+//
+// The returned buffer retains buf, which is released when the returned
+// buffer is released: buf is thus not freed while the slice is alive.
+// Releasing the returned buffer more times than it was retained is a no-op.
+//
+// SliceBuffer panics if the slice is outside the range of buf.
+func SliceBuffer(buf *Buffer, offset, length int) *Buffer {
+	if offset < 0 || length < 0 || offset+length > buf.Len() {
+		panic("arrow/memory: buffer slice out of range")
+	}
+	buf.Retain()
+	return &Buffer{refCount: 1, buf: buf.Bytes()[offset : offset+length : offset+length], length: length, parent: buf}
+}
+
 // NewBuffer creates a mutable, resizable buffer with an Allocator for managing memory.
 func NewResizableBuffer(mem Allocator) *Buffer {
 	return &Buffer{refCount: 1, mutable: true, mem: mem}
@@ -42,7 +65,7 @@ func NewResizableBuffer(mem Allocator) *Buffer {
 
 // Retain increases the reference count by 1.
 func (b *Buffer) Retain() {
-	if b.mem != nil {
+	if b.mem != nil || b.parent != nil {
 		atomic.AddInt64(&b.refCount, 1)
 	}
 }
@@ -50,6 +73,14 @@ func (b *Buffer) Retain() {
 // Release decreases the reference count by 1.
 // When the reference count goes to zero, the memory is freed.
 func (b *Buffer) Release() {
+	if b.parent != nil {
+		if atomic.AddInt64(&b.refCount, -1) == 0 {
+			b.parent.Release()
+			b.parent, b.buf, b.length = nil, nil, 0
+		}
+		return
+	}
+
 	if b.mem != nil {
 		debug.Assert(atomic.LoadInt64(&b.refCount) > 0, "too many releases")
 
@@ -69,6 +100,8 @@ func (b *Buffer) Mutable() bool { return b.mutable }
 func (b *Buffer) Len() int      { return b.length }
 func (b *Buffer) Cap() int      { return len(b.buf) }
 
+// Reserve ensures the buffer can hold at least capacity bytes, reallocating
+// the underlying memory if needed. The length of the buffer is unchanged.
 func (b *Buffer) Reserve(capacity int) {
 	if capacity > len(b.buf) {
 		newCap := roundUpToMultipleOf64(capacity)
@@ -80,10 +113,15 @@ func (b *Buffer) Reserve(capacity int) {
 	}
 }
 
+// Resize sets the length of the buffer to newSize bytes.
+// Growing the buffer reserves the needed capacity and zero-fills the newly
+// exposed bytes. Shrinking the buffer releases the excess capacity.
 func (b *Buffer) Resize(newSize int) {
 	b.resize(newSize, true)
 }
 
+// ResizeNoShrink is like Resize but never releases capacity, so that a buffer
+// shrunk and grown again reuses its memory.
 func (b *Buffer) ResizeNoShrink(newSize int) {
 	b.resize(newSize, false)
 }
@@ -91,6 +129,9 @@ func (b *Buffer) ResizeNoShrink(newSize int) {
 func (b *Buffer) resize(newSize int, shrink bool) {
 	if !shrink || newSize > b.length {
 		b.Reserve(newSize)
+		if newSize > b.length {
+			Set(b.buf[b.length:newSize], 0)
+		}
 	} else {
 		// Buffer is not growing, so shrink to the requested size without
 		// excess space.
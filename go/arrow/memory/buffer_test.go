@@ -43,3 +43,109 @@ func TestNewResizableBuffer(t *testing.T) {
 	assert.Nil(t, buf.Bytes())
 	assert.Zero(t, buf.Len())
 }
+
+func TestBufferResize(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	buf := memory.NewResizableBuffer(mem)
+	defer buf.Release()
+
+	buf.Reserve(100)
+	assert.Equal(t, 0, buf.Len())
+	assert.Equal(t, 128, buf.Cap())
+
+	buf.Resize(100)
+	memory.Set(buf.Bytes(), 0xff)
+
+	// shrinking without releasing capacity, then growing again, reuses the
+	// memory and zero-fills the exposed bytes.
+	buf.ResizeNoShrink(10)
+	assert.Equal(t, 10, buf.Len())
+	assert.Equal(t, 128, buf.Cap())
+	mem.AssertSize(t, 128)
+
+	buf.ResizeNoShrink(100)
+	assert.Equal(t, 100, buf.Len())
+	assert.Equal(t, 128, buf.Cap())
+	mem.AssertSize(t, 128)
+	for i, v := range buf.Bytes() {
+		want := byte(0)
+		if i < 10 {
+			want = 0xff
+		}
+		if v != want {
+			t.Fatalf("invalid byte %d: got=%#x, want=%#x", i, v, want)
+		}
+	}
+
+	// Resize releases the excess capacity.
+	buf.Resize(10)
+	assert.Equal(t, 10, buf.Len())
+	assert.Equal(t, 64, buf.Cap())
+	mem.AssertSize(t, 64)
+
+	buf.Resize(0)
+	assert.Equal(t, 0, buf.Cap())
+	mem.AssertSize(t, 0)
+}
+
+func TestSliceBuffer(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	buf := memory.NewResizableBuffer(mem)
+	buf.Resize(10)
+	copy(buf.Bytes(), "0123456789")
+
+	slice := memory.SliceBuffer(buf, 2, 5)
+	assert.Equal(t, []byte("23456"), slice.Bytes())
+	assert.Equal(t, 5, slice.Len())
+	assert.False(t, slice.Mutable())
+
+	// the slice shares the memory of its parent.
+	buf.Bytes()[2] = 'x'
+	assert.Equal(t, []byte("x3456"), slice.Bytes())
+
+	sub := memory.SliceBuffer(slice, 1, 2)
+	assert.Equal(t, []byte("34"), sub.Bytes())
+	slice.Release()
+
+	// the parent is still alive, retained by the slice of the slice.
+	buf.Release()
+	assert.NotNil(t, buf.Bytes())
+	assert.Equal(t, 10, buf.Len())
+	mem.AssertSize(t, 64)
+
+	sub.Release()
+	assert.Nil(t, sub.Bytes())
+	assert.Nil(t, buf.Bytes())
+	mem.AssertSize(t, 0)
+
+	// releasing a released slice is a no-op.
+	sub.Release()
+	slice.Release()
+	mem.AssertSize(t, 0)
+
+	empty := memory.SliceBuffer(memory.NewBufferBytes([]byte("abc")), 3, 0)
+	assert.Equal(t, 0, len(empty.Bytes()))
+	empty.Release()
+
+	assert.Panics(t, func() { memory.SliceBuffer(memory.NewBufferBytes([]byte("abc")), 2, 2) })
+}
+
+func TestNewBufferWithAllocator(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	buf := memory.NewBufferWithAllocator(mem.Allocate(10), mem)
+	assert.Equal(t, 10, buf.Len())
+	assert.False(t, buf.Mutable())
+
+	buf.Retain()
+	buf.Release()
+	mem.AssertSize(t, 10)
+
+	buf.Release()
+	assert.Nil(t, buf.Bytes())
+}
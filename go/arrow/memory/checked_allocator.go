@@ -16,40 +16,109 @@
 
 package memory
 
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+)
+
+// CheckedAllocator is an Allocator that keeps track of every outstanding
+// allocation made through it, to detect memory leaks in tests.
+//
+// Freeing or reallocating memory that was not obtained from a CheckedAllocator
+// (or that was already freed) panics.
+//
+// CheckedAllocator is safe to use from multiple goroutines.
 type CheckedAllocator struct {
-	mem  Allocator
-	base int
+	mem Allocator
+
+	mu   sync.Mutex
 	sz   int
+	ptrs map[uintptr]int // size of each outstanding allocation, by address
 }
 
 func NewCheckedAllocator(mem Allocator) *CheckedAllocator {
-	return &CheckedAllocator{mem: mem}
+	return &CheckedAllocator{mem: mem, ptrs: make(map[uintptr]int)}
+}
+
+// CurrentAlloc returns the number of bytes currently allocated and not yet freed.
+func (a *CheckedAllocator) CurrentAlloc() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.sz
 }
 
 func (a *CheckedAllocator) Allocate(size int) []byte {
+	out := a.mem.Allocate(size)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	a.sz += size
-	return a.mem.Allocate(size)
+	a.track(out)
+	return out
 }
 
 func (a *CheckedAllocator) Reallocate(size int, b []byte) []byte {
+	a.untrack(b, "reallocate")
+
+	out := a.mem.Reallocate(size, b)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
 	a.sz += size - len(b)
-	return a.mem.Reallocate(size, b)
+	a.track(out)
+	return out
 }
 
 func (a *CheckedAllocator) Free(b []byte) {
+	a.untrack(b, "free")
+
+	a.mu.Lock()
 	a.sz -= len(b)
+	a.mu.Unlock()
+
 	a.mem.Free(b)
 }
 
+func (a *CheckedAllocator) track(b []byte) {
+	if cap(b) == 0 {
+		return
+	}
+	a.ptrs[checkedAddr(b)] = len(b)
+}
+
+func (a *CheckedAllocator) untrack(b []byte, op string) {
+	if cap(b) == 0 {
+		return
+	}
+	addr := checkedAddr(b)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	sz, ok := a.ptrs[addr]
+	switch {
+	case !ok:
+		panic(fmt.Errorf("arrow/memory: %s of unknown memory at %#x (size=%d)", op, addr, len(b)))
+	case sz != len(b):
+		panic(fmt.Errorf("arrow/memory: %s of memory at %#x with invalid size (got=%d, want=%d)", op, addr, len(b), sz))
+	}
+	delete(a.ptrs, addr)
+}
+
+func checkedAddr(b []byte) uintptr {
+	return uintptr(unsafe.Pointer(&b[:cap(b)][0]))
+}
+
 type TestingT interface {
 	Errorf(format string, args ...interface{})
 	Helper()
 }
 
+// AssertSize checks that sz bytes are currently allocated.
 func (a *CheckedAllocator) AssertSize(t TestingT, sz int) {
-	if a.sz != sz {
+	if cur := a.CurrentAlloc(); cur != sz {
 		t.Helper()
-		t.Errorf("invalid memory size exp=%d, got=%d", sz, a.sz)
+		t.Errorf("invalid memory size exp=%d, got=%d", sz, cur)
 	}
 }
 
@@ -59,13 +128,13 @@ type CheckedAllocatorScope struct {
 }
 
 func NewCheckedAllocatorScope(alloc *CheckedAllocator) *CheckedAllocatorScope {
-	return &CheckedAllocatorScope{alloc: alloc, sz: alloc.sz}
+	return &CheckedAllocatorScope{alloc: alloc, sz: alloc.CurrentAlloc()}
 }
 
 func (c *CheckedAllocatorScope) CheckSize(t TestingT) {
-	if c.sz != c.alloc.sz {
+	if cur := c.alloc.CurrentAlloc(); c.sz != cur {
 		t.Helper()
-		t.Errorf("invalid memory size exp=%d, got=%d", c.sz, c.alloc.sz)
+		t.Errorf("invalid memory size exp=%d, got=%d", c.sz, cur)
 	}
 }
 
@@ -0,0 +1,110 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeT struct {
+	msgs []string
+}
+
+func (t *fakeT) Helper() {}
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.msgs = append(t.msgs, fmt.Sprintf(format, args...))
+}
+
+func TestCheckedAllocator(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+
+	b1 := mem.Allocate(10)
+	b2 := mem.Allocate(20)
+	assert.Equal(t, 30, mem.CurrentAlloc())
+
+	b1 = mem.Reallocate(100, b1)
+	assert.Equal(t, 120, mem.CurrentAlloc())
+
+	var ft fakeT
+	mem.AssertSize(&ft, 0)
+	assert.Equal(t, []string{"invalid memory size exp=0, got=120"}, ft.msgs)
+
+	mem.Free(b1)
+	mem.Free(b2)
+	mem.AssertSize(t, 0)
+}
+
+func TestCheckedAllocatorInvalidFree(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	t.Run("unknown", func(t *testing.T) {
+		assert.Panics(t, func() { mem.Free(make([]byte, 10)) })
+	})
+
+	t.Run("double-free", func(t *testing.T) {
+		buf := mem.Allocate(10)
+		mem.Free(buf)
+		assert.Panics(t, func() { mem.Free(buf) })
+	})
+
+	t.Run("stale-reallocate", func(t *testing.T) {
+		buf := mem.Allocate(10)
+		grown := mem.Reallocate(20, buf)
+		assert.Panics(t, func() { mem.Reallocate(30, buf) })
+		mem.Free(grown)
+	})
+
+	t.Run("invalid-size", func(t *testing.T) {
+		buf := mem.Allocate(10)
+		assert.Panics(t, func() { mem.Free(buf[:5]) })
+		mem.Free(buf)
+	})
+}
+
+func TestCheckedAllocatorBufferLeak(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+
+	buf := memory.NewResizableBuffer(mem)
+	buf.Resize(100)
+
+	var ft fakeT
+	mem.AssertSize(&ft, 0)
+	assert.Len(t, ft.msgs, 1, "leaked buffer was not detected")
+
+	buf.Release()
+	mem.AssertSize(t, 0)
+}
+
+func TestSetDefaultAllocator(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	prev := memory.SetDefaultAllocator(mem)
+	assert.True(t, memory.DefaultAllocator == memory.Allocator(mem))
+
+	buf := memory.NewResizableBuffer(memory.DefaultAllocator)
+	buf.Resize(10)
+	assert.Equal(t, 64, mem.CurrentAlloc())
+	buf.Release()
+	mem.AssertSize(t, 0)
+
+	assert.True(t, memory.SetDefaultAllocator(prev) == memory.Allocator(mem))
+	assert.True(t, memory.DefaultAllocator == prev)
+}
@@ -0,0 +1,180 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"math/bits"
+	"sync"
+)
+
+const (
+	poolMinClassShift = 6  // smallest size class is 64 bytes
+	poolMaxClassShift = 24 // largest size class is 16MiB
+	poolNumClasses    = poolMaxClassShift - poolMinClassShift + 1
+
+	// poolMaxFreeN is the maximum number of buffers retained per size class.
+	poolMaxFreeN = 256
+)
+
+// PoolStats holds the reuse statistics of a PoolAllocator.
+type PoolStats struct {
+	Allocations int64 // number of calls to Allocate.
+	Reuses      int64 // number of allocations served from a free list.
+	Outstanding int64 // number of bytes allocated and not yet freed.
+}
+
+// PoolOption is a functional option to configure a PoolAllocator.
+type PoolOption func(*PoolAllocator)
+
+// WithZeroing configures whether buffers handed back from a free list
+// are zeroed before being returned.
+// Fresh allocations are always obtained from the underlying allocator
+// and are not affected by this option.
+func WithZeroing(v bool) PoolOption {
+	return func(a *PoolAllocator) {
+		a.zero = v
+	}
+}
+
+// PoolAllocator is an Allocator that keeps freed buffers in power-of-two
+// size-class free lists and reuses them for subsequent allocations,
+// reducing the churn on the underlying allocator.
+//
+// Allocations larger than the biggest size class are forwarded to the
+// underlying allocator and are not pooled.
+//
+// PoolAllocator is safe to use from multiple goroutines.
+type PoolAllocator struct {
+	mem  Allocator
+	zero bool
+
+	mu    sync.Mutex
+	free  [poolNumClasses][][]byte
+	stats PoolStats
+}
+
+// NewPoolAllocator returns a PoolAllocator obtaining its memory from mem.
+func NewPoolAllocator(mem Allocator, opts ...PoolOption) *PoolAllocator {
+	a := &PoolAllocator{mem: mem}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// poolClass returns the size class needed to hold size bytes,
+// or -1 if size is too large to be pooled.
+func poolClass(size int) int {
+	if size <= 1<<poolMinClassShift {
+		return 0
+	}
+	c := bits.Len(uint(size-1)) - poolMinClassShift
+	if c >= poolNumClasses {
+		return -1
+	}
+	return c
+}
+
+// poolClassOf returns the size class of a buffer with the provided capacity,
+// or -1 if the capacity does not match a size class exactly.
+func poolClassOf(capacity int) int {
+	c := poolClass(capacity)
+	if c < 0 || capacity != 1<<uint(c+poolMinClassShift) {
+		return -1
+	}
+	return c
+}
+
+func (a *PoolAllocator) Allocate(size int) []byte {
+	c := poolClass(size)
+
+	a.mu.Lock()
+	a.stats.Allocations++
+	a.stats.Outstanding += int64(size)
+	if c >= 0 {
+		if n := len(a.free[c]); n > 0 {
+			buf := a.free[c][n-1]
+			a.free[c][n-1] = nil
+			a.free[c] = a.free[c][:n-1]
+			a.stats.Reuses++
+			a.mu.Unlock()
+
+			buf = buf[:size]
+			if a.zero {
+				Set(buf, 0)
+			}
+			return buf
+		}
+	}
+	a.mu.Unlock()
+
+	if c < 0 {
+		return a.mem.Allocate(size)
+	}
+	return a.mem.Allocate(1 << uint(c+poolMinClassShift))[:size]
+}
+
+func (a *PoolAllocator) Reallocate(size int, b []byte) []byte {
+	if size <= cap(b) && poolClassOf(cap(b)) >= 0 {
+		a.mu.Lock()
+		a.stats.Outstanding += int64(size - len(b))
+		a.mu.Unlock()
+
+		if n := len(b); size > n {
+			b = b[:size]
+			if a.zero {
+				Set(b[n:], 0)
+			}
+			return b
+		}
+		return b[:size]
+	}
+
+	buf := a.Allocate(size)
+	copy(buf, b)
+	a.Free(b)
+	return buf
+}
+
+func (a *PoolAllocator) Free(b []byte) {
+	c := poolClassOf(cap(b))
+
+	a.mu.Lock()
+	a.stats.Outstanding -= int64(len(b))
+	if c >= 0 && len(a.free[c]) < poolMaxFreeN {
+		a.free[c] = append(a.free[c], b[:cap(b)])
+		a.mu.Unlock()
+		return
+	}
+	a.mu.Unlock()
+
+	if c >= 0 {
+		b = b[:cap(b)]
+	}
+	a.mem.Free(b)
+}
+
+// Stats returns the current reuse statistics of the allocator.
+func (a *PoolAllocator) Stats() PoolStats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.stats
+}
+
+var (
+	_ Allocator = (*PoolAllocator)(nil)
+)
@@ -0,0 +1,155 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoolAllocator_Reuse(t *testing.T) {
+	mem := memory.NewPoolAllocator(memory.NewGoAllocator())
+
+	buf := mem.Allocate(100)
+	assert.Len(t, buf, 100)
+	assert.Equal(t, 128, cap(buf))
+	buf[0] = 0xff
+	mem.Free(buf)
+
+	got := mem.Allocate(120)
+	assert.Len(t, got, 120)
+	assert.Equal(t, &buf[:1][0], &got[:1][0], "buffer was not reused")
+	assert.Equal(t, byte(0xff), got[0], "reused buffer should not be zeroed by default")
+
+	// a different size class does not reuse the freed buffer.
+	other := mem.Allocate(1000)
+	assert.Equal(t, 1024, cap(other))
+
+	assert.Equal(t, memory.PoolStats{Allocations: 3, Reuses: 1, Outstanding: 1120}, mem.Stats())
+
+	mem.Free(got)
+	mem.Free(other)
+	assert.Equal(t, int64(0), mem.Stats().Outstanding)
+}
+
+func TestPoolAllocator_Zeroing(t *testing.T) {
+	mem := memory.NewPoolAllocator(memory.NewGoAllocator(), memory.WithZeroing(true))
+
+	buf := mem.Allocate(64)
+	memory.Set(buf, 0xff)
+	mem.Free(buf)
+
+	buf = mem.Allocate(64)
+	assert.Equal(t, make([]byte, 64), buf)
+
+	buf = mem.Reallocate(10, buf)
+	memory.Set(buf[:cap(buf)], 0xff)
+	buf = mem.Reallocate(64, buf)
+	assert.Equal(t, make([]byte, 54), buf[10:])
+	mem.Free(buf)
+
+	assert.Equal(t, memory.PoolStats{Allocations: 2, Reuses: 1}, mem.Stats())
+}
+
+func TestPoolAllocator_Reallocate(t *testing.T) {
+	mem := memory.NewPoolAllocator(memory.NewGoAllocator())
+
+	buf := mem.Allocate(10)
+	for i := range buf {
+		buf[i] = byte(i)
+	}
+
+	// growing within the size class keeps the same memory.
+	grown := mem.Reallocate(60, buf)
+	assert.Equal(t, &buf[0], &grown[0])
+	assert.Len(t, grown, 60)
+
+	// growing past the size class moves to a larger class.
+	grown = mem.Reallocate(200, grown)
+	assert.Len(t, grown, 200)
+	assert.Equal(t, 256, cap(grown))
+	assert.Equal(t, []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, grown[:10])
+	assert.Equal(t, int64(200), mem.Stats().Outstanding)
+
+	// the old buffer went back to its free list.
+	small := mem.Allocate(64)
+	assert.Equal(t, &buf[0], &small[0])
+
+	mem.Free(small)
+	mem.Free(grown)
+	assert.Equal(t, int64(0), mem.Stats().Outstanding)
+}
+
+func TestPoolAllocator_Large(t *testing.T) {
+	checked := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer checked.AssertSize(t, 0)
+
+	mem := memory.NewPoolAllocator(checked)
+
+	const n = 32 << 20
+	buf := mem.Allocate(n)
+	assert.Len(t, buf, n)
+	assert.Equal(t, n, cap(buf))
+	checked.AssertSize(t, n)
+
+	mem.Free(buf)
+	buf = mem.Allocate(n)
+	mem.Free(buf)
+
+	assert.Equal(t, memory.PoolStats{Allocations: 2}, mem.Stats())
+}
+
+func TestPoolAllocator_Buffer(t *testing.T) {
+	mem := memory.NewPoolAllocator(memory.NewGoAllocator())
+
+	for i := 0; i < 10; i++ {
+		buf := memory.NewResizableBuffer(mem)
+		buf.Resize(500)
+		assert.Len(t, buf.Bytes(), 500)
+		buf.Release()
+	}
+
+	stats := mem.Stats()
+	assert.Equal(t, int64(10), stats.Allocations)
+	assert.Equal(t, int64(9), stats.Reuses)
+	assert.Equal(t, int64(0), stats.Outstanding)
+}
+
+func TestPoolAllocator_Concurrent(t *testing.T) {
+	mem := memory.NewPoolAllocator(memory.NewGoAllocator(), memory.WithZeroing(true))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				buf := mem.Allocate(64 * (1 + (i+j)%16))
+				buf[0] = byte(i)
+				mem.Free(buf)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	stats := mem.Stats()
+	assert.Equal(t, int64(8000), stats.Allocations)
+	assert.Equal(t, int64(0), stats.Outstanding)
+}
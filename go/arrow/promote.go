@@ -0,0 +1,140 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"fmt"
+)
+
+// PromoteTypes returns the common data type values of both a and b can be
+// converted to, following the numeric promotion rules:
+//
+//   - the null type promotes to any data type;
+//   - integers of the same signedness promote to the wider of the two types;
+//   - a signed and an unsigned integer promote to the narrowest signed integer
+//     type holding both, up to int64 (uint64 and a signed integer promote
+//     to int64);
+//   - floating-point types promote to the wider of the two types;
+//   - an integer and a floating-point type promote to float64.
+//
+// PromoteTypes returns an error if a and b are different and not both numeric.
+func PromoteTypes(a, b DataType) (DataType, error) {
+	switch {
+	case TypeEquals(a, b):
+		return a, nil
+	case a.ID() == NULL:
+		return b, nil
+	case b.ID() == NULL:
+		return a, nil
+	}
+
+	ka, wa := numericKind(a)
+	kb, wb := numericKind(b)
+	if ka == notNumeric || kb == notNumeric {
+		return nil, fmt.Errorf("arrow: cannot promote %v and %v to a common type", a, b)
+	}
+
+	switch {
+	case ka == floatKind && kb == floatKind:
+		return floatOfWidth(maxInt(wa, wb)), nil
+	case ka == floatKind || kb == floatKind:
+		return PrimitiveTypes.Float64, nil
+	case ka == kb:
+		if ka == signedKind {
+			return signedOfWidth(maxInt(wa, wb)), nil
+		}
+		return unsignedOfWidth(maxInt(wa, wb)), nil
+	case ka == unsignedKind:
+		a, ka, wa, kb, wb = b, kb, wb, ka, wa
+	}
+
+	// a is signed and b is unsigned.
+	if wa > wb {
+		return a, nil
+	}
+	if wb == 64 {
+		return PrimitiveTypes.Int64, nil
+	}
+	return signedOfWidth(2 * wb), nil
+}
+
+type numKind int
+
+const (
+	notNumeric numKind = iota
+	signedKind
+	unsignedKind
+	floatKind
+)
+
+// numericKind returns the kind of numeric data type dt and its bit width.
+func numericKind(dt DataType) (numKind, int) {
+	switch dt.ID() {
+	case INT8, INT16, INT32, INT64:
+		return signedKind, dt.(FixedWidthDataType).BitWidth()
+	case UINT8, UINT16, UINT32, UINT64:
+		return unsignedKind, dt.(FixedWidthDataType).BitWidth()
+	case FLOAT16, FLOAT32, FLOAT64:
+		return floatKind, dt.(FixedWidthDataType).BitWidth()
+	default:
+		return notNumeric, 0
+	}
+}
+
+func signedOfWidth(bits int) DataType {
+	switch bits {
+	case 8:
+		return PrimitiveTypes.Int8
+	case 16:
+		return PrimitiveTypes.Int16
+	case 32:
+		return PrimitiveTypes.Int32
+	default:
+		return PrimitiveTypes.Int64
+	}
+}
+
+func unsignedOfWidth(bits int) DataType {
+	switch bits {
+	case 8:
+		return PrimitiveTypes.Uint8
+	case 16:
+		return PrimitiveTypes.Uint16
+	case 32:
+		return PrimitiveTypes.Uint32
+	default:
+		return PrimitiveTypes.Uint64
+	}
+}
+
+func floatOfWidth(bits int) DataType {
+	switch bits {
+	case 16:
+		return FixedWidthTypes.Float16
+	case 32:
+		return PrimitiveTypes.Float32
+	default:
+		return PrimitiveTypes.Float64
+	}
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
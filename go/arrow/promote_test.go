@@ -0,0 +1,86 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"testing"
+)
+
+func TestPromoteTypes(t *testing.T) {
+	var (
+		i8  = PrimitiveTypes.Int8
+		i16 = PrimitiveTypes.Int16
+		i32 = PrimitiveTypes.Int32
+		i64 = PrimitiveTypes.Int64
+		u8  = PrimitiveTypes.Uint8
+		u16 = PrimitiveTypes.Uint16
+		u32 = PrimitiveTypes.Uint32
+		u64 = PrimitiveTypes.Uint64
+		f16 = FixedWidthTypes.Float16
+		f32 = PrimitiveTypes.Float32
+		f64 = PrimitiveTypes.Float64
+		str = BinaryTypes.String
+		nul = Null
+	)
+
+	for _, tc := range []struct {
+		a, b DataType
+		want DataType // nil if a and b cannot be promoted.
+	}{
+		{i32, i32, i32},
+		{i32, i64, i64},
+		{i8, i16, i16},
+		{u8, u32, u32},
+		{u64, u16, u64},
+		{i16, u8, i16},
+		{i8, u8, i16},
+		{i32, u16, i32},
+		{i32, u32, i64},
+		{i64, u64, i64},
+		{i8, u64, i64},
+		{f16, f32, f32},
+		{f32, f64, f64},
+		{i8, f32, f64},
+		{u64, f16, f64},
+		{i64, f64, f64},
+		{nul, i32, i32},
+		{str, nul, str},
+		{nul, nul, nul},
+		{str, str, str},
+		{str, i32, nil},
+		{f64, str, nil},
+		{FixedWidthTypes.Boolean, i8, nil},
+		{ListOf(i32), ListOf(i64), nil},
+	} {
+		for _, args := range [][2]DataType{{tc.a, tc.b}, {tc.b, tc.a}} {
+			got, err := PromoteTypes(args[0], args[1])
+			if tc.want == nil {
+				if err == nil {
+					t.Errorf("PromoteTypes(%v, %v): expected an error, got=%v", args[0], args[1], got)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("PromoteTypes(%v, %v): unexpected error: %v", args[0], args[1], err)
+				continue
+			}
+			if !TypeEquals(got, tc.want) {
+				t.Errorf("PromoteTypes(%v, %v): got=%v, want=%v", args[0], args[1], got, tc.want)
+			}
+		}
+	}
+}
@@ -65,6 +65,16 @@ func (md Metadata) Len() int         { return len(md.keys) }
 func (md Metadata) Keys() []string   { return md.keys }
 func (md Metadata) Values() []string { return md.values }
 
+// Value returns the value associated with the provided key name and
+// whether such a key exists.
+func (md Metadata) Value(k string) (string, bool) {
+	i := md.FindKey(k)
+	if i < 0 {
+		return "", false
+	}
+	return md.values[i], true
+}
+
 func (md Metadata) String() string {
 	o := new(strings.Builder)
 	fmt.Fprintf(o, "[")
@@ -89,6 +99,26 @@ func (md Metadata) FindKey(k string) int {
 	return -1
 }
 
+// equal returns whether md and o hold the same key-value pairs, regardless
+// of their order.
+func (md Metadata) equal(o Metadata) bool {
+	if len(md.keys) != len(o.keys) {
+		return false
+	}
+	pairs := make(map[[2]string]int, len(md.keys))
+	for i := range md.keys {
+		pairs[[2]string{md.keys[i], md.values[i]}]++
+	}
+	for i := range o.keys {
+		kv := [2]string{o.keys[i], o.values[i]}
+		if pairs[kv] == 0 {
+			return false
+		}
+		pairs[kv]--
+	}
+	return true
+}
+
 func (md Metadata) clone() Metadata {
 	if len(md.keys) == 0 {
 		return Metadata{}
@@ -106,20 +136,22 @@ func (md Metadata) clone() Metadata {
 
 // Schema is a sequence of Field values, describing the columns of a table or
 // a record batch.
+//
+// Field names do not need to be unique: the lookup methods taking a field
+// name report all the fields with that name.
 type Schema struct {
 	fields []Field
-	index  map[string]int
+	index  map[string][]int
 	meta   Metadata
 }
 
 // NewSchema returns a new Schema value from the slice of fields and metadata.
 //
-// NewSchema panics if there are duplicated fields.
 // NewSchema panics if there is a field with an invalid DataType.
 func NewSchema(fields []Field, metadata *Metadata) *Schema {
 	sc := &Schema{
 		fields: make([]Field, 0, len(fields)),
-		index:  make(map[string]int, len(fields)),
+		index:  make(map[string][]int, len(fields)),
 	}
 	if metadata != nil {
 		sc.meta = metadata.clone()
@@ -129,10 +161,7 @@ func NewSchema(fields []Field, metadata *Metadata) *Schema {
 			panic("arrow: field with nil DataType")
 		}
 		sc.fields = append(sc.fields, field)
-		if _, dup := sc.index[field.Name]; dup {
-			panic(fmt.Errorf("arrow: duplicate field with name %q", field.Name))
-		}
-		sc.index[field.Name] = i
+		sc.index[field.Name] = append(sc.index[field.Name], i)
 	}
 	return sc
 }
@@ -140,32 +169,152 @@ func NewSchema(fields []Field, metadata *Metadata) *Schema {
 func (sc *Schema) Metadata() Metadata { return sc.meta }
 func (sc *Schema) Fields() []Field    { return sc.fields }
 func (sc *Schema) Field(i int) Field  { return sc.fields[i] }
+func (sc *Schema) NumFields() int     { return len(sc.fields) }
 
+// FieldByName returns the first field with the provided name and
+// whether such a field exists.
 func (sc *Schema) FieldByName(n string) (Field, bool) {
-	i, ok := sc.index[n]
+	indices, ok := sc.index[n]
 	if !ok {
 		return Field{}, ok
 	}
-	return sc.fields[i], ok
+	return sc.fields[indices[0]], ok
+}
+
+// FieldsByName returns all the fields with the provided name, in schema order.
+func (sc *Schema) FieldsByName(n string) []Field {
+	indices, ok := sc.index[n]
+	if !ok {
+		return nil
+	}
+	fields := make([]Field, len(indices))
+	for i, idx := range indices {
+		fields[i] = sc.fields[idx]
+	}
+	return fields
 }
 
-// FieldIndex returns the index of the named field or -1.
+// FieldIndex returns the index of the first field with the provided name or -1.
 func (sc *Schema) FieldIndex(n string) int {
-	i, ok := sc.index[n]
+	indices, ok := sc.index[n]
 	if !ok {
 		return -1
 	}
-	return i
+	return indices[0]
+}
+
+// FieldIndices returns the indices of all the fields with the provided name,
+// in increasing order.
+func (sc *Schema) FieldIndices(n string) []int {
+	indices, ok := sc.index[n]
+	if !ok {
+		return nil
+	}
+	return append([]int(nil), indices...)
 }
 
 func (sc *Schema) HasField(n string) bool {
 	return sc.FieldIndex(n) >= 0
 }
 
+// AddField returns a new schema with the provided field inserted at
+// index i, shifting the fields at index i and above.
+// The schema-level metadata is carried over to the new schema.
+//
+// AddField returns an error if i is not in the [0, NumFields()] range or
+// if the field has an invalid DataType.
+func (sc *Schema) AddField(i int, field Field) (*Schema, error) {
+	if i < 0 || i > len(sc.fields) {
+		return nil, fmt.Errorf("arrow: invalid field index %d to add to schema with %d fields", i, len(sc.fields))
+	}
+	if field.Type == nil {
+		return nil, fmt.Errorf("arrow: field with nil DataType")
+	}
+	fields := make([]Field, 0, len(sc.fields)+1)
+	fields = append(fields, sc.fields[:i]...)
+	fields = append(fields, field)
+	fields = append(fields, sc.fields[i:]...)
+	return NewSchema(fields, &sc.meta), nil
+}
+
+// RemoveField returns a new schema without the field at index i.
+// The schema-level metadata is carried over to the new schema.
+//
+// RemoveField returns an error if i is not in the [0, NumFields()) range.
+func (sc *Schema) RemoveField(i int) (*Schema, error) {
+	if i < 0 || i >= len(sc.fields) {
+		return nil, fmt.Errorf("arrow: invalid field index %d to remove from schema with %d fields", i, len(sc.fields))
+	}
+	fields := make([]Field, 0, len(sc.fields)-1)
+	fields = append(fields, sc.fields[:i]...)
+	fields = append(fields, sc.fields[i+1:]...)
+	return NewSchema(fields, &sc.meta), nil
+}
+
+// SetField returns a new schema with the field at index i replaced by the
+// provided field.
+// The schema-level metadata is carried over to the new schema.
+//
+// SetField returns an error if i is not in the [0, NumFields()) range or
+// if the field has an invalid DataType.
+func (sc *Schema) SetField(i int, field Field) (*Schema, error) {
+	if i < 0 || i >= len(sc.fields) {
+		return nil, fmt.Errorf("arrow: invalid field index %d to set in schema with %d fields", i, len(sc.fields))
+	}
+	if field.Type == nil {
+		return nil, fmt.Errorf("arrow: field with nil DataType")
+	}
+	fields := make([]Field, len(sc.fields))
+	copy(fields, sc.fields)
+	fields[i] = field
+	return NewSchema(fields, &sc.meta), nil
+}
+
+// UnifySchemas returns a schema holding the union of the fields of the
+// provided schemas, matched by name, in order of first appearance.
+// A field is nullable if it is nullable in any of the schemas. The metadata
+// of a field, and the schema-level metadata, come from its first appearance.
+//
+// UnifySchemas returns an error if fields with the same name have different
+// data types, or if a schema holds several fields with the same name.
+func UnifySchemas(schemas []*Schema) (*Schema, error) {
+	if len(schemas) == 0 {
+		return nil, fmt.Errorf("arrow: no schema to unify")
+	}
+
+	var (
+		fields []Field
+		index  = make(map[string]int)
+	)
+	for i, sc := range schemas {
+		seen := make(map[string]bool, len(sc.fields))
+		for _, f := range sc.fields {
+			if seen[f.Name] {
+				return nil, fmt.Errorf("arrow: duplicate field %q in schema %d", f.Name, i)
+			}
+			seen[f.Name] = true
+
+			j, ok := index[f.Name]
+			if !ok {
+				index[f.Name] = len(fields)
+				fields = append(fields, f)
+				continue
+			}
+			if !TypeEquals(fields[j].Type, f.Type) {
+				return nil, fmt.Errorf("arrow: field %q type mismatch in schema %d: got=%v, want=%v", f.Name, i, f.Type, fields[j].Type)
+			}
+			fields[j].Nullable = fields[j].Nullable || f.Nullable
+		}
+	}
+
+	return NewSchema(fields, &schemas[0].meta), nil
+}
+
 func (sc *Schema) HasMetadata() bool { return len(sc.meta.keys) > 0 }
 
 // Equal returns whether two schema are equal.
-// Equal does not compare the metadata.
+// Equal does not compare the schema-level metadata.
+// The metadata of the fields is compared, as per Field.Equal.
 func (sc *Schema) Equal(o *Schema) bool {
 	switch {
 	case sc == o:
@@ -184,6 +333,15 @@ func (sc *Schema) Equal(o *Schema) bool {
 	return true
 }
 
+// EqualWithMetadata returns whether two schema are equal, including
+// their schema-level metadata.
+func (sc *Schema) EqualWithMetadata(o *Schema) bool {
+	if !sc.Equal(o) {
+		return false
+	}
+	return sc == o || sc.meta.equal(o.meta)
+}
+
 func (s *Schema) String() string {
 	o := new(strings.Builder)
 	fmt.Fprintf(o, "schema:\n  fields: %d\n", len(s.Fields()))
@@ -0,0 +1,416 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow/decimal128"
+)
+
+// jsonSchema is the Arrow JSON representation of a schema, as used by the
+// Arrow integration tests.
+type jsonSchema struct {
+	Fields   []jsonField `json:"fields"`
+	Metadata []jsonKV    `json:"metadata,omitempty"`
+}
+
+type jsonField struct {
+	Name       string                 `json:"name"`
+	Nullable   bool                   `json:"nullable"`
+	Type       map[string]interface{} `json:"type"`
+	Children   []jsonField            `json:"children"`
+	Dictionary *jsonDictionary        `json:"dictionary,omitempty"`
+	Metadata   []jsonKV               `json:"metadata,omitempty"`
+}
+
+// jsonDictionary is the dictionary encoding of a field, whose type object
+// describes the type of the dictionary values.
+type jsonDictionary struct {
+	ID        int64                  `json:"id"`
+	IndexType map[string]interface{} `json:"indexType"`
+	IsOrdered bool                   `json:"isOrdered"`
+}
+
+type jsonKV struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+var jsonUnits = [...]string{
+	Nanosecond:  "NANOSECOND",
+	Microsecond: "MICROSECOND",
+	Millisecond: "MILLISECOND",
+	Second:      "SECOND",
+}
+
+// MarshalJSON encodes the schema in the Arrow JSON schema representation:
+// the fields, with their name, nullability, type object, children and
+// metadata, and the schema-level metadata.
+//
+// Dictionary-encoded fields are numbered in depth-first order.
+func (sc *Schema) MarshalJSON() ([]byte, error) {
+	var id int64
+	fields, err := fieldsToJSON(sc.fields, &id)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonSchema{Fields: fields, Metadata: metadataToJSON(sc.meta)})
+}
+
+// UnmarshalJSON decodes a schema from the Arrow JSON schema representation.
+func (sc *Schema) UnmarshalJSON(data []byte) error {
+	var v jsonSchema
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	fields, err := fieldsFromJSON(v.Fields)
+	if err != nil {
+		return err
+	}
+	meta := metadataFromJSON(v.Metadata)
+	*sc = *NewSchema(fields, &meta)
+	return nil
+}
+
+func metadataToJSON(md Metadata) []jsonKV {
+	if md.Len() == 0 {
+		return nil
+	}
+	kvs := make([]jsonKV, md.Len())
+	for i := range md.keys {
+		kvs[i] = jsonKV{Key: md.keys[i], Value: md.values[i]}
+	}
+	return kvs
+}
+
+func metadataFromJSON(kvs []jsonKV) Metadata {
+	var (
+		keys   = make([]string, len(kvs))
+		values = make([]string, len(kvs))
+	)
+	for i, kv := range kvs {
+		keys[i], values[i] = kv.Key, kv.Value
+	}
+	return NewMetadata(keys, values)
+}
+
+// fieldsToJSON returns the JSON representation of fields, numbering their
+// dictionary-encoded fields from *id.
+func fieldsToJSON(fields []Field, id *int64) ([]jsonField, error) {
+	o := make([]jsonField, len(fields))
+	for i, f := range fields {
+		var (
+			dt   = f.Type
+			dict *jsonDictionary
+		)
+		if dictType, ok := dt.(*DictionaryType); ok {
+			index, _, err := typeToJSON(dictType.IndexType)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %v", f.Name, err)
+			}
+			dict = &jsonDictionary{ID: *id, IndexType: index, IsOrdered: dictType.Ordered}
+			dt = dictType.ValueType
+			*id++
+		}
+		typ, children, err := typeToJSON(dt)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %v", f.Name, err)
+		}
+		o[i] = jsonField{
+			Name:       f.Name,
+			Nullable:   f.Nullable,
+			Type:       typ,
+			Dictionary: dict,
+			Metadata:   metadataToJSON(f.Metadata),
+		}
+		if o[i].Children, err = fieldsToJSON(children, id); err != nil {
+			return nil, fmt.Errorf("field %q: %v", f.Name, err)
+		}
+	}
+	return o, nil
+}
+
+func fieldsFromJSON(fields []jsonField) ([]Field, error) {
+	o := make([]Field, len(fields))
+	for i, f := range fields {
+		children, err := fieldsFromJSON(f.Children)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %v", f.Name, err)
+		}
+		dt, err := typeFromJSON(f.Type, children)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %v", f.Name, err)
+		}
+		if f.Dictionary != nil {
+			index, err := typeFromJSON(f.Dictionary.IndexType, nil)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %v", f.Name, err)
+			}
+			if !ValidDictionaryIndexType(index) {
+				return nil, fmt.Errorf("field %q: arrow: invalid dictionary index type %v", f.Name, index)
+			}
+			dt = &DictionaryType{IndexType: index, ValueType: dt, Ordered: f.Dictionary.IsOrdered}
+		}
+		o[i] = Field{
+			Name:     f.Name,
+			Type:     dt,
+			Nullable: f.Nullable,
+			Metadata: metadataFromJSON(f.Metadata),
+		}
+	}
+	return o, nil
+}
+
+// typeToJSON returns the JSON type object of dt, and the fields of its
+// child types.
+func typeToJSON(dt DataType) (map[string]interface{}, []Field, error) {
+	switch dt := dt.(type) {
+	case *NullType:
+		return map[string]interface{}{"name": "null"}, nil, nil
+	case *BooleanType:
+		return map[string]interface{}{"name": "bool"}, nil, nil
+	case *Int8Type, *Int16Type, *Int32Type, *Int64Type:
+		return map[string]interface{}{"name": "int", "isSigned": true, "bitWidth": dt.(FixedWidthDataType).BitWidth()}, nil, nil
+	case *Uint8Type, *Uint16Type, *Uint32Type, *Uint64Type:
+		return map[string]interface{}{"name": "int", "isSigned": false, "bitWidth": dt.(FixedWidthDataType).BitWidth()}, nil, nil
+	case *Float16Type:
+		return map[string]interface{}{"name": "floatingpoint", "precision": "HALF"}, nil, nil
+	case *Float32Type:
+		return map[string]interface{}{"name": "floatingpoint", "precision": "SINGLE"}, nil, nil
+	case *Float64Type:
+		return map[string]interface{}{"name": "floatingpoint", "precision": "DOUBLE"}, nil, nil
+	case *Decimal128Type:
+		return map[string]interface{}{"name": "decimal", "precision": dt.Precision, "scale": dt.Scale, "bitWidth": 128}, nil, nil
+	case *Decimal256Type:
+		return map[string]interface{}{"name": "decimal", "precision": dt.Precision, "scale": dt.Scale, "bitWidth": 256}, nil, nil
+	case *BinaryType:
+		return map[string]interface{}{"name": "binary"}, nil, nil
+	case *StringType:
+		return map[string]interface{}{"name": "utf8"}, nil, nil
+	case *LargeBinaryType:
+		return map[string]interface{}{"name": "largebinary"}, nil, nil
+	case *LargeStringType:
+		return map[string]interface{}{"name": "largeutf8"}, nil, nil
+	case *FixedSizeBinaryType:
+		return map[string]interface{}{"name": "fixedsizebinary", "byteWidth": dt.ByteWidth}, nil, nil
+	case *Date32Type:
+		return map[string]interface{}{"name": "date", "unit": "DAY"}, nil, nil
+	case *Date64Type:
+		return map[string]interface{}{"name": "date", "unit": "MILLISECOND"}, nil, nil
+	case *Time32Type:
+		return map[string]interface{}{"name": "time", "unit": jsonUnits[dt.Unit], "bitWidth": 32}, nil, nil
+	case *Time64Type:
+		return map[string]interface{}{"name": "time", "unit": jsonUnits[dt.Unit], "bitWidth": 64}, nil, nil
+	case *TimestampType:
+		typ := map[string]interface{}{"name": "timestamp", "unit": jsonUnits[dt.Unit]}
+		if dt.TimeZone != "" {
+			typ["timezone"] = dt.TimeZone
+		}
+		return typ, nil, nil
+	case *DurationType:
+		return map[string]interface{}{"name": "duration", "unit": jsonUnits[dt.Unit]}, nil, nil
+	case *MonthIntervalType:
+		return map[string]interface{}{"name": "interval", "unit": "YEAR_MONTH"}, nil, nil
+	case *DayTimeIntervalType:
+		return map[string]interface{}{"name": "interval", "unit": "DAY_TIME"}, nil, nil
+	case *ListType:
+		return map[string]interface{}{"name": "list"}, []Field{{Name: "item", Type: dt.Elem(), Nullable: true}}, nil
+	case *LargeListType:
+		return map[string]interface{}{"name": "largelist"}, []Field{{Name: "item", Type: dt.Elem(), Nullable: true}}, nil
+	case *FixedSizeListType:
+		return map[string]interface{}{"name": "fixedsizelist", "listSize": dt.Len()}, []Field{{Name: "item", Type: dt.Elem(), Nullable: true}}, nil
+	case *StructType:
+		return map[string]interface{}{"name": "struct"}, dt.Fields(), nil
+	case *RunEndEncodedType:
+		return map[string]interface{}{"name": "runendencoded"}, []Field{
+			{Name: "run_ends", Type: dt.RunEnds},
+			{Name: "values", Type: dt.Values, Nullable: true},
+		}, nil
+	default:
+		return nil, nil, fmt.Errorf("arrow: unsupported JSON data type %v", dt)
+	}
+}
+
+// typeFromJSON returns the data type described by the JSON type object typ,
+// with the provided child fields.
+func typeFromJSON(typ map[string]interface{}, children []Field) (DataType, error) {
+	var (
+		name, _ = typ["name"].(string)
+		unit, _ = typ["unit"].(string)
+		width   = jsonInt(typ, "bitWidth")
+	)
+
+	child := func(n int) error {
+		if len(children) != n {
+			return fmt.Errorf("arrow: invalid number of children for JSON data type %q: got=%d, want=%d", name, len(children), n)
+		}
+		return nil
+	}
+
+	var dt DataType
+	switch name {
+	case "null":
+		dt = Null
+	case "bool":
+		dt = FixedWidthTypes.Boolean
+	case "int":
+		signed, _ := typ["isSigned"].(bool)
+		switch {
+		case signed && width == 8:
+			dt = PrimitiveTypes.Int8
+		case signed && width == 16:
+			dt = PrimitiveTypes.Int16
+		case signed && width == 32:
+			dt = PrimitiveTypes.Int32
+		case signed && width == 64:
+			dt = PrimitiveTypes.Int64
+		case !signed && width == 8:
+			dt = PrimitiveTypes.Uint8
+		case !signed && width == 16:
+			dt = PrimitiveTypes.Uint16
+		case !signed && width == 32:
+			dt = PrimitiveTypes.Uint32
+		case !signed && width == 64:
+			dt = PrimitiveTypes.Uint64
+		}
+	case "floatingpoint":
+		switch typ["precision"] {
+		case "HALF":
+			dt = FixedWidthTypes.Float16
+		case "SINGLE":
+			dt = PrimitiveTypes.Float32
+		case "DOUBLE":
+			dt = PrimitiveTypes.Float64
+		}
+	case "decimal":
+		var (
+			precision = int32(jsonInt(typ, "precision"))
+			scale     = int32(jsonInt(typ, "scale"))
+		)
+		switch width {
+		case 0, 128:
+			if precision < 1 || precision > decimal128.MaxPrecision {
+				return nil, fmt.Errorf("arrow: invalid decimal128 precision %d (must be in [1, %d])", precision, decimal128.MaxPrecision)
+			}
+			dt = &Decimal128Type{Precision: precision, Scale: scale}
+		case 256:
+			return NewDecimal256Type(precision, scale)
+		}
+	case "binary":
+		dt = BinaryTypes.Binary
+	case "utf8":
+		dt = BinaryTypes.String
+	case "largebinary":
+		dt = BinaryTypes.LargeBinary
+	case "largeutf8":
+		dt = BinaryTypes.LargeString
+	case "fixedsizebinary":
+		dt = &FixedSizeBinaryType{ByteWidth: jsonInt(typ, "byteWidth")}
+	case "date":
+		switch unit {
+		case "DAY":
+			dt = FixedWidthTypes.Date32
+		case "MILLISECOND":
+			dt = FixedWidthTypes.Date64
+		}
+	case "time":
+		u, ok := jsonUnit(unit)
+		switch {
+		case !ok:
+		case width == 32 && (u == Second || u == Millisecond):
+			dt = &Time32Type{Unit: u}
+		case width == 64 && (u == Microsecond || u == Nanosecond):
+			dt = &Time64Type{Unit: u}
+		}
+	case "timestamp":
+		if u, ok := jsonUnit(unit); ok {
+			tz, _ := typ["timezone"].(string)
+			dt = &TimestampType{Unit: u, TimeZone: tz}
+		}
+	case "duration":
+		if u, ok := jsonUnit(unit); ok {
+			dt = &DurationType{Unit: u}
+		}
+	case "interval":
+		switch unit {
+		case "YEAR_MONTH":
+			dt = FixedWidthTypes.MonthInterval
+		case "DAY_TIME":
+			dt = FixedWidthTypes.DayTimeInterval
+		}
+	case "list":
+		if err := child(1); err != nil {
+			return nil, err
+		}
+		dt = ListOf(children[0].Type)
+	case "largelist":
+		if err := child(1); err != nil {
+			return nil, err
+		}
+		dt = LargeListOf(children[0].Type)
+	case "fixedsizelist":
+		if err := child(1); err != nil {
+			return nil, err
+		}
+		n := jsonInt(typ, "listSize")
+		if n <= 0 {
+			return nil, fmt.Errorf("arrow: invalid fixed size list size %d", n)
+		}
+		dt = FixedSizeListOf(int32(n), children[0].Type)
+	case "struct":
+		seen := make(map[string]bool, len(children))
+		for _, c := range children {
+			if seen[c.Name] {
+				return nil, fmt.Errorf("arrow: duplicate struct field with name %q", c.Name)
+			}
+			seen[c.Name] = true
+		}
+		dt = StructOf(children...)
+	case "runendencoded":
+		if err := child(2); err != nil {
+			return nil, err
+		}
+		if !ValidRunEndsType(children[0].Type) {
+			return nil, fmt.Errorf("arrow: invalid run ends type %v", children[0].Type)
+		}
+		dt = RunEndEncodedOf(children[0].Type, children[1].Type)
+	}
+
+	if dt == nil {
+		b, _ := json.Marshal(typ)
+		return nil, fmt.Errorf("arrow: unsupported JSON data type %s", b)
+	}
+	return dt, nil
+}
+
+// jsonInt returns the integer value of the key k of the JSON object typ,
+// or 0 if there is no such integer.
+func jsonInt(typ map[string]interface{}, k string) int {
+	v, _ := typ[k].(float64)
+	return int(v)
+}
+
+func jsonUnit(s string) (TimeUnit, bool) {
+	for u, v := range jsonUnits {
+		if v == s {
+			return TimeUnit(u), true
+		}
+	}
+	return 0, false
+}
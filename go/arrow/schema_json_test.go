@@ -0,0 +1,182 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+func TestSchemaJSONRoundTrip(t *testing.T) {
+	md := arrow.NewMetadata([]string{"origin"}, []string{"sensors"})
+	fmd := arrow.NewMetadata([]string{"unit", "desc"}, []string{"m/s", "speed"})
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "id", Type: arrow.PrimitiveTypes.Uint64},
+			{Name: "speed", Type: arrow.PrimitiveTypes.Float32, Nullable: true, Metadata: fmd},
+			{Name: "price", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}, Nullable: true},
+			{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "Europe/Paris"}},
+			{Name: "local", Type: arrow.FixedWidthTypes.Timestamp_s},
+			{Name: "t", Type: arrow.FixedWidthTypes.Time32ms},
+			{Name: "d", Type: arrow.FixedWidthTypes.Date64},
+			{Name: "elapsed", Type: arrow.FixedWidthTypes.Duration_ns},
+			{Name: "hash", Type: &arrow.FixedSizeBinaryType{ByteWidth: 16}},
+			{Name: "doc", Type: arrow.BinaryTypes.LargeString, Nullable: true},
+			{Name: "tags", Type: arrow.ListOf(arrow.BinaryTypes.String), Nullable: true},
+			{Name: "xyz", Type: arrow.FixedSizeListOf(3, arrow.PrimitiveTypes.Float64)},
+			{Name: "events", Type: arrow.LargeListOf(arrow.StructOf(
+				arrow.Field{Name: "at", Type: arrow.FixedWidthTypes.Date32},
+				arrow.Field{Name: "kinds", Type: arrow.ListOf(arrow.PrimitiveTypes.Int16), Nullable: true, Metadata: fmd},
+			))},
+			{Name: "state", Type: arrow.RunEndEncodedOf(arrow.PrimitiveTypes.Int32, arrow.BinaryTypes.String)},
+			{Name: "color", Type: &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String}, Nullable: true},
+			{Name: "codes", Type: arrow.ListOf(&arrow.DictionaryType{
+				IndexType: arrow.PrimitiveTypes.Uint16,
+				ValueType: arrow.PrimitiveTypes.Int64,
+				Ordered:   true,
+			})},
+		},
+		&md,
+	)
+
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf("could not marshal schema: %+v", err)
+	}
+
+	var got arrow.Schema
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("could not unmarshal schema: %+v\n%s", err, raw)
+	}
+
+	if !got.EqualWithMetadata(schema) {
+		t.Fatalf("invalid schema:\ngot= %v\nwant=%v", &got, schema)
+	}
+	if got, want := got.FieldIndex("doc"), 9; got != want {
+		t.Fatalf("invalid field index: got=%d, want=%d", got, want)
+	}
+}
+
+func TestSchemaJSON(t *testing.T) {
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_ms, Nullable: true},
+			{Name: "vs", Type: arrow.ListOf(arrow.PrimitiveTypes.Int32)},
+		},
+		nil,
+	)
+
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf("could not marshal schema: %+v", err)
+	}
+
+	want := `{"fields":[` +
+		`{"name":"ts","nullable":true,"type":{"name":"timestamp","timezone":"UTC","unit":"MILLISECOND"},"children":[]},` +
+		`{"name":"vs","nullable":false,"type":{"name":"list"},"children":[` +
+		`{"name":"item","nullable":true,"type":{"bitWidth":32,"isSigned":true,"name":"int"},"children":[]}]}]}`
+	if got := string(raw); got != want {
+		t.Fatalf("invalid JSON:\ngot= %s\nwant=%s", got, want)
+	}
+}
+
+func TestSchemaJSONDictionary(t *testing.T) {
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "a", Type: &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int32, ValueType: arrow.BinaryTypes.String}},
+			{Name: "b", Type: &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Uint8, ValueType: arrow.PrimitiveTypes.Int64, Ordered: true}},
+		},
+		nil,
+	)
+
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf("could not marshal schema: %+v", err)
+	}
+
+	want := `{"fields":[` +
+		`{"name":"a","nullable":false,"type":{"name":"utf8"},"children":[],` +
+		`"dictionary":{"id":0,"indexType":{"bitWidth":32,"isSigned":true,"name":"int"},"isOrdered":false}},` +
+		`{"name":"b","nullable":false,"type":{"bitWidth":64,"isSigned":true,"name":"int"},"children":[],` +
+		`"dictionary":{"id":1,"indexType":{"bitWidth":8,"isSigned":false,"name":"int"},"isOrdered":true}}]}`
+	if got := string(raw); got != want {
+		t.Fatalf("invalid JSON:\ngot= %s\nwant=%s", got, want)
+	}
+}
+
+func TestSchemaJSONInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		raw  string
+		want string
+	}{
+		{
+			name: "unknown-type",
+			raw:  `{"fields":[{"name":"f","type":{"name":"int","bitWidth":12},"children":[]}]}`,
+			want: `field "f": arrow: unsupported JSON data type {"bitWidth":12,"name":"int"}`,
+		},
+		{
+			name: "list-children",
+			raw:  `{"fields":[{"name":"f","type":{"name":"list"},"children":[]}]}`,
+			want: `field "f": arrow: invalid number of children for JSON data type "list": got=0, want=1`,
+		},
+		{
+			name: "dictionary-index",
+			raw:  `{"fields":[{"name":"f","type":{"name":"utf8"},"children":[],"dictionary":{"id":0,"indexType":{"name":"utf8"}}}]}`,
+			want: `field "f": arrow: invalid dictionary index type utf8`,
+		},
+		{
+			name: "struct-duplicate-children",
+			raw:  `{"fields":[{"name":"f","type":{"name":"struct"},"children":[{"name":"a","type":{"name":"bool"},"children":[]},{"name":"a","type":{"name":"utf8"},"children":[]}]}]}`,
+			want: `field "f": arrow: duplicate struct field with name "a"`,
+		},
+		{
+			name: "fixed-size-list-size",
+			raw:  `{"fields":[{"name":"f","type":{"name":"fixedsizelist","listSize":0},"children":[{"name":"item","type":{"name":"bool"},"children":[]}]}]}`,
+			want: `field "f": arrow: invalid fixed size list size 0`,
+		},
+		{
+			name: "decimal-precision",
+			raw:  `{"fields":[{"name":"f","type":{"name":"decimal","precision":39,"scale":2},"children":[]}]}`,
+			want: `field "f": arrow: invalid decimal128 precision 39 (must be in [1, 38])`,
+		},
+		{
+			name: "decimal256-precision",
+			raw:  `{"fields":[{"name":"f","type":{"name":"decimal","bitWidth":256,"precision":0,"scale":2},"children":[]}]}`,
+			want: `field "f": arrow: invalid decimal256 precision 0 (must be in [1, 76])`,
+		},
+		{
+			name: "malformed",
+			raw:  `{"fields":[{"name":"f"`,
+			want: `unexpected end of JSON input`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var sc arrow.Schema
+			err := json.Unmarshal([]byte(tc.raw), &sc)
+			if err == nil {
+				t.Fatalf("expected an error")
+			}
+			if got := err.Error(); got != tc.want {
+				t.Fatalf("invalid error:\ngot= %s\nwant=%s", got, tc.want)
+			}
+		})
+	}
+}
@@ -152,14 +152,6 @@ func TestSchema(t *testing.T) {
 			md:  nil,
 			err: fmt.Errorf("arrow: field with nil DataType"),
 		},
-		{
-			fields: []Field{
-				{Name: "f1", Type: PrimitiveTypes.Int32},
-				{Name: "f1", Type: PrimitiveTypes.Int64},
-			},
-			md:  nil,
-			err: fmt.Errorf(`arrow: duplicate field with name "f1"`),
-		},
 	} {
 		t.Run("", func(t *testing.T) {
 			if tc.err != nil {
@@ -233,6 +225,151 @@ func TestSchema(t *testing.T) {
 	}
 }
 
+func TestSchemaDuplicateFields(t *testing.T) {
+	fields := []Field{
+		{Name: "f1", Type: PrimitiveTypes.Int32},
+		{Name: "f2", Type: PrimitiveTypes.Int64},
+		{Name: "f1", Type: PrimitiveTypes.Float64},
+	}
+	s := NewSchema(fields, nil)
+
+	if got, want := s.NumFields(), 3; got != want {
+		t.Fatalf("invalid number of fields: got=%d, want=%d", got, want)
+	}
+	if got, want := s.FieldIndices("f1"), []int{0, 2}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid indices: got=%v, want=%v", got, want)
+	}
+	if got, want := s.FieldsByName("f1"), []Field{fields[0], fields[2]}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid fields: got=%v, want=%v", got, want)
+	}
+	if got, want := s.FieldIndex("f1"), 0; got != want {
+		t.Fatalf("invalid index: got=%d, want=%d", got, want)
+	}
+	if got, ok := s.FieldByName("f1"); !ok || !got.Equal(fields[0]) {
+		t.Fatalf("invalid field: got=%v, want=%v", got, fields[0])
+	}
+	if got, want := s.FieldIndices("f2"), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("invalid indices: got=%v, want=%v", got, want)
+	}
+	if got := s.FieldIndices("N/A"); got != nil {
+		t.Fatalf("invalid indices: got=%v, want=nil", got)
+	}
+	if got := s.FieldsByName("N/A"); got != nil {
+		t.Fatalf("invalid fields: got=%v, want=nil", got)
+	}
+	if !s.HasField("f2") || s.HasField("N/A") {
+		t.Fatalf("invalid HasField")
+	}
+}
+
+func TestSchemaAddRemoveSetField(t *testing.T) {
+	md := NewMetadata([]string{"k1"}, []string{"v1"})
+	f1 := Field{Name: "f1", Type: PrimitiveTypes.Int32}
+	f2 := Field{Name: "f2", Type: PrimitiveTypes.Int64}
+	f3 := Field{Name: "f3", Type: BinaryTypes.String}
+	s := NewSchema([]Field{f1, f2}, &md)
+
+	names := func(s *Schema) []string {
+		o := make([]string, s.NumFields())
+		for i, f := range s.Fields() {
+			o[i] = f.Name
+		}
+		return o
+	}
+
+	for _, tc := range []struct {
+		name string
+		f    func() (*Schema, error)
+		want []string
+		err  string
+	}{
+		{
+			name: "add-first",
+			f:    func() (*Schema, error) { return s.AddField(0, f3) },
+			want: []string{"f3", "f1", "f2"},
+		},
+		{
+			name: "add-middle",
+			f:    func() (*Schema, error) { return s.AddField(1, f3) },
+			want: []string{"f1", "f3", "f2"},
+		},
+		{
+			name: "add-last",
+			f:    func() (*Schema, error) { return s.AddField(2, f3) },
+			want: []string{"f1", "f2", "f3"},
+		},
+		{
+			name: "add-duplicate",
+			f:    func() (*Schema, error) { return s.AddField(2, f1) },
+			want: []string{"f1", "f2", "f1"},
+		},
+		{
+			name: "add-out-of-range",
+			f:    func() (*Schema, error) { return s.AddField(3, f3) },
+			err:  "arrow: invalid field index 3 to add to schema with 2 fields",
+		},
+		{
+			name: "add-negative",
+			f:    func() (*Schema, error) { return s.AddField(-1, f3) },
+			err:  "arrow: invalid field index -1 to add to schema with 2 fields",
+		},
+		{
+			name: "add-nil-type",
+			f:    func() (*Schema, error) { return s.AddField(0, Field{Name: "f0"}) },
+			err:  "arrow: field with nil DataType",
+		},
+		{
+			name: "remove",
+			f:    func() (*Schema, error) { return s.RemoveField(0) },
+			want: []string{"f2"},
+		},
+		{
+			name: "remove-out-of-range",
+			f:    func() (*Schema, error) { return s.RemoveField(2) },
+			err:  "arrow: invalid field index 2 to remove from schema with 2 fields",
+		},
+		{
+			name: "set",
+			f:    func() (*Schema, error) { return s.SetField(1, f3) },
+			want: []string{"f1", "f3"},
+		},
+		{
+			name: "set-out-of-range",
+			f:    func() (*Schema, error) { return s.SetField(2, f3) },
+			err:  "arrow: invalid field index 2 to set in schema with 2 fields",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.f()
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if got, want := names(got), tc.want; !reflect.DeepEqual(got, want) {
+				t.Fatalf("invalid fields: got=%v, want=%v", got, want)
+			}
+			for _, name := range tc.want {
+				if !got.HasField(name) {
+					t.Fatalf("missing field %q in index", name)
+				}
+			}
+			if got, want := got.Metadata(), md; !reflect.DeepEqual(got, want) {
+				t.Fatalf("invalid metadata: got=%v, want=%v", got, want)
+			}
+		})
+	}
+
+	// the original schema is left untouched.
+	if got, want := names(s), []string{"f1", "f2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("schema was modified: got=%v, want=%v", got, want)
+	}
+}
+
 func TestSchemaEqual(t *testing.T) {
 	fields := []Field{
 		{Name: "f1", Type: PrimitiveTypes.Int32},
@@ -312,3 +449,142 @@ func TestSchemaEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaEqualWithMetadata(t *testing.T) {
+	fields := []Field{
+		{Name: "f1", Type: PrimitiveTypes.Int32, Metadata: MetadataFrom(map[string]string{"unit": "m"})},
+		{Name: "f2", Type: PrimitiveTypes.Int64},
+	}
+	md1 := NewMetadata([]string{"k1"}, []string{"v1"})
+	md2 := NewMetadata([]string{"k1"}, []string{"v2"})
+	empty := MetadataFrom(nil)
+
+	for _, tc := range []struct {
+		a, b *Schema
+		want bool
+	}{
+		{
+			a:    NewSchema(fields, nil),
+			b:    NewSchema(fields, &empty),
+			want: true,
+		},
+		{
+			a:    NewSchema(fields, &md1),
+			b:    NewSchema(fields, &md1),
+			want: true,
+		},
+		{
+			a:    NewSchema(fields, &md1),
+			b:    NewSchema(fields, &md2),
+			want: false,
+		},
+		{
+			a:    NewSchema(fields, &md1),
+			b:    NewSchema(fields, nil),
+			want: false,
+		},
+		{
+			a:    NewSchema(fields, &md1),
+			b:    NewSchema(fields[1:], &md1),
+			want: false,
+		},
+	} {
+		t.Run("", func(t *testing.T) {
+			if !tc.a.Equal(tc.b) && tc.want {
+				t.Fatalf("a != b")
+			}
+			if got := tc.a.EqualWithMetadata(tc.b); got != tc.want {
+				t.Fatalf("got=%v, want=%v", got, tc.want)
+			}
+			if got := tc.b.EqualWithMetadata(tc.a); got != tc.want {
+				t.Fatalf("got=%v, want=%v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUnifySchemas(t *testing.T) {
+	md := NewMetadata([]string{"k"}, []string{"v"})
+	fmd := NewMetadata([]string{"unit"}, []string{"m"})
+
+	var (
+		f1  = Field{Name: "f1", Type: PrimitiveTypes.Int32}
+		f1n = Field{Name: "f1", Type: PrimitiveTypes.Int32, Nullable: true}
+		f2  = Field{Name: "f2", Type: PrimitiveTypes.Float64, Metadata: fmd}
+		f3  = Field{Name: "f3", Type: BinaryTypes.String, Nullable: true}
+	)
+
+	for _, tc := range []struct {
+		name    string
+		schemas []*Schema
+		want    *Schema
+		err     string
+	}{
+		{
+			name:    "single",
+			schemas: []*Schema{NewSchema([]Field{f1, f2}, &md)},
+			want:    NewSchema([]Field{f1, f2}, &md),
+		},
+		{
+			name: "added-column",
+			schemas: []*Schema{
+				NewSchema([]Field{f1, f2}, &md),
+				NewSchema([]Field{f3, f1, f2}, nil),
+			},
+			want: NewSchema([]Field{f1, f2, f3}, &md),
+		},
+		{
+			name: "nullability",
+			schemas: []*Schema{
+				NewSchema([]Field{f1}, nil),
+				NewSchema([]Field{f2}, nil),
+				NewSchema([]Field{f1n, f2}, nil),
+			},
+			want: NewSchema([]Field{f1n, f2}, nil),
+		},
+		{
+			name: "type-conflict",
+			schemas: []*Schema{
+				NewSchema([]Field{f1, f2}, nil),
+				NewSchema([]Field{{Name: "f2", Type: PrimitiveTypes.Int64}}, nil),
+			},
+			err: "arrow: field \"f2\" type mismatch in schema 1: got=int64, want=float64",
+		},
+		{
+			name:    "duplicate",
+			schemas: []*Schema{NewSchema([]Field{f1, f1n}, nil)},
+			err:     "arrow: duplicate field \"f1\" in schema 0",
+		},
+		{
+			name: "empty",
+			err:  "arrow: no schema to unify",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := UnifySchemas(tc.schemas)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("invalid error: got=%v, want=%q", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if !got.EqualWithMetadata(tc.want) {
+				t.Fatalf("invalid schema:\ngot= %v\nwant=%v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMetadataValue(t *testing.T) {
+	md := NewMetadata([]string{"unit", "desc"}, []string{"m/s", "speed"})
+
+	if v, ok := md.Value("desc"); !ok || v != "speed" {
+		t.Fatalf("invalid value: got=(%q, %v), want=(%q, true)", v, ok, "speed")
+	}
+	if v, ok := md.Value("missing"); ok || v != "" {
+		t.Fatalf("invalid value: got=(%q, %v), want=(\"\", false)", v, ok)
+	}
+}
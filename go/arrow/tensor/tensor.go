@@ -126,9 +126,17 @@ func (tb *tensorBase) IsColMajor() bool {
 	return equalInt64s(strides, tb.strides)
 }
 
+// offset returns the position in the backing array of the element at index.
+// offset panics if index does not address an element of the tensor.
 func (tb *tensorBase) offset(index []int64) int64 {
+	if len(index) != len(tb.shape) {
+		panic(fmt.Errorf("arrow/tensor: invalid number of indices (got=%d, want=%d)", len(index), len(tb.shape)))
+	}
 	var offset int64
 	for i, v := range index {
+		if v < 0 || v >= tb.shape[i] {
+			panic(fmt.Errorf("arrow/tensor: index %d out of range in dimension %d of size %d", v, i, tb.shape[i]))
+		}
 		offset += v * tb.strides[i]
 	}
 	return offset / tb.bw
@@ -167,6 +175,15 @@ func New(data *array.Data, shape, strides []int64, names []string) Interface {
 	}
 }
 
+// NewRowMajor returns a new n-dim array from the provided backing data and shape,
+// laid out in row-major (C) order.
+// If names is nil, a slice of empty strings will be created.
+//
+// NewRowMajor panics if the backing data is not a numerical type.
+func NewRowMajor(data *array.Data, shape []int64, names []string) Interface {
+	return New(data, shape, nil, names)
+}
+
 func newTensor(dtype arrow.DataType, data *array.Data, shape, strides []int64, names []string) *tensorBase {
 	tb := tensorBase{
 		refCount: 1,
@@ -182,6 +199,9 @@ func newTensor(dtype arrow.DataType, data *array.Data, shape, strides []int64, n
 	if len(tb.shape) > 0 && len(tb.strides) == 0 {
 		tb.strides = rowMajorStrides(dtype, shape)
 	}
+	if tb.names == nil {
+		tb.names = make([]string, len(tb.shape))
+	}
 	return &tb
 }
 
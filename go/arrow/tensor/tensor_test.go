@@ -164,3 +164,97 @@ func TestInvalidTensor(t *testing.T) {
 	})
 
 }
+
+func TestTensorLayouts(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	bld := array.NewInt64Builder(mem)
+	defer bld.Release()
+
+	bld.AppendValues([]int64{1, 2, 3, 4, 5, 6}, nil)
+
+	arr := bld.NewInt64Array()
+	defer arr.Release()
+
+	shape := []int64{2, 3}
+
+	t.Run("row-major", func(t *testing.T) {
+		tsr := tensor.NewRowMajor(arr.Data(), shape, nil).(*tensor.Int64)
+		defer tsr.Release()
+
+		if !tsr.IsRowMajor() || tsr.IsColMajor() {
+			t.Fatalf("should be row-major")
+		}
+		if got, want := tsr.Strides(), []int64{24, 8}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("invalid strides: got=%v, want=%v", got, want)
+		}
+		if got, want := tsr.DimNames(), []string{"", ""}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("invalid dim-names: got=%q, want=%q", got, want)
+		}
+		if got, want := tsr.Value([]int64{1, 0}), int64(4); got != want {
+			t.Fatalf("invalid value: got=%v, want=%v", got, want)
+		}
+	})
+
+	t.Run("col-major", func(t *testing.T) {
+		tsr := tensor.New(arr.Data(), shape, []int64{8, 16}, nil).(*tensor.Int64)
+		defer tsr.Release()
+
+		if tsr.IsRowMajor() || !tsr.IsColMajor() {
+			t.Fatalf("should be column-major")
+		}
+		if !tsr.IsContiguous() {
+			t.Fatalf("should be contiguous")
+		}
+		for _, tc := range []struct {
+			i []int64
+			v int64
+		}{
+			{i: []int64{0, 0}, v: 1},
+			{i: []int64{1, 0}, v: 2},
+			{i: []int64{0, 1}, v: 3},
+			{i: []int64{1, 2}, v: 6},
+		} {
+			if got := tsr.Value(tc.i); got != tc.v {
+				t.Fatalf("arr[%v]: got=%v, want=%v", tc.i, got, tc.v)
+			}
+		}
+	})
+
+	t.Run("out-of-bounds", func(t *testing.T) {
+		tsr := tensor.NewRowMajor(arr.Data(), shape, nil).(*tensor.Int64)
+		defer tsr.Release()
+
+		for _, tc := range []struct {
+			i   []int64
+			err error
+		}{
+			{
+				i:   []int64{2, 0},
+				err: fmt.Errorf("arrow/tensor: index 2 out of range in dimension 0 of size 2"),
+			},
+			{
+				i:   []int64{0, -1},
+				err: fmt.Errorf("arrow/tensor: index -1 out of range in dimension 1 of size 3"),
+			},
+			{
+				i:   []int64{0},
+				err: fmt.Errorf("arrow/tensor: invalid number of indices (got=1, want=2)"),
+			},
+		} {
+			t.Run(fmt.Sprintf("%v", tc.i), func(t *testing.T) {
+				defer func() {
+					e := recover()
+					if e == nil {
+						t.Fatalf("expected a panic: %v", tc.err)
+					}
+					if !reflect.DeepEqual(e, tc.err) {
+						t.Fatalf("invalid error: got=%v, want=%v", e, tc.err)
+					}
+				}()
+				tsr.Value(tc.i)
+			})
+		}
+	})
+}
@@ -43,9 +43,10 @@ func (decimal128Traits) PutValue(b []byte, v decimal128.Num) {
 	binary.LittleEndian.PutUint64(b[8:], uint64(v.HighBits()))
 }
 
-// CastFromBytes reinterprets the slice b to a slice of type uint16.
+// CastFromBytes reinterprets the slice b to a slice of type decimal128.Num.
+// The returned slice aliases the memory of b: no data is copied.
 //
-// NOTE: len(b) must be a multiple of Uint16SizeBytes.
+// NOTE: len(b) must be a multiple of Decimal128SizeBytes.
 func (decimal128Traits) CastFromBytes(b []byte) []decimal128.Num {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -59,6 +60,7 @@ func (decimal128Traits) CastFromBytes(b []byte) []decimal128.Num {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (decimal128Traits) CastToBytes(b []decimal128.Num) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -43,6 +43,7 @@ func (float16Traits) PutValue(b []byte, v float16.Num) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type uint16.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Uint16SizeBytes.
 func (float16Traits) CastFromBytes(b []byte) []float16.Num {
@@ -58,6 +59,7 @@ func (float16Traits) CastFromBytes(b []byte) []float16.Num {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (float16Traits) CastToBytes(b []float16.Num) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
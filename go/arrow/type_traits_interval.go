@@ -45,6 +45,7 @@ func (monthTraits) PutValue(b []byte, v MonthInterval) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type MonthInterval.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of MonthIntervalSizeBytes.
 func (monthTraits) CastFromBytes(b []byte) []MonthInterval {
@@ -60,6 +61,7 @@ func (monthTraits) CastFromBytes(b []byte) []MonthInterval {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (monthTraits) CastToBytes(b []MonthInterval) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -94,6 +96,7 @@ func (daytimeTraits) PutValue(b []byte, v DayTimeInterval) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type DayTimeInterval.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of DayTimeIntervalSizeBytes.
 func (daytimeTraits) CastFromBytes(b []byte) []DayTimeInterval {
@@ -109,6 +112,7 @@ func (daytimeTraits) CastFromBytes(b []byte) []DayTimeInterval {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (daytimeTraits) CastToBytes(b []DayTimeInterval) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
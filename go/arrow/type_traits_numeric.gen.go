@@ -62,6 +62,7 @@ func (int64Traits) PutValue(b []byte, v int64) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type int64.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Int64SizeBytes.
 func (int64Traits) CastFromBytes(b []byte) []int64 {
@@ -77,6 +78,7 @@ func (int64Traits) CastFromBytes(b []byte) []int64 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (int64Traits) CastToBytes(b []int64) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -110,6 +112,7 @@ func (uint64Traits) PutValue(b []byte, v uint64) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type uint64.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Uint64SizeBytes.
 func (uint64Traits) CastFromBytes(b []byte) []uint64 {
@@ -125,6 +128,7 @@ func (uint64Traits) CastFromBytes(b []byte) []uint64 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (uint64Traits) CastToBytes(b []uint64) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -158,6 +162,7 @@ func (float64Traits) PutValue(b []byte, v float64) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type float64.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Float64SizeBytes.
 func (float64Traits) CastFromBytes(b []byte) []float64 {
@@ -173,6 +178,7 @@ func (float64Traits) CastFromBytes(b []byte) []float64 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (float64Traits) CastToBytes(b []float64) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -206,6 +212,7 @@ func (int32Traits) PutValue(b []byte, v int32) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type int32.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Int32SizeBytes.
 func (int32Traits) CastFromBytes(b []byte) []int32 {
@@ -221,6 +228,7 @@ func (int32Traits) CastFromBytes(b []byte) []int32 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (int32Traits) CastToBytes(b []int32) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -254,6 +262,7 @@ func (uint32Traits) PutValue(b []byte, v uint32) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type uint32.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Uint32SizeBytes.
 func (uint32Traits) CastFromBytes(b []byte) []uint32 {
@@ -269,6 +278,7 @@ func (uint32Traits) CastFromBytes(b []byte) []uint32 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (uint32Traits) CastToBytes(b []uint32) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -302,6 +312,7 @@ func (float32Traits) PutValue(b []byte, v float32) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type float32.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Float32SizeBytes.
 func (float32Traits) CastFromBytes(b []byte) []float32 {
@@ -317,6 +328,7 @@ func (float32Traits) CastFromBytes(b []byte) []float32 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (float32Traits) CastToBytes(b []float32) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -350,6 +362,7 @@ func (int16Traits) PutValue(b []byte, v int16) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type int16.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Int16SizeBytes.
 func (int16Traits) CastFromBytes(b []byte) []int16 {
@@ -365,6 +378,7 @@ func (int16Traits) CastFromBytes(b []byte) []int16 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (int16Traits) CastToBytes(b []int16) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -398,6 +412,7 @@ func (uint16Traits) PutValue(b []byte, v uint16) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type uint16.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Uint16SizeBytes.
 func (uint16Traits) CastFromBytes(b []byte) []uint16 {
@@ -413,6 +428,7 @@ func (uint16Traits) CastFromBytes(b []byte) []uint16 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (uint16Traits) CastToBytes(b []uint16) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -446,6 +462,7 @@ func (int8Traits) PutValue(b []byte, v int8) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type int8.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Int8SizeBytes.
 func (int8Traits) CastFromBytes(b []byte) []int8 {
@@ -461,6 +478,7 @@ func (int8Traits) CastFromBytes(b []byte) []int8 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (int8Traits) CastToBytes(b []int8) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -494,6 +512,7 @@ func (uint8Traits) PutValue(b []byte, v uint8) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type uint8.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Uint8SizeBytes.
 func (uint8Traits) CastFromBytes(b []byte) []uint8 {
@@ -509,6 +528,7 @@ func (uint8Traits) CastFromBytes(b []byte) []uint8 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (uint8Traits) CastToBytes(b []uint8) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -542,6 +562,7 @@ func (timestampTraits) PutValue(b []byte, v Timestamp) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type Timestamp.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of TimestampSizeBytes.
 func (timestampTraits) CastFromBytes(b []byte) []Timestamp {
@@ -557,6 +578,7 @@ func (timestampTraits) CastFromBytes(b []byte) []Timestamp {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (timestampTraits) CastToBytes(b []Timestamp) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -590,6 +612,7 @@ func (time32Traits) PutValue(b []byte, v Time32) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type Time32.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Time32SizeBytes.
 func (time32Traits) CastFromBytes(b []byte) []Time32 {
@@ -605,6 +628,7 @@ func (time32Traits) CastFromBytes(b []byte) []Time32 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (time32Traits) CastToBytes(b []Time32) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -638,6 +662,7 @@ func (time64Traits) PutValue(b []byte, v Time64) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type Time64.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Time64SizeBytes.
 func (time64Traits) CastFromBytes(b []byte) []Time64 {
@@ -653,6 +678,7 @@ func (time64Traits) CastFromBytes(b []byte) []Time64 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (time64Traits) CastToBytes(b []Time64) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -686,6 +712,7 @@ func (date32Traits) PutValue(b []byte, v Date32) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type Date32.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Date32SizeBytes.
 func (date32Traits) CastFromBytes(b []byte) []Date32 {
@@ -701,6 +728,7 @@ func (date32Traits) CastFromBytes(b []byte) []Date32 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (date32Traits) CastToBytes(b []Date32) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -734,6 +762,7 @@ func (date64Traits) PutValue(b []byte, v Date64) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type Date64.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of Date64SizeBytes.
 func (date64Traits) CastFromBytes(b []byte) []Date64 {
@@ -749,6 +778,7 @@ func (date64Traits) CastFromBytes(b []byte) []Date64 {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (date64Traits) CastToBytes(b []Date64) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
@@ -782,6 +812,7 @@ func (durationTraits) PutValue(b []byte, v Duration) {
 }
 
 // CastFromBytes reinterprets the slice b to a slice of type Duration.
+// The returned slice aliases the memory of b: no data is copied.
 //
 // NOTE: len(b) must be a multiple of DurationSizeBytes.
 func (durationTraits) CastFromBytes(b []byte) []Duration {
@@ -797,6 +828,7 @@ func (durationTraits) CastFromBytes(b []byte) []Duration {
 }
 
 // CastToBytes reinterprets the slice b to a slice of bytes.
+// The returned slice aliases the memory of b: no data is copied.
 func (durationTraits) CastToBytes(b []Duration) []byte {
 	h := (*reflect.SliceHeader)(unsafe.Pointer(&b))
 
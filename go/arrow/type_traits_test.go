@@ -23,6 +23,7 @@ import (
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/decimal256"
 	"github.com/apache/arrow/go/arrow/float16"
 )
 
@@ -132,6 +133,43 @@ func TestDecimal128Traits(t *testing.T) {
 	}
 }
 
+func TestDecimal256Traits(t *testing.T) {
+	const N = 10
+	nbytes := arrow.Decimal256Traits.BytesRequired(N)
+	vs := make([]decimal256.Num, N)
+	for i := range vs {
+		vs[i] = decimal256.New(uint64(i), 1, 2, 10)
+	}
+	b1 := arrow.Decimal256Traits.CastToBytes(vs)
+
+	b2 := make([]byte, nbytes)
+	for i := 0; i < N; i++ {
+		beg := i * arrow.Decimal256SizeBytes
+		end := (i + 1) * arrow.Decimal256SizeBytes
+		arrow.Decimal256Traits.PutValue(b2[beg:end], decimal256.New(uint64(i), 1, 2, 10))
+	}
+
+	if !reflect.DeepEqual(b1, b2) {
+		v1 := arrow.Decimal256Traits.CastFromBytes(b1)
+		v2 := arrow.Decimal256Traits.CastFromBytes(b2)
+		t.Fatalf("invalid values:\nb1=%v\nb2=%v\nv1=%v\nv2=%v\n", b1, b2, v1, v2)
+	}
+
+	v1 := arrow.Decimal256Traits.CastFromBytes(b1)
+	for i, v := range v1 {
+		if got, want := v, decimal256.New(uint64(i), 1, 2, 10); got != want {
+			t.Fatalf("invalid value[%d]. got=%v, want=%v", i, got, want)
+		}
+	}
+
+	v2 := make([]decimal256.Num, N)
+	arrow.Decimal256Traits.Copy(v2, v1)
+
+	if !reflect.DeepEqual(v1, v2) {
+		t.Fatalf("invalid values:\nv1=%v\nv2=%v\n", v1, v2)
+	}
+}
+
 func TestMonthIntervalTraits(t *testing.T) {
 	const N = 10
 	b1 := arrow.MonthIntervalTraits.CastToBytes([]arrow.MonthInterval{
@@ -199,3 +237,56 @@ func TestDayTimeIntervalTraits(t *testing.T) {
 		t.Fatalf("invalid values:\nv1=%v\nv2=%v\n", v1, v2)
 	}
 }
+
+func TestTraitsCastAliasing(t *testing.T) {
+	t.Run("from-bytes", func(t *testing.T) {
+		raw := make([]byte, 3*arrow.Int64SizeBytes, 4*arrow.Int64SizeBytes)
+		vs := arrow.Int64Traits.CastFromBytes(raw)
+
+		if got, want := len(vs), 3; got != want {
+			t.Fatalf("invalid length: got=%d, want=%d", got, want)
+		}
+		if got, want := cap(vs), 4; got != want {
+			t.Fatalf("invalid capacity: got=%d, want=%d", got, want)
+		}
+
+		// writes through one slice are visible through the other.
+		vs[1] = -1
+		if got, want := raw[arrow.Int64SizeBytes:2*arrow.Int64SizeBytes], []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}; !reflect.DeepEqual(got, want) {
+			t.Fatalf("invalid bytes: got=%v, want=%v", got, want)
+		}
+		arrow.Int64Traits.PutValue(raw, 42)
+		if got, want := vs[0], int64(42); got != want {
+			t.Fatalf("invalid value: got=%d, want=%d", got, want)
+		}
+	})
+
+	t.Run("to-bytes", func(t *testing.T) {
+		vs := make([]float32, 2, 5)
+		raw := arrow.Float32Traits.CastToBytes(vs)
+
+		if got, want := len(raw), 2*arrow.Float32SizeBytes; got != want {
+			t.Fatalf("invalid length: got=%d, want=%d", got, want)
+		}
+		if got, want := cap(raw), 5*arrow.Float32SizeBytes; got != want {
+			t.Fatalf("invalid capacity: got=%d, want=%d", got, want)
+		}
+
+		vs[1] = 1.5
+		if got, want := arrow.Float32Traits.CastFromBytes(raw)[1], float32(1.5); got != want {
+			t.Fatalf("invalid value: got=%v, want=%v", got, want)
+		}
+		if &raw[0] != &arrow.Uint8Traits.CastToBytes(arrow.Uint8Traits.CastFromBytes(raw))[0] {
+			t.Fatalf("round-trip should not copy")
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if got := arrow.Int32Traits.CastFromBytes(nil); len(got) != 0 {
+			t.Fatalf("invalid length: got=%d, want=0", len(got))
+		}
+		if got := arrow.Int32Traits.CastToBytes(nil); len(got) != 0 {
+			t.Fatalf("invalid length: got=%d, want=0", len(got))
+		}
+	})
+}